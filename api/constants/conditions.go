@@ -4,6 +4,14 @@ const (
 	// ConditionMCPSuccessful is an aggregated condition showing whether all component resources could be reconciled successfully.
 	ConditionMCPSuccessful = "MCPSuccessful"
 
+	// ConditionDeletionStuck shows whether the current stage of a ManagedControlPlane's deletion takes longer than the configured timeout.
+	// It is only present while the ManagedControlPlane is being deleted.
+	ConditionDeletionStuck = "DeletionStuck"
+
+	// ConditionShootDrift shows whether the Gardener shoot of an APIServer has been modified outside of the operator.
+	// It is "True" once the shoot has been brought into the desired state, its reason tells whether the shoot had drifted before.
+	ConditionShootDrift = "ShootDrift"
+
 	ConditionClusterRequestGranted = "ClusterRequestGranted"
 	ConditionClusterReady          = "ClusterReady"
 	ConditionAccessRequestGranted  = "AccessRequestGranted"
@@ -12,4 +20,12 @@ const (
 
 	ConditionLandscaperV2ResourceCreatedOrUpdated = "LandscaperV2ResourceCreatedOrUpdated"
 	ConditionLandscaperV2ResourceDeleted          = "LandscaperV2ResourceDeleted"
+
+	ConditionAuthenticationV2ResourceCreatedOrUpdated = "AuthenticationV2ResourceCreatedOrUpdated"
+	ConditionAuthenticationV2ResourceDeleted          = "AuthenticationV2ResourceDeleted"
+	ConditionAuthorizationV2ResourceCreatedOrUpdated  = "AuthorizationV2ResourceCreatedOrUpdated"
+	ConditionAuthorizationV2ResourceDeleted           = "AuthorizationV2ResourceDeleted"
+
+	ConditionCloudOrchestratorAccessRequestGranted = "CloudOrchestratorAccessRequestGranted"
+	ConditionCloudOrchestratorAccessRequestDeleted = "CloudOrchestratorAccessRequestDeleted"
 )
@@ -26,6 +26,10 @@ const (
 
 	// ReasonMissingExpectedCondition means that a condition that was expected to be present is missing.
 	ReasonMissingExpectedCondition = "MissingExpectedCondition"
+
+	// ReasonDependencyHibernated means that another component that this component depends on is hibernated.
+	// The component is not reconciled until its dependency has been woken up.
+	ReasonDependencyHibernated = "DependencyHibernated"
 )
 
 // General messages
@@ -59,6 +63,21 @@ const (
 
 	// ReasonWaitingForGardenerShoot implies that the Gardener shoot cluster is not yet ready.
 	ReasonWaitingForGardenerShoot = "WaitingForGardenerShoot"
+
+	// ReasonAPIServerHibernated means that the APIServer is hibernated, or is currently being hibernated or woken up.
+	ReasonAPIServerHibernated = "Hibernated"
+
+	// ReasonNoShootDrift means that the shoot has not been modified outside of the operator.
+	ReasonNoShootDrift = "NoShootDrift"
+
+	// ReasonShootDriftCorrected means that the shoot has been modified outside of the operator and has been reset to its desired state.
+	ReasonShootDriftCorrected = "ShootDriftCorrected"
+
+	// ReasonFieldOwnershipConflict means that fields which the operator wants to set on a Gardener resource are owned by another field manager.
+	ReasonFieldOwnershipConflict = "FieldOwnershipConflict"
+
+	// ReasonKubernetesVersionNotSupported means that the Kubernetes version the shoot should be upgraded to is not offered by the CloudProfile.
+	ReasonKubernetesVersionNotSupported = "KubernetesVersionNotSupported"
 )
 
 // Landscaper Connector
@@ -82,6 +101,10 @@ const (
 const (
 	// ReasonManagingOpenIDConnect indicates Creating/Updating/Deleting the OpenIDConnect resources has failed.
 	ReasonManagingOpenIDConnect = "ManagingOpenIDConnectResourcesProblem"
+
+	// ReasonWaitingForV2ControlPlane means that the component is waiting for the v2 ControlPlane resource to become ready or to be deleted.
+	// It is also used by the Authorization Reconciler.
+	ReasonWaitingForV2ControlPlane = "WaitingForV2ControlPlane"
 )
 
 // Authorization Reconciler
@@ -96,6 +119,34 @@ const (
 	ReasonAllComponentsReconciledSuccessfully = "AllComponentsReconciledSuccessfully"
 	// ReasonNotAllComponentsReconciledSuccessfully indicates that not all components have been reconciled successfully.
 	ReasonNotAllComponentsReconciledSuccessfully = "NotAllComponentsReconciledSuccessfully"
+	// ReasonDeletionStageProgressing indicates that the current deletion stage has not exceeded its timeout (or no timeout is configured).
+	ReasonDeletionStageProgressing = "DeletionStageProgressing"
+	// ReasonDeletionStageTimeoutExceeded indicates that the current deletion stage takes longer than the configured timeout.
+	ReasonDeletionStageTimeoutExceeded = "DeletionStageTimeoutExceeded"
+)
+
+// Architecture Migration
+const (
+	// ReasonMigrationInProgress indicates that the migration of a component from the v1 to the v2 architecture is in progress.
+	ReasonMigrationInProgress = "MigrationInProgress"
+	// ReasonMigrationCompleted indicates that a component has been migrated from the v1 to the v2 architecture.
+	ReasonMigrationCompleted = "MigrationCompleted"
+	// ReasonMigrationRollingBack indicates that an unfinished migration of a component is being rolled back.
+	ReasonMigrationRollingBack = "MigrationRollingBack"
+	// ReasonMigrationRolledBack indicates that an unfinished migration of a component has been rolled back.
+	ReasonMigrationRolledBack = "MigrationRolledBack"
+	// ReasonMigrationRollbackNotPossible indicates that a rollback has been requested for a migration which has already been completed.
+	ReasonMigrationRollbackNotPossible = "MigrationRollbackNotPossible"
+	// ReasonMigrationNotSupported indicates that the component cannot be migrated, e.g. because of its type.
+	ReasonMigrationNotSupported = "MigrationNotSupported"
+	// ReasonMigrationStepPending indicates that a migration step has not been started yet, because a previous step is not completed.
+	ReasonMigrationStepPending = "MigrationStepPending"
+	// ReasonMigrationStepInProgress indicates that a migration step has been started, but is not completed yet.
+	ReasonMigrationStepInProgress = "MigrationStepInProgress"
+	// ReasonMigrationStepRolledBack indicates that a migration step has been rolled back.
+	ReasonMigrationStepRolledBack = "MigrationStepRolledBack"
+	// ReasonMigrationStepNotReversible indicates that a migration step which has been started cannot be reverted by the rollback.
+	ReasonMigrationStepNotReversible = "MigrationStepNotReversible"
 )
 
 const (
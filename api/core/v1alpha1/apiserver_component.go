@@ -25,12 +25,29 @@ func (as *APIServer) GetSpec() any {
 }
 
 // SetSpec implements Component.
+// If the new spec does not specify a Gardener landscape configuration, an already existing one is kept,
+// because it might have been chosen by the APIServer controller and must not change afterwards.
 func (as *APIServer) SetSpec(cfg any) error {
 	apiServerSpec, ok := cfg.(*APIServerSpec)
 	if !ok {
 		return openmcperrors.ErrWrongComponentConfigType
 	}
-	as.Spec = *apiServerSpec
+	lc := ""
+	if as.Spec.Internal != nil && as.Spec.Internal.GardenerConfig != nil {
+		lc = as.Spec.Internal.GardenerConfig.LandscapeConfiguration
+	}
+	as.Spec = *apiServerSpec.DeepCopy()
+	if lc != "" {
+		if as.Spec.Internal == nil {
+			as.Spec.Internal = &APIServerInternalConfiguration{}
+		}
+		if as.Spec.Internal.GardenerConfig == nil {
+			as.Spec.Internal.GardenerConfig = &GardenerInternalConfiguration{}
+		}
+		if as.Spec.Internal.GardenerConfig.LandscapeConfiguration == "" {
+			as.Spec.Internal.GardenerConfig.LandscapeConfiguration = lc
+		}
+	}
 	return nil
 }
 
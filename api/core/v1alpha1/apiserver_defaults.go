@@ -19,9 +19,8 @@ func (asSpec *APIServerSpec) Default() {
 
 // Validate validates the configuration.
 // Only the configuration that belongs to the configured type is validated, configuration for other types is ignored.
-func (asSpec *APIServerSpec) Validate(path string, morePaths ...string) error {
+func (asSpec *APIServerSpec) Validate(fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
-	fldPath := field.NewPath(path, morePaths...)
 
 	switch asSpec.Type {
 	case Gardener, GardenerDedicated:
@@ -30,5 +29,5 @@ func (asSpec *APIServerSpec) Validate(path string, morePaths ...string) error {
 		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), string(asSpec.Type), []string{string(Gardener), string(GardenerDedicated)}))
 	}
 
-	return allErrs.ToAggregate()
+	return allErrs
 }
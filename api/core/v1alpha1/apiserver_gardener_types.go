@@ -2,6 +2,9 @@ package v1alpha1
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -38,6 +41,25 @@ type GardenerConfiguration struct {
 	// EncryptionConfig contains customizable encryption configuration of the API server.
 	// +optional
 	EncryptionConfig *EncryptionConfig `json:"encryptionConfig,omitempty"`
+
+	// Maintenance configures when maintenance operations are performed and which updates are applied automatically.
+	// If not specified, the time window is chosen by Gardener.
+	// +optional
+	Maintenance *MaintenanceConfig `json:"maintenance,omitempty"`
+
+	// Hibernation configures when the API server is hibernated to save costs.
+	// While hibernated, the API server is not reachable.
+	// If specified, the API server can additionally be hibernated and woken up on demand via the operation annotation.
+	// +optional
+	Hibernation *HibernationConfig `json:"hibernation,omitempty"`
+
+	// SizingProfile is the name of the sizing profile which determines the purpose and the worker sizing of the cluster.
+	// The available sizing profiles are defined by the landscape operator.
+	// If not specified, the landscape's default is used.
+	// Changing the sizing profile causes the worker nodes to be replaced.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`
+	// +optional
+	SizingProfile string `json:"sizingProfile,omitempty"`
 }
 
 type GardenerInternalConfiguration struct {
@@ -96,6 +118,218 @@ type EncryptionConfig struct {
 	Resources []string `json:"resources,omitempty"`
 }
 
+// MaintenanceConfig configures when maintenance operations are performed and which updates are applied automatically.
+type MaintenanceConfig struct {
+	// TimeWindow is the daily time window in which maintenance operations, e.g. updates, are performed.
+	// It must lie within the time windows supported by the landscape.
+	// +optional
+	TimeWindow *MaintenanceTimeWindow `json:"timeWindow,omitempty"`
+
+	// AutoUpdate specifies which updates are applied automatically during the maintenance time window.
+	// +optional
+	AutoUpdate *MaintenanceAutoUpdate `json:"autoUpdate,omitempty"`
+}
+
+// MaintenanceTimeWindow is a daily time window.
+type MaintenanceTimeWindow struct {
+	// Begin is the beginning of the time window in the format 'HH:MM', e.g. '22:00'.
+	// +kubebuilder:validation:Pattern=`^([0-1][0-9]|2[0-3]):[0-5][0-9]$`
+	Begin string `json:"begin"`
+
+	// End is the end of the time window in the format 'HH:MM', e.g. '23:30'.
+	// If it is before the beginning, the time window spans midnight.
+	// +kubebuilder:validation:Pattern=`^([0-1][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+
+	// UTCOffset is the fixed offset from UTC begin and end refer to, in the format '+HH:MM' or '-HH:MM', e.g. '+01:00'.
+	// Must not be set together with Location. Defaults to '+00:00' if neither is set.
+	// +kubebuilder:validation:Pattern=`^[+-]([0-1][0-9]|2[0-3]):[0-5][0-9]$`
+	// +optional
+	UTCOffset string `json:"utcOffset,omitempty"`
+
+	// Location is the IANA name of the time zone begin and end refer to, e.g. 'Europe/Berlin'.
+	// Since Gardener only supports fixed UTC offsets, the current offset of the time zone is used, so the time window in UTC moves whenever daylight saving time begins or ends.
+	// Must not be set together with UTCOffset.
+	// +optional
+	Location string `json:"location,omitempty"`
+}
+
+// MaintenanceAutoUpdate specifies which updates are applied automatically during the maintenance time window.
+type MaintenanceAutoUpdate struct {
+	// KubernetesVersion specifies whether the Kubernetes patch version may be updated automatically.
+	// Defaults to true.
+	// +optional
+	KubernetesVersion *bool `json:"kubernetesVersion,omitempty"`
+
+	// MachineImageVersion specifies whether the machine image versions of the worker nodes may be updated automatically.
+	// Defaults to true.
+	// +optional
+	MachineImageVersion *bool `json:"machineImageVersion,omitempty"`
+}
+
+const (
+	// maintenanceTimeWindowMinDuration is the minimum duration of a maintenance time window, as required by Gardener.
+	maintenanceTimeWindowMinDuration = 30 * time.Minute
+	// maintenanceTimeWindowMaxDuration is the maximum duration of a maintenance time window, as required by Gardener.
+	maintenanceTimeWindowMaxDuration = 6 * time.Hour
+)
+
+// Offset returns the offset from UTC of the time window at the given point in time.
+// The point in time is only relevant if the time window refers to a time zone.
+func (tw *MaintenanceTimeWindow) Offset(now time.Time) (time.Duration, error) {
+	if tw.Location != "" {
+		if tw.UTCOffset != "" {
+			return 0, fmt.Errorf("offset and location must not both be set")
+		}
+		loc, err := time.LoadLocation(tw.Location)
+		if err != nil {
+			return 0, fmt.Errorf("unknown time zone '%s': %w", tw.Location, err)
+		}
+		_, offset := now.In(loc).Zone()
+		return time.Duration(offset) * time.Second, nil
+	}
+	if tw.UTCOffset == "" {
+		return 0, nil
+	}
+	if len(tw.UTCOffset) != 6 || (tw.UTCOffset[0] != '+' && tw.UTCOffset[0] != '-') {
+		return 0, fmt.Errorf("offset '%s' does not follow the format '+HH:MM' or '-HH:MM'", tw.UTCOffset)
+	}
+	t, err := time.Parse("15:04", tw.UTCOffset[1:])
+	if err != nil {
+		return 0, fmt.Errorf("offset '%s' does not follow the format '+HH:MM' or '-HH:MM': %w", tw.UTCOffset, err)
+	}
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	if tw.UTCOffset[0] == '-' {
+		offset = -offset
+	}
+	return offset, nil
+}
+
+// Duration returns the duration of the time window.
+func (tw *MaintenanceTimeWindow) Duration() (time.Duration, error) {
+	begin, err := time.Parse("15:04", tw.Begin)
+	if err != nil {
+		return 0, err
+	}
+	end, err := time.Parse("15:04", tw.End)
+	if err != nil {
+		return 0, err
+	}
+	d := end.Sub(begin)
+	if d <= 0 {
+		// the time window spans midnight
+		d += 24 * time.Hour
+	}
+	return d, nil
+}
+
+// Validate validates the time window.
+func (tw *MaintenanceTimeWindow) Validate(fldPath *field.Path) field.ErrorList {
+	if tw == nil {
+		return nil
+	}
+	allErrs := field.ErrorList{}
+
+	validFormat := true
+	if _, err := time.Parse("15:04", tw.Begin); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("begin"), tw.Begin, "must follow the format 'HH:MM'"))
+		validFormat = false
+	}
+	if _, err := time.Parse("15:04", tw.End); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("end"), tw.End, "must follow the format 'HH:MM'"))
+		validFormat = false
+	}
+	if validFormat {
+		d, _ := tw.Duration()
+		if d < maintenanceTimeWindowMinDuration || d > maintenanceTimeWindowMaxDuration {
+			allErrs = append(allErrs, field.Invalid(fldPath, fmt.Sprintf("%s-%s", tw.Begin, tw.End), fmt.Sprintf("time window must last between %s and %s", maintenanceTimeWindowMinDuration, maintenanceTimeWindowMaxDuration)))
+		}
+	}
+	if tw.Location != "" {
+		if tw.UTCOffset != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("utcOffset"), "must not be set together with location"))
+		}
+		if _, err := time.LoadLocation(tw.Location); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("location"), tw.Location, fmt.Sprintf("unknown time zone: %s", err.Error())))
+		}
+	} else if _, err := tw.Offset(time.Now()); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("utcOffset"), tw.UTCOffset, "must follow the format '+HH:MM' or '-HH:MM'"))
+	}
+
+	return allErrs
+}
+
+// HibernationConfig configures when the API server is hibernated.
+type HibernationConfig struct {
+	// Schedules are the schedules which determine when the API server is hibernated and woken up.
+	// If empty, the API server is only hibernated on demand.
+	// +optional
+	Schedules []HibernationSchedule `json:"schedules,omitempty"`
+
+	// Location is the IANA name of the time zone the schedules refer to, e.g. 'Europe/Berlin'.
+	// Defaults to 'UTC'.
+	// +optional
+	Location string `json:"location,omitempty"`
+}
+
+// HibernationSchedule determines when the API server is hibernated and woken up.
+// At least one of start and end must be specified.
+type HibernationSchedule struct {
+	// Start is a cron expression which determines when the API server is hibernated, e.g. '0 20 * * 1-5'.
+	// +optional
+	Start string `json:"start,omitempty"`
+
+	// End is a cron expression which determines when the API server is woken up, e.g. '0 7 * * 1-5'.
+	// +optional
+	End string `json:"end,omitempty"`
+}
+
+// cronFieldRegex matches a single field of a cron expression.
+var cronFieldRegex = regexp.MustCompile(`^(\*|[0-9A-Za-z]+(-[0-9A-Za-z]+)?)(/[0-9]+)?(,(\*|[0-9A-Za-z]+(-[0-9A-Za-z]+)?)(/[0-9]+)?)*$`)
+
+// isValidCronExpression returns true if the given string is a standard cron expression with five fields.
+func isValidCronExpression(expr string) bool {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return false
+	}
+	for _, f := range fields {
+		if !cronFieldRegex.MatchString(f) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate validates the hibernation configuration.
+func (hc *HibernationConfig) Validate(fldPath *field.Path) field.ErrorList {
+	if hc == nil {
+		return nil
+	}
+	allErrs := field.ErrorList{}
+
+	if hc.Location != "" {
+		if _, err := time.LoadLocation(hc.Location); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("location"), hc.Location, fmt.Sprintf("unknown time zone: %s", err.Error())))
+		}
+	}
+	for i, sched := range hc.Schedules {
+		schedPath := fldPath.Child("schedules").Index(i)
+		if sched.Start == "" && sched.End == "" {
+			allErrs = append(allErrs, field.Required(schedPath, "at least one of start and end must be specified"))
+			continue
+		}
+		if sched.Start != "" && !isValidCronExpression(sched.Start) {
+			allErrs = append(allErrs, field.Invalid(schedPath.Child("start"), sched.Start, "must be a cron expression with five fields"))
+		}
+		if sched.End != "" && !isValidCronExpression(sched.End) {
+			allErrs = append(allErrs, field.Invalid(schedPath.Child("end"), sched.End, "must be a cron expression with five fields"))
+		}
+	}
+
+	return allErrs
+}
+
 // GardenerStatus contains internal status for 'Gardener' type APIServer.
 type GardenerStatus struct {
 	// Shoot contains the shoot manifest generated by the controller.
@@ -116,6 +350,11 @@ func (gc *GardenerConfiguration) Validate(fldPath *field.Path) field.ErrorList {
 
 	// TODO validate OIDC config?
 
+	if gc.Maintenance != nil {
+		allErrs = append(allErrs, gc.Maintenance.TimeWindow.Validate(fldPath.Child("maintenance", "timeWindow"))...)
+	}
+	allErrs = append(allErrs, gc.Hibernation.Validate(fldPath.Child("hibernation"))...)
+
 	return allErrs
 }
 
@@ -65,6 +65,108 @@ type ExternalAPIServerStatus struct {
 	// ServiceAccountIssuer represents the OpenIDConnect issuer URL that can be used to verify service account tokens.
 	// +optional
 	ServiceAccountIssuer string `json:"serviceAccountIssuer,omitempty"`
+
+	// Placement describes where the API server is running.
+	// +optional
+	Placement *APIServerPlacement `json:"placement,omitempty"`
+
+	// KubernetesVersion contains the current and the target Kubernetes version of the API server.
+	// +optional
+	KubernetesVersion *KubernetesVersionStatus `json:"kubernetesVersion,omitempty"`
+
+	// Hibernation contains the hibernation state of the API server.
+	// Only set if hibernation is configured for the API server.
+	// +optional
+	Hibernation *HibernationStatus `json:"hibernation,omitempty"`
+}
+
+// HibernationState describes whether the API server is hibernated.
+type HibernationState string
+
+const (
+	// HibernationStateAwake means that the API server is running.
+	HibernationStateAwake HibernationState = "Awake"
+	// HibernationStateHibernating means that the API server is being hibernated.
+	HibernationStateHibernating HibernationState = "Hibernating"
+	// HibernationStateHibernated means that the API server is hibernated.
+	HibernationStateHibernated HibernationState = "Hibernated"
+	// HibernationStateWakingUp means that the API server is being woken up.
+	HibernationStateWakingUp HibernationState = "WakingUp"
+)
+
+// HibernationStatus describes the hibernation state of the API server.
+type HibernationStatus struct {
+	// State is the current hibernation state of the API server.
+	// +kubebuilder:validation:Enum=Awake;Hibernating;Hibernated;WakingUp
+	State HibernationState `json:"state"`
+}
+
+// IsHibernated returns true if the API server is hibernated or is currently being hibernated or woken up.
+// The API server is not expected to be reachable in any of these states.
+func (hs *HibernationStatus) IsHibernated() bool {
+	return hs != nil && hs.State != "" && hs.State != HibernationStateAwake
+}
+
+// KubernetesVersionStatus describes the current Kubernetes version of the API server and the version it will be upgraded to.
+type KubernetesVersionStatus struct {
+	// Current is the Kubernetes version the API server is currently configured with.
+	// +optional
+	Current string `json:"current,omitempty"`
+
+	// Target is the Kubernetes version the API server should be running according to the configured version policy.
+	// If it differs from the current version, the upgrade will be performed during the next maintenance time window.
+	// +optional
+	Target string `json:"target,omitempty"`
+}
+
+// RegionSelectionMethod describes how the region of the API server has been chosen.
+type RegionSelectionMethod string
+
+const (
+	// RegionSelectionMethodExplicit means that the region has been specified explicitly in the APIServer's Gardener configuration.
+	RegionSelectionMethodExplicit RegionSelectionMethod = "Explicit"
+	// RegionSelectionMethodDesiredRegion means that the region has been resolved from the desiredRegion by proximity.
+	RegionSelectionMethodDesiredRegion RegionSelectionMethod = "DesiredRegion"
+	// RegionSelectionMethodDefault means that the default region from the operator configuration has been used.
+	RegionSelectionMethodDefault RegionSelectionMethod = "Default"
+)
+
+// APIServerPlacement describes the region and zones the API server cluster is running in.
+type APIServerPlacement struct {
+	// Region is the provider-specific region the cluster is running in.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// RegionSelection describes how the region has been chosen.
+	// +optional
+	RegionSelection *RegionSelection `json:"regionSelection,omitempty"`
+
+	// ControlPlaneZone is the zone the control plane is pinned to.
+	// Only set if the cloud provider requires a control plane zone.
+	// +optional
+	ControlPlaneZone string `json:"controlPlaneZone,omitempty"`
+
+	// WorkerZones are the zones the worker nodes are spread across.
+	// +optional
+	WorkerZones []string `json:"workerZones,omitempty"`
+}
+
+// RegionSelection describes how the region of the API server has been chosen.
+type RegionSelection struct {
+	// Method is the method by which the region has been chosen.
+	// +kubebuilder:validation:Enum=Explicit;DesiredRegion;Default
+	Method RegionSelectionMethod `json:"method"`
+
+	// DesiredRegion is the generic region the region has been resolved from.
+	// Only set if method is 'DesiredRegion'.
+	// +optional
+	DesiredRegion *RegionSpecification `json:"desiredRegion,omitempty"`
+
+	// Distance is the proximity distance between the desired region and the chosen region.
+	// 0 means that the desired region and direction matched exactly, higher values mean that a neighboring direction or region had to be used.
+	// Only set if method is 'DesiredRegion'.
+	// +optional
+	Distance *int32 `json:"distance,omitempty"`
 }
 
 // APIServerStatus contains the APIServer status and potentially other fields which should not be exposed to the customer.
@@ -16,30 +16,31 @@ func (as *AuthenticationSpec) Default() {
 }
 
 // Validate validates the AuthenticationSpec
-func (as *AuthenticationSpec) Validate(path string, morePaths ...string) error {
+func (as *AuthenticationSpec) Validate(fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
-	fldPath := field.NewPath(path, morePaths...)
 
 	uniqueness := make(map[string]interface{})
 
-	for _, idp := range as.IdentityProviders {
+	for i, idp := range as.IdentityProviders {
+		idpPath := fldPath.Child("identityProviders").Index(i)
 		if _, ok := uniqueness[idp.Name]; ok {
-			allErrs = append(allErrs, field.Duplicate(fldPath.Child("identityProviders").Child(idp.Name), idp.Name))
+			allErrs = append(allErrs, field.Duplicate(idpPath.Child("name"), idp.Name))
 		} else {
 			uniqueness[idp.Name] = nil
 		}
 
-		allErrs = append(allErrs, ValidateIdp(idp, fldPath.Child("identityProviders"))...)
+		allErrs = append(allErrs, ValidateIdp(idp, idpPath)...)
 	}
 
-	return allErrs.ToAggregate()
+	return allErrs
 }
 
 // ValidateIdp validates the IdentityProvider
+// fldPath is expected to point to the IdentityProvider itself.
 func ValidateIdp(idp IdentityProvider, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	idpPath := fldPath.Child(idp.Name)
+	idpPath := fldPath
 
 	if idp.IssuerURL == "" {
 		allErrs = append(allErrs, field.Required(idpPath.Child("issuerURL"), "issuerURL must be set"))
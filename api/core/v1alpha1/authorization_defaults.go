@@ -23,37 +23,35 @@ func (as *AuthorizationSpec) Default() {
 }
 
 // Validate validates the AuthorizationSpec
-func (as *AuthorizationSpec) Validate(path string, morePaths ...string) error {
+func (as *AuthorizationSpec) Validate(fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
-	fldPath := field.NewPath(path, morePaths...)
 
-	for _, role := range as.RoleBindings {
+	for i, role := range as.RoleBindings {
+		rbPath := fldPath.Child("roleBindings").Index(i)
 		if role.Role != RoleBindingRoleAdmin && role.Role != RoleBindingRoleView {
-			allErrs = append(allErrs, field.Invalid(fldPath.Child("role"), role.Role, "role must be either admin or view"))
+			allErrs = append(allErrs, field.Invalid(rbPath.Child("role"), role.Role, "role must be either admin or view"))
 		}
 
-		fldPath = fldPath.Child("subjects")
-
-		for i, subject := range role.Subjects {
-			fldPath = fldPath.Index(i)
+		for j, subject := range role.Subjects {
+			subjectPath := rbPath.Child("subjects").Index(j)
 
 			if subject.Kind != GroupKind && subject.Kind != UserKind && subject.Kind != ServiceAccountKind {
-				allErrs = append(allErrs, field.Invalid(fldPath.Child("kind"), subject.Kind, "kind must be either ServiceAccount, User or Group"))
+				allErrs = append(allErrs, field.Invalid(subjectPath.Child("kind"), subject.Kind, "kind must be either ServiceAccount, User or Group"))
 			}
 
 			if (subject.Kind == GroupKind || subject.Kind == UserKind) && subject.APIGroup != GroupName {
-				allErrs = append(allErrs, field.Invalid(fldPath.Child("apiGroup"), subject.APIGroup, "apiGroup must be set to "+GroupName))
+				allErrs = append(allErrs, field.Invalid(subjectPath.Child("apiGroup"), subject.APIGroup, "apiGroup must be set to "+GroupName))
 			}
 
 			if subject.Name == "" {
-				allErrs = append(allErrs, field.Required(fldPath.Child("name"), "name must be set"))
+				allErrs = append(allErrs, field.Required(subjectPath.Child("name"), "name must be set"))
 			}
 
 			if subject.Namespace == "" && subject.Kind == ServiceAccountKind {
-				allErrs = append(allErrs, field.Required(fldPath.Child("namespace"), "namespace must be set"))
+				allErrs = append(allErrs, field.Required(subjectPath.Child("namespace"), "namespace must be set"))
 			}
 		}
 	}
 
-	return allErrs.ToAggregate()
+	return allErrs
 }
@@ -1,5 +1,9 @@
 package v1alpha1
 
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
 // Default sets defaults.
 // This modifies the receiver object.
 // Note that only the parts which belong to the configured type are defaulted, everything else is ignored.
@@ -7,6 +11,6 @@ func (cos *CloudOrchestratorSpec) Default() {}
 
 // Validate validates the configuration.
 // Only the configuration that belongs to the configured type is validated, configuration for other types is ignored.
-func (cos *CloudOrchestratorSpec) Validate(path string, morePaths ...string) error {
+func (cos *CloudOrchestratorSpec) Validate(_ *field.Path) field.ErrorList {
 	return nil
 }
@@ -151,3 +151,15 @@ func (ct ComponentType) ArchitectureLabelPrefix() string {
 func (ct ComponentType) ArchitectureVersionLabel() string {
 	return fmt.Sprintf("%s%s", ct.ArchitectureLabelPrefix(), "version")
 }
+
+// ArchitectureMigrationAnnotation returns the component-specific architecture migration annotation.
+// Note that this annotation is only used on the MCP resource itself, on the component resources, the static ArchitectureMigrationAnnotation is used.
+func (ct ComponentType) ArchitectureMigrationAnnotation() string {
+	return fmt.Sprintf("%s%s", ct.ArchitectureLabelPrefix(), "migration")
+}
+
+// ArchitectureMigrationCondition returns the name of the condition that holds the state of the component's migration from the v1 to the v2 architecture.
+// It resolves to "<componentType>ArchitectureMigration".
+func (ct ComponentType) ArchitectureMigrationCondition() string {
+	return fmt.Sprintf("%sArchitectureMigration", string(ct))
+}
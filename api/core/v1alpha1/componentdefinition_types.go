@@ -0,0 +1,142 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ComponentDefinitionFinalizer is the finalizer which is added to ComponentDefinitions while they are registered.
+const ComponentDefinitionFinalizer = "componentdefinition." + BaseDomain
+
+// ComponentDefinitionSpec defines the desired state of ComponentDefinition.
+type ComponentDefinitionSpec struct {
+	// Type is the type of the component.
+	// It must not be the type of a built-in component. It is used as prefix for the component's conditions,
+	// so the controller of the component is expected to set the '<Type>Reconciliation' and '<Type>Healthy' conditions in the resource's status.
+	// +kubebuilder:validation:Pattern=`^[A-Z][a-zA-Z0-9]*$`
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	Type ComponentType `json:"type"`
+
+	// Resource specifies the namespaced resource which is created for each ManagedControlPlane which configures this component.
+	// The resource has the same name and namespace as the ManagedControlPlane.
+	Resource ComponentDefinitionResource `json:"resource"`
+
+	// SpecPath is the dot-separated path to the configuration of this component in the ManagedControlPlane's spec, e.g. 'components.external.myComponent'.
+	// The configuration must be an object, it is copied into the spec of the component's resource as-is.
+	// The component is only created for ManagedControlPlanes which contain configuration at this path.
+	// External components should put their configuration below 'components.external', which is not validated by the ManagedControlPlane's schema.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_-]+(\.[a-zA-Z0-9_-]+)*$`
+	SpecPath string `json:"specPath"`
+
+	// Dependencies contains the types of the components this component depends on.
+	// The ManagedControlPlane controller creates dependencies before and deletes them after this component.
+	// +optional
+	Dependencies []ComponentType `json:"dependencies,omitempty"`
+
+	// RoleAggregation contains the label selectors of the (Cluster)Roles which are aggregated into the roles that are managed by the Authorization component.
+	// It should be used if the component brings its own custom resources which the end-users have to interact with.
+	// +optional
+	RoleAggregation *ComponentRoleAggregation `json:"roleAggregation,omitempty"`
+}
+
+// ComponentDefinitionResource identifies the resource of an externally defined component.
+type ComponentDefinitionResource struct {
+	// Group is the API group of the resource.
+	Group string `json:"group"`
+	// Version is the API version of the resource.
+	Version string `json:"version"`
+	// Kind is the kind of the resource.
+	Kind string `json:"kind"`
+}
+
+// GroupVersionKind returns the GroupVersionKind of the resource.
+func (r ComponentDefinitionResource) GroupVersionKind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{
+		Group:   r.Group,
+		Version: r.Version,
+		Kind:    r.Kind,
+	}
+}
+
+// ComponentRoleAggregation contains the label selectors for the aggregation of the roles managed by the Authorization component.
+type ComponentRoleAggregation struct {
+	// ClusterAdmin contains the label selectors which are added to the aggregation rule of the cluster-scoped admin role.
+	// +optional
+	ClusterAdmin []metav1.LabelSelector `json:"clusterAdmin,omitempty"`
+	// ClusterView contains the label selectors which are added to the aggregation rule of the cluster-scoped view role.
+	// +optional
+	ClusterView []metav1.LabelSelector `json:"clusterView,omitempty"`
+	// Admin contains the label selectors which are added to the aggregation rule of the namespace-scoped admin role.
+	// +optional
+	Admin []metav1.LabelSelector `json:"admin,omitempty"`
+	// View contains the label selectors which are added to the aggregation rule of the namespace-scoped view role.
+	// +optional
+	View []metav1.LabelSelector `json:"view,omitempty"`
+}
+
+// LabelSelectorsForRole returns the label selectors for the role with the given name.
+func (ra *ComponentRoleAggregation) LabelSelectorsForRole(roleName string) []metav1.LabelSelector {
+	if ra == nil {
+		return nil
+	}
+	if IsClusterScopedRole(roleName) {
+		if IsAdminRole(roleName) {
+			return ra.ClusterAdmin
+		}
+		return ra.ClusterView
+	}
+	if IsAdminRole(roleName) {
+		return ra.Admin
+	}
+	return ra.View
+}
+
+// ComponentDefinitionStatus defines the observed state of ComponentDefinition.
+type ComponentDefinitionStatus struct {
+	// ObservedGeneration is the last generation of this resource that has been handled by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Registered is true if the component is registered and handled by the ManagedControlPlane controller.
+	Registered bool `json:"registered"`
+
+	// Message contains further information, e.g. why the component could not be registered.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=compdef
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=".spec.resource.kind"
+// +kubebuilder:printcolumn:name="Registered",type="boolean",JSONPath=".status.registered"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ComponentDefinition registers an externally contributed component with the ManagedControlPlane controller.
+// For each ManagedControlPlane which contains configuration at the given spec path, a resource of the given kind is created,
+// and its conditions are aggregated into the ManagedControlPlane's status like the ones of the built-in components.
+type ComponentDefinition struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ComponentDefinitionSpec   `json:"spec,omitempty"`
+	Status ComponentDefinitionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ComponentDefinitionList contains a list of ComponentDefinition.
+type ComponentDefinitionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComponentDefinition `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(func(scheme *runtime.Scheme) error {
+		scheme.AddKnownTypes(GroupVersion, &ComponentDefinition{}, &ComponentDefinitionList{})
+		return nil
+	})
+}
@@ -16,6 +16,18 @@ const (
 	// OperationAnnotationValueIgnore is the value of the operation annotation which causes the responsible controller to ignore this resource.
 	OperationAnnotationValueIgnore = "ignore"
 
+	// OperationAnnotationValueHibernate is the value of the operation annotation which causes the APIServer to be hibernated on demand.
+	// It is only respected if hibernation is configured for the APIServer.
+	OperationAnnotationValueHibernate = "hibernate"
+
+	// OperationAnnotationValueWakeUp is the value of the operation annotation which causes a hibernated APIServer to be woken up on demand.
+	// It is only respected if hibernation is configured for the APIServer.
+	OperationAnnotationValueWakeUp = "wakeup"
+
+	// OperationAnnotationValuePlan is the value of the operation annotation which puts a ManagedControlPlane into plan mode.
+	// In plan mode, changes to the ManagedControlPlane are not applied. Instead, the changes which would be made are written into a ConfigMap.
+	OperationAnnotationValuePlan = "plan"
+
 	// ManagedControlPlaneBackReferenceLabelName contains the name of the creating ManagedControlPlane resource, in case the ManagedControlPlane's status is lost.
 	ManagedControlPlaneBackReferenceLabelName = BaseDomain + "/mcp-name"
 	// ManagedControlPlaneBackReferenceLabelNamespace contains the namespace of the creating ManagedControlPlane resource, in case the ManagedControlPlane's status is lost.
@@ -76,6 +88,18 @@ const (
 	// ManagedControlPlaneDeletionConfirmationAnnotation is the annotation, which needs to be set true before a mcp can be deleted
 	ManagedControlPlaneDeletionConfirmationAnnotation = "confirmation." + BaseDomain + "/deletion"
 
+	// ManagedControlPlaneCancelDeletionAnnotation can be set to true to cancel the deletion of a ManagedControlPlane which is pending deletion.
+	// It is removed by the ManagedControlPlane controller, together with the deletion confirmation annotation.
+	ManagedControlPlaneCancelDeletionAnnotation = "confirmation." + BaseDomain + "/cancel-deletion"
+
+	// ManagedControlPlaneSuspendedByAnnotation contains the name of the user who suspended the ManagedControlPlane.
+	// It is set by the mutating webhook when spec.suspend is set and removed when it is unset.
+	ManagedControlPlaneSuspendedByAnnotation = BaseDomain + "/suspended-by"
+
+	// SuspendedOperationAnnotation contains the operation annotation value a component resource had when its ManagedControlPlane was suspended.
+	// It is set by the ManagedControlPlane controller when the operation annotation is replaced by the ignore operation and removed when the operation is restored on resume.
+	SuspendedOperationAnnotation = BaseDomain + "/suspended-operation"
+
 	// APIServer
 
 	APIServerDomain = "apiserver." + BaseDomain
@@ -89,4 +113,11 @@ const (
 	ArchitectureV2               = "v2"
 	V1MCPReferenceLabelName      = "v1." + BaseDomain + "/mcp-name"
 	V1MCPReferenceLabelNamespace = "v1." + BaseDomain + "/mcp-namespace"
+
+	// ArchitectureMigrationAnnotation requests a migration of a component resource from the v1 to the v2 architecture.
+	// Its value is either ArchitectureV2 to migrate the component or ArchitectureMigrationValueRollback to roll back an unfinished migration.
+	// On the MCP resource, the component-specific annotation is used instead, see ComponentType.ArchitectureMigrationAnnotation.
+	ArchitectureMigrationAnnotation = ArchitectureLabelPrefix + "migration"
+	// ArchitectureMigrationValueRollback is the value of the migration annotation which rolls back an unfinished migration.
+	ArchitectureMigrationValueRollback = "rollback"
 )
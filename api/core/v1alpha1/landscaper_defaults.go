@@ -1,5 +1,9 @@
 package v1alpha1
 
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
 // Default sets defaults.
 // This modifies the receiver object.
 // Note that only the parts which belong to the configured type are defaulted, everything else is ignored.
@@ -15,6 +19,6 @@ func (lss *LandscaperSpec) Default() {
 
 // Validate validates the configuration.
 // Only the configuration that belongs to the configured type is validated, configuration for other types is ignored.
-func (lss *LandscaperSpec) Validate(path string, morePaths ...string) error {
+func (lss *LandscaperSpec) Validate(_ *field.Path) field.ErrorList {
 	return nil
 }
@@ -14,6 +14,14 @@ type ManagedControlPlaneComponents struct {
 	Landscaper *LandscaperConfiguration `json:"landscaper,omitempty"`
 
 	CloudOrchestratorConfiguration `json:",inline"`
+
+	// External contains the configuration for components which are registered via ComponentDefinitions.
+	// The content is not validated by the schema, it is up to the respective component to interpret it.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +optional
+	External *runtime.RawExtension `json:"external,omitempty"`
 }
 
 // ManagedControlPlaneSpec defines the desired state of ManagedControlPlane.
@@ -33,6 +41,44 @@ type ManagedControlPlaneSpec struct {
 
 	// Components contains the configuration for Components like APIServer, Landscaper, CloudOrchestrator.
 	Components ManagedControlPlaneComponents `json:"components"`
+
+	// ReadinessGates contains the component conditions which are required for the ManagedControlPlane to be ready.
+	// If empty, all conditions are required.
+	// If set, conditions which are not listed here don't prevent the ManagedControlPlane from being ready, it is "Degraded" instead if any of them is not true.
+	// +optional
+	// +listType=map
+	// +listMapKey=conditionType
+	ReadinessGates []ManagedControlPlaneReadinessGate `json:"readinessGates,omitempty"`
+
+	// DeletionGracePeriod enables a grace period for the deletion of the ManagedControlPlane.
+	// If set, confirming the deletion via the deletion confirmation annotation doesn't allow the ManagedControlPlane to be deleted right away.
+	// Instead, it becomes "PendingDeletion" and its API server is hibernated. The ManagedControlPlane is deleted automatically once the grace period has passed,
+	// unless the deletion is cancelled via the cancel deletion annotation before.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="deletionGracePeriod must be positive"
+	DeletionGracePeriod *metav1.Duration `json:"deletionGracePeriod,omitempty"`
+
+	// Suspend suspends the reconciliation of the ManagedControlPlane and all of its components.
+	// While it is set, all component resources get the ignore operation annotation and changes to the spec are not applied to them.
+	// Removing it resumes the normal reconciliation.
+	// A suspended ManagedControlPlane cannot be deleted.
+	// +optional
+	Suspend *ManagedControlPlaneSuspension `json:"suspend,omitempty"`
+}
+
+// ManagedControlPlaneSuspension contains information about the suspension of a ManagedControlPlane.
+type ManagedControlPlaneSuspension struct {
+	// Reason describes why the ManagedControlPlane has been suspended.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// ManagedControlPlaneReadinessGate references a component condition which is required for the ManagedControlPlane to be ready.
+type ManagedControlPlaneReadinessGate struct {
+	// ConditionType is the type of the condition, as shown in the ManagedControlPlane's status.
+	// A readiness gate for a condition which doesn't exist is not fulfilled.
+	// +kubebuilder:validation:MinLength=1
+	ConditionType string `json:"conditionType"`
 }
 
 // ManagedControlPlaneComponentsStatus contains the status of the components of a ManagedControlPlane.
@@ -56,8 +102,138 @@ type ManagedControlPlaneStatus struct {
 	Conditions []ManagedControlPlaneComponentCondition `json:"conditions,omitempty"`
 
 	Components ManagedControlPlaneComponentsStatus `json:"components,omitempty"`
+
+	// Progress describes the progress of the ManagedControlPlane's provisioning, update, or deletion.
+	// +optional
+	Progress *ManagedControlPlaneProgress `json:"progress,omitempty"`
+
+	// PendingDeletion is set while the ManagedControlPlane waits for its deletion grace period to pass.
+	// +optional
+	PendingDeletion *ManagedControlPlanePendingDeletionStatus `json:"pendingDeletion,omitempty"`
+
+	// Suspension is set while the ManagedControlPlane is suspended.
+	// +optional
+	Suspension *ManagedControlPlaneSuspensionStatus `json:"suspension,omitempty"`
+
+	// Deletion describes the current stage of the ManagedControlPlane's deletion.
+	// It is only set while the ManagedControlPlane is being deleted.
+	// +optional
+	Deletion *ManagedControlPlaneDeletionStatus `json:"deletion,omitempty"`
+}
+
+// ManagedControlPlaneSuspensionStatus describes the suspension of a ManagedControlPlane.
+type ManagedControlPlaneSuspensionStatus struct {
+	// SuspendedAt is the time at which the suspension has been observed by the controller.
+	SuspendedAt metav1.Time `json:"suspendedAt"`
+
+	// SuspendedBy is the name of the user who suspended the ManagedControlPlane.
+	// +optional
+	SuspendedBy string `json:"suspendedBy,omitempty"`
+
+	// Reason is the reason for the suspension, as specified in the ManagedControlPlane's spec.
+	// +optional
+	Reason string `json:"reason,omitempty"`
 }
 
+// ManagedControlPlanePendingDeletionStatus describes a scheduled deletion of a ManagedControlPlane.
+type ManagedControlPlanePendingDeletionStatus struct {
+	// ConfirmedAt is the time at which the deletion has been confirmed.
+	ConfirmedAt metav1.Time `json:"confirmedAt"`
+
+	// DeleteAfter is the time after which the ManagedControlPlane will be deleted.
+	DeleteAfter metav1.Time `json:"deleteAfter"`
+
+	// APIServerWasHibernated is true if the API server was already hibernated when the deletion was confirmed.
+	// If the deletion is cancelled, the API server is only woken up if it was awake before.
+	// +optional
+	APIServerWasHibernated bool `json:"apiServerWasHibernated,omitempty"`
+}
+
+// ManagedControlPlaneDeletionStatus describes the current stage of a ManagedControlPlane's deletion.
+// Components are deleted in stages, a component is only deleted after all components depending on it are gone.
+type ManagedControlPlaneDeletionStatus struct {
+	// Stage contains the components which are currently being deleted, sorted by component type.
+	Stage []ComponentType `json:"stage"`
+
+	// StageStartTime is the time at which the current stage has started.
+	StageStartTime metav1.Time `json:"stageStartTime"`
+
+	// Waiting contains the components whose deletion waits for the current stage to finish, sorted by component type.
+	// +optional
+	Waiting []ComponentType `json:"waiting,omitempty"`
+}
+
+// ManagedControlPlaneProgress describes the progress of a ManagedControlPlane.
+type ManagedControlPlaneProgress struct {
+	// Phase is the current phase of the ManagedControlPlane.
+	Phase MCPPhase `json:"phase"`
+
+	// PhaseTransitionTime is the time at which the ManagedControlPlane entered its current phase.
+	PhaseTransitionTime metav1.Time `json:"phaseTransitionTime"`
+
+	// FirstReadyTime is the time at which the ManagedControlPlane has been ready for the first time.
+	// As long as it is not set, the ManagedControlPlane returns to phase "Provisioning" instead of "Updating" when it recovers from a failure.
+	// +optional
+	FirstReadyTime *metav1.Time `json:"firstReadyTime,omitempty"`
+
+	// Components contains the phases of the single components, sorted by component type.
+	// +optional
+	Components []ComponentProgress `json:"components,omitempty"`
+
+	// RemainingSteps lists the components which are not yet ready or not yet deleted, in the order in which they are expected to finish.
+	// The order is derived from the dependencies between the components.
+	// +optional
+	RemainingSteps []ManagedControlPlaneStep `json:"remainingSteps,omitempty"`
+}
+
+// ComponentProgress describes the progress of a single component.
+type ComponentProgress struct {
+	// Component is the type of the component.
+	Component ComponentType `json:"component"`
+
+	// Phase is the current phase of the component.
+	// Components are never in phase "Degraded".
+	Phase MCPPhase `json:"phase"`
+
+	// FirstReadyTime is the time at which the component has been ready for the first time.
+	// +optional
+	FirstReadyTime *metav1.Time `json:"firstReadyTime,omitempty"`
+}
+
+// ManagedControlPlaneStep is a remaining step in the provisioning, update, or deletion of a ManagedControlPlane.
+type ManagedControlPlaneStep struct {
+	// Component is the type of the component which is not yet ready or, during deletion, not yet deleted.
+	Component ComponentType `json:"component"`
+
+	// BlockedBy contains the components which have to finish before this component can finish.
+	// During deletion, these are the components depending on this one.
+	// +optional
+	BlockedBy []ComponentType `json:"blockedBy,omitempty"`
+}
+
+// MCPPhase is the phase of a ManagedControlPlane or one of its components.
+type MCPPhase string
+
+const (
+	// MCPPhaseProvisioning means that the ManagedControlPlane or component is being created and has not been ready yet.
+	MCPPhaseProvisioning MCPPhase = "Provisioning"
+
+	// MCPPhaseReady means that the ManagedControlPlane or component is ready.
+	MCPPhaseReady MCPPhase = "Ready"
+
+	// MCPPhaseUpdating means that the ManagedControlPlane or component has been ready before, but is currently not ready, e.g. because it is being updated.
+	MCPPhaseUpdating MCPPhase = "Updating"
+
+	// MCPPhaseDegraded means that all conditions required by the ManagedControlPlane's readiness gates are true, but some other conditions are not.
+	MCPPhaseDegraded MCPPhase = "Degraded"
+
+	// MCPPhaseDeleting means that the ManagedControlPlane or component is being deleted.
+	MCPPhaseDeleting MCPPhase = "Deleting"
+
+	// MCPPhaseFailed means that the reconciliation of the ManagedControlPlane or component failed.
+	MCPPhaseFailed MCPPhase = "Failed"
+)
+
 type ManagedControlPlaneComponentCondition struct {
 	ComponentCondition `json:",inline"`
 
@@ -71,7 +247,12 @@ type ManagedControlPlaneMetaStatus struct {
 
 	// Status is the current status of the ManagedControlPlane.
 	// It is "Deleting" if the ManagedControlPlane is being deleted.
+	// It is "PendingDeletion" if the ManagedControlPlane's deletion has been confirmed, but its deletion grace period has not yet passed.
+	// It is "Suspended" if the reconciliation of the ManagedControlPlane has been suspended.
+	// It is "Hibernated" if the API server is hibernated.
 	// It is "Ready" if all conditions are true, and "Not Ready" otherwise.
+	// If readiness gates are specified, it is "Not Ready" if any of the gated conditions is not true,
+	// and "Degraded" if only conditions which are not gated are not true.
 	Status MCPStatus `json:"status"`
 
 	// Message contains an optional message.
@@ -90,8 +271,20 @@ const (
 	// MCPStatusNotReady indicates that the ManagedControlPlane is not ready.
 	MCPStatusNotReady MCPStatus = "Not Ready"
 
+	// MCPStatusDegraded indicates that all conditions required by the ManagedControlPlane's readiness gates are true, but some other conditions are not.
+	MCPStatusDegraded MCPStatus = "Degraded"
+
 	// MCPStatusDeleting indicates that the ManagedControlPlane is being deleted.
 	MCPStatusDeleting MCPStatus = "Deleting"
+
+	// MCPStatusPendingDeletion indicates that the deletion of the ManagedControlPlane has been confirmed, but its deletion grace period has not yet passed.
+	MCPStatusPendingDeletion MCPStatus = "PendingDeletion"
+
+	// MCPStatusHibernated indicates that the ManagedControlPlane's API server is hibernated, or is currently being hibernated or woken up.
+	MCPStatusHibernated MCPStatus = "Hibernated"
+
+	// MCPStatusSuspended indicates that the reconciliation of the ManagedControlPlane and its components has been suspended.
+	MCPStatusSuspended MCPStatus = "Suspended"
 )
 
 // +kubebuilder:object:root=true
@@ -100,6 +293,7 @@ const (
 // ManagedControlPlane is the Schema for the ManagedControlPlane API
 // +kubebuilder:resource:shortName=mcp
 // +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.status`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.progress.phase`,priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 36",message="name must not be longer than 36 characters"
 type ManagedControlPlane struct {
@@ -2,11 +2,16 @@ package v1alpha1
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	apierrors "k8s.io/apimachinery/pkg/util/errors"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -15,6 +20,22 @@ import (
 // log is for logging in this package.
 var managedcontrolplanelog = logf.Log.WithName("managedcontrolplane-resource")
 
+// ManagedControlPlaneValidator validates a ManagedControlPlane on creation and update.
+// On update, oldMcp is the ManagedControlPlane before the update, it is nil on creation.
+// The field paths of the returned errors must refer to the ManagedControlPlane, e.g. 'spec.components.apiServer'.
+// +kubebuilder:object:generate=false
+type ManagedControlPlaneValidator func(ctx context.Context, mcp, oldMcp *ManagedControlPlane) field.ErrorList
+
+// managedControlPlaneValidators are the validators which are run by the validating webhook on creation and update of ManagedControlPlanes.
+var managedControlPlaneValidators []ManagedControlPlaneValidator
+
+// RegisterManagedControlPlaneValidators registers validators which are run by the validating webhook on creation and update of ManagedControlPlanes.
+// The configuration of the components can only be validated by the component converters, which are not part of this module.
+// The MCP operator registers the corresponding validators before setting up the webhook.
+func RegisterManagedControlPlaneValidators(validators ...ManagedControlPlaneValidator) {
+	managedControlPlaneValidators = append(managedControlPlaneValidators, validators...)
+}
+
 // SetupWebhookWithManager will setup the manager to manage the webhooks
 func (r *ManagedControlPlane) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr, r).
@@ -38,28 +59,28 @@ func (r *ManagedControlPlane) Default(ctx context.Context, obj *ManagedControlPl
 
 	setCreatedBy(obj, req)
 
-	return nil
+	return setSuspendedBy(obj, req)
 }
 
-// +kubebuilder:webhook:path=/validate-core-openmcp-cloud-v1alpha1-managedcontrolplane,mutating=false,failurePolicy=fail,sideEffects=None,groups=core.openmcp.cloud,resources=managedcontrolplanes,verbs=delete,versions=v1alpha1,name=vmanagedcontrolplane.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-core-openmcp-cloud-v1alpha1-managedcontrolplane,mutating=false,failurePolicy=fail,sideEffects=None,groups=core.openmcp.cloud,resources=managedcontrolplanes,verbs=create;update;delete,versions=v1alpha1,name=vmanagedcontrolplane.kb.io,admissionReviewVersions=v1
 
 var _ admission.Validator[*ManagedControlPlane] = &ManagedControlPlane{}
 
 // ValidateCreate implements admission.Validator so a webhook will be registered for the type
-func (r *ManagedControlPlane) ValidateCreate(_ context.Context, _ *ManagedControlPlane) (admission.Warnings, error) {
-	managedcontrolplanelog.Info("validate create - this should never be triggered", "name", r.Name)
+func (r *ManagedControlPlane) ValidateCreate(ctx context.Context, obj *ManagedControlPlane) (admission.Warnings, error) {
+	managedcontrolplanelog.Info("validate create", "name", obj.Name)
 
-	// no-op
-	return nil, nil
+	return nil, validateManagedControlPlane(ctx, obj, nil)
 }
 
 // ValidateUpdate implements admission.Validator so a webhook will be registered for the type
-func (r *ManagedControlPlane) ValidateUpdate(_ context.Context, oldMcp *ManagedControlPlane, newMcp *ManagedControlPlane) (admission.Warnings, error) {
+func (r *ManagedControlPlane) ValidateUpdate(ctx context.Context, oldMcp *ManagedControlPlane, newMcp *ManagedControlPlane) (admission.Warnings, error) {
 	var errorList []error
 
 	// Add update validators here when needed
 	updateValidators := []func(*ManagedControlPlane, *ManagedControlPlane) error{
 		validateCreatedByUnchanged,
+		validateDeletionGracePeriodUnchanged,
 	}
 
 	for _, validator := range updateValidators {
@@ -69,17 +90,36 @@ func (r *ManagedControlPlane) ValidateUpdate(_ context.Context, oldMcp *ManagedC
 		}
 	}
 
-	return nil, apierrors.NewAggregate(errorList)
+	if err := validateChangedManagedControlPlane(ctx, newMcp, oldMcp); err != nil {
+		managedcontrolplanelog.Error(fmt.Errorf("update validation failed"), err.Error())
+		errorList = append(errorList, err)
+	}
+
+	return nil, utilerrors.NewAggregate(errorList)
 }
 
 // ValidateDelete implements admission.Validator so a webhook will be registered for the type
 func (r *ManagedControlPlane) ValidateDelete(_ context.Context, obj *ManagedControlPlane) (admission.Warnings, error) {
-	managedcontrolplanelog.Info("validate delete", "name", r.Name)
+	managedcontrolplanelog.Info("validate delete", "name", obj.Name)
 
-	if obj.Annotations[ManagedControlPlaneDeletionConfirmationAnnotation] == "true" {
+	if obj.Spec.Suspend != nil {
+		return nil, fmt.Errorf("ManagedControlPlane %q is suspended, it has to be resumed by removing spec.suspend before it can be deleted", obj.Name)
+	}
+	if obj.Annotations[ManagedControlPlaneDeletionConfirmationAnnotation] != "true" {
+		return nil, fmt.Errorf("ManagedControlPlane %q requires annotation %q to be set to true, before it can be deleted", obj.Name, ManagedControlPlaneDeletionConfirmationAnnotation)
+	}
+	if obj.Spec.DeletionGracePeriod == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("ManagedControlPlane %q requires annotation %q to be set to true, before it can be deleted", r.Name, ManagedControlPlaneDeletionConfirmationAnnotation)
+	// with a deletion grace period, the ManagedControlPlane controller deletes the ManagedControlPlane after the grace period has passed
+	pd := obj.Status.PendingDeletion
+	if pd == nil {
+		return nil, fmt.Errorf("ManagedControlPlane %q has a deletion grace period, it will be deleted automatically once the grace period after the deletion confirmation has passed", obj.Name)
+	}
+	if time.Now().Before(pd.DeleteAfter.Time) {
+		return nil, fmt.Errorf("ManagedControlPlane %q is pending deletion and will be deleted automatically after %s, set annotation %q to true to cancel the deletion", obj.Name, pd.DeleteAfter.UTC().Format(time.RFC3339), ManagedControlPlaneCancelDeletionAnnotation)
+	}
+	return nil, nil
 }
 
 // errCreatedByImmutable is the error that is returned when the value of the resource creator annotation has been changed by the user.
@@ -101,6 +141,42 @@ func validateCreatedByUnchanged(old, new *ManagedControlPlane) error {
 	return errCreatedByImmutable
 }
 
+// errDeletionGracePeriodImmutable is the error that is returned when the deletion grace period of a ManagedControlPlane which is pending deletion has been changed.
+var errDeletionGracePeriodImmutable = fmt.Errorf("spec.deletionGracePeriod cannot be changed or removed while the ManagedControlPlane is pending deletion, set annotation %q to true to cancel the deletion first", ManagedControlPlaneCancelDeletionAnnotation)
+
+// validateDeletionGracePeriodUnchanged checks if the deletion grace period has been changed or removed while the ManagedControlPlane is pending deletion.
+// Returns an error if the value has been changed or "nil" if it's the same or the ManagedControlPlane is not pending deletion.
+func validateDeletionGracePeriodUnchanged(old, new *ManagedControlPlane) error {
+	if old.Status.PendingDeletion == nil || equality.Semantic.DeepEqual(old.Spec.DeletionGracePeriod, new.Spec.DeletionGracePeriod) {
+		return nil
+	}
+
+	return errDeletionGracePeriodImmutable
+}
+
+// validateManagedControlPlane runs all registered validators on the given ManagedControlPlane.
+// oldMcp is nil on creation.
+// Returns an 'Invalid' error containing all validation errors, or "nil" if the ManagedControlPlane is valid.
+func validateManagedControlPlane(ctx context.Context, mcp, oldMcp *ManagedControlPlane) error {
+	allErrs := field.ErrorList{}
+	for _, validator := range managedControlPlaneValidators {
+		allErrs = append(allErrs, validator(ctx, mcp, oldMcp)...)
+	}
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind("ManagedControlPlane").GroupKind(), mcp.Name, allErrs)
+}
+
+// validateChangedManagedControlPlane validates the ManagedControlPlane, if its spec or labels have been changed.
+// Other updates, e.g. removing the finalizer of a ManagedControlPlane which has become invalid due to stricter validation, must not be blocked.
+func validateChangedManagedControlPlane(ctx context.Context, newMcp, oldMcp *ManagedControlPlane) error {
+	if !newMcp.DeletionTimestamp.IsZero() || (equality.Semantic.DeepEqual(oldMcp.Spec, newMcp.Spec) && equality.Semantic.DeepEqual(oldMcp.Labels, newMcp.Labels)) {
+		return nil
+	}
+	return validateManagedControlPlane(ctx, newMcp, oldMcp)
+}
+
 // setCreatedBy sets an annotation that contains the name of the user who created the resource.
 // The value is only set when the "Operation" is "Create".
 func setCreatedBy(obj metav1.Object, req admission.Request) {
@@ -111,6 +187,29 @@ func setCreatedBy(obj metav1.Object, req admission.Request) {
 	setMetaDataAnnotation(obj, CreatedByAnnotation, req.UserInfo.Username)
 }
 
+// setSuspendedBy sets an annotation that contains the name of the user who suspended the ManagedControlPlane.
+// The value is set when spec.suspend is set on creation or added during an update. While the ManagedControlPlane stays suspended, the original value is kept.
+// The annotation is removed if spec.suspend is not set.
+func setSuspendedBy(obj *ManagedControlPlane, req admission.Request) error {
+	if obj.Spec.Suspend == nil {
+		delete(obj.Annotations, ManagedControlPlaneSuspendedByAnnotation)
+		return nil
+	}
+
+	suspendedBy := req.UserInfo.Username
+	if req.Operation == admissionv1.Update && len(req.OldObject.Raw) > 0 {
+		oldMcp := &ManagedControlPlane{}
+		if err := json.Unmarshal(req.OldObject.Raw, oldMcp); err != nil {
+			return fmt.Errorf("error decoding old ManagedControlPlane: %w", err)
+		}
+		if oldSuspendedBy, ok := oldMcp.GetAnnotations()[ManagedControlPlaneSuspendedByAnnotation]; ok && oldMcp.Spec.Suspend != nil {
+			suspendedBy = oldSuspendedBy
+		}
+	}
+	setMetaDataAnnotation(obj, ManagedControlPlaneSuspendedByAnnotation, suspendedBy)
+	return nil
+}
+
 // setMetaDataAnnotation sets the annotation on the given object.
 // If the given Object did not yet have annotations, they are initialized.
 func setMetaDataAnnotation(meta metav1.Object, key, value string) {
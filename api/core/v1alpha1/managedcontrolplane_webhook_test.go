@@ -1,6 +1,10 @@
 package v1alpha1
 
 import (
+	"context"
+	"encoding/json"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
@@ -8,7 +12,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
@@ -47,6 +53,39 @@ var _ = Describe("ManagedControlPlane Webhook", func() {
 			err = k8sClient.Delete(ctx, mcp)
 			Expect(err).ShouldNot(HaveOccurred())
 		})
+
+		It("Should deny the deletion until the deletion grace period has passed", func() {
+			mcp := &ManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "mcp",
+					Annotations: map[string]string{ManagedControlPlaneDeletionConfirmationAnnotation: "true"},
+				},
+				Spec: ManagedControlPlaneSpec{
+					DeletionGracePeriod: &metav1.Duration{Duration: time.Hour},
+				},
+			}
+
+			// the webhook calls the validator on an empty object
+			validator := &ManagedControlPlane{}
+
+			// deletion has not been scheduled yet
+			_, err := validator.ValidateDelete(ctx, mcp)
+			Expect(err).Should(MatchError(ContainSubstring(`ManagedControlPlane "mcp" has a deletion grace period`)))
+
+			// grace period has not yet passed
+			mcp.Status.PendingDeletion = &ManagedControlPlanePendingDeletionStatus{
+				ConfirmedAt: metav1.Now(),
+				DeleteAfter: metav1.NewTime(time.Now().Add(time.Hour)),
+			}
+			_, err = validator.ValidateDelete(ctx, mcp)
+			Expect(err).Should(MatchError(ContainSubstring(`ManagedControlPlane "mcp" is pending deletion`)))
+			Expect(err).Should(MatchError(ContainSubstring(ManagedControlPlaneCancelDeletionAnnotation)))
+
+			// grace period has passed
+			mcp.Status.PendingDeletion.DeleteAfter = metav1.NewTime(time.Now().Add(-time.Minute))
+			_, err = validator.ValidateDelete(ctx, mcp)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
 	})
 
 	Context("When updating a ManagedControlPlane", func() {
@@ -170,6 +209,107 @@ var _ = Describe("ManagedControlPlane Webhook", func() {
 
 			Expect(mcp.Annotations).Should(Equal(map[string]string{CreatedByAnnotation: "john.doe@test.com"}))
 		})
+
+		It("Should record who suspended the ManagedControlPlane", func() {
+			oldMcp := &ManagedControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "mcp"}}
+			mcp := oldMcp.DeepCopy()
+			mcp.Spec.Suspend = &ManagedControlPlaneSuspension{Reason: "incident"}
+			request := func(user string, old *ManagedControlPlane) admission.Request {
+				raw, err := json.Marshal(old)
+				Expect(err).ShouldNot(HaveOccurred())
+				return admission.Request{
+					AdmissionRequest: admissionv1.AdmissionRequest{
+						Operation: admissionv1.Update,
+						UserInfo:  authv1.UserInfo{Username: user},
+						OldObject: runtime.RawExtension{Raw: raw},
+					},
+				}
+			}
+
+			Expect(setSuspendedBy(mcp, request("john.doe@test.com", oldMcp))).To(Succeed())
+			Expect(mcp.Annotations).Should(HaveKeyWithValue(ManagedControlPlaneSuspendedByAnnotation, "john.doe@test.com"))
+
+			// the original value is kept while the ManagedControlPlane stays suspended
+			oldMcp = mcp.DeepCopy()
+			mcp.Annotations[ManagedControlPlaneSuspendedByAnnotation] = "someone.else@test.com"
+			Expect(setSuspendedBy(mcp, request("jane.doe@test.com", oldMcp))).To(Succeed())
+			Expect(mcp.Annotations).Should(HaveKeyWithValue(ManagedControlPlaneSuspendedByAnnotation, "john.doe@test.com"))
+
+			// suspended ManagedControlPlanes cannot be deleted
+			mcp.Annotations[ManagedControlPlaneDeletionConfirmationAnnotation] = "true"
+			_, err := mcp.ValidateDelete(ctx, mcp)
+			Expect(err).Should(MatchError(ContainSubstring("is suspended")))
+
+			// the annotation is removed on resume
+			oldMcp = mcp.DeepCopy()
+			mcp.Spec.Suspend = nil
+			Expect(setSuspendedBy(mcp, request("jane.doe@test.com", oldMcp))).To(Succeed())
+			Expect(mcp.Annotations).ShouldNot(HaveKey(ManagedControlPlaneSuspendedByAnnotation))
+		})
+
+		It("Should deny changes to spec.deletionGracePeriod while the ManagedControlPlane is pending deletion", func() {
+			oldMcp := &ManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "mcp"},
+				Spec: ManagedControlPlaneSpec{
+					DeletionGracePeriod: &metav1.Duration{Duration: time.Hour},
+				},
+			}
+
+			// the grace period can be changed as long as the deletion has not been confirmed
+			mcp := oldMcp.DeepCopy()
+			mcp.Spec.DeletionGracePeriod.Duration = time.Minute
+			_, err := mcp.ValidateUpdate(ctx, oldMcp, mcp)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			oldMcp.Status.PendingDeletion = &ManagedControlPlanePendingDeletionStatus{
+				ConfirmedAt: metav1.Now(),
+				DeleteAfter: metav1.NewTime(time.Now().Add(time.Hour)),
+			}
+			_, err = mcp.ValidateUpdate(ctx, oldMcp, mcp)
+			Expect(err).Should(MatchError(ContainSubstring("spec.deletionGracePeriod")))
+
+			// shouldn't be removed
+			mcp.Spec.DeletionGracePeriod = nil
+			_, err = mcp.ValidateUpdate(ctx, oldMcp, mcp)
+			Expect(err).Should(MatchError(ContainSubstring("spec.deletionGracePeriod")))
+
+			// other changes are allowed
+			mcp = oldMcp.DeepCopy()
+			mcp.Annotations = map[string]string{ManagedControlPlaneCancelDeletionAnnotation: "true"}
+			_, err = mcp.ValidateUpdate(ctx, oldMcp, mcp)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("Should run the registered validators on create and on changes to spec or labels", func() {
+			oldValidators := managedControlPlaneValidators
+			defer func() { managedControlPlaneValidators = oldValidators }()
+			managedControlPlaneValidators = nil
+			RegisterManagedControlPlaneValidators(func(_ context.Context, mcp, _ *ManagedControlPlane) field.ErrorList {
+				if mcp.Spec.Components.Landscaper != nil {
+					return field.ErrorList{field.Forbidden(field.NewPath("spec", "components", "landscaper"), "landscaper is not allowed")}
+				}
+				return nil
+			})
+
+			mcp := &ManagedControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "mcp"}}
+			_, err := mcp.ValidateCreate(ctx, mcp)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			invalid := mcp.DeepCopy()
+			invalid.Spec.Components.Landscaper = &LandscaperConfiguration{}
+			_, err = mcp.ValidateCreate(ctx, invalid)
+			Expect(apierrors.IsInvalid(err)).Should(BeTrue())
+			Expect(err).Should(MatchError(ContainSubstring("spec.components.landscaper: Forbidden")))
+
+			_, err = mcp.ValidateUpdate(ctx, mcp, invalid)
+			Expect(err).Should(MatchError(ContainSubstring("spec.components.landscaper: Forbidden")))
+
+			// updates which don't change spec or labels are not blocked by an invalid spec
+			updated := invalid.DeepCopy()
+			updated.Finalizers = []string{"foo"}
+			_, err = mcp.ValidateUpdate(ctx, invalid, updated)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
 	})
 
 })
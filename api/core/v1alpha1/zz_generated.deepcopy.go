@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -130,6 +131,31 @@ func (in *APIServerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerPlacement) DeepCopyInto(out *APIServerPlacement) {
+	*out = *in
+	if in.RegionSelection != nil {
+		in, out := &in.RegionSelection, &out.RegionSelection
+		*out = new(RegionSelection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkerZones != nil {
+		in, out := &in.WorkerZones, &out.WorkerZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerPlacement.
+func (in *APIServerPlacement) DeepCopy() *APIServerPlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerPlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServerSpec) DeepCopyInto(out *APIServerSpec) {
 	*out = *in
@@ -160,7 +186,7 @@ func (in *APIServerSpec) DeepCopy() *APIServerSpec {
 func (in *APIServerStatus) DeepCopyInto(out *APIServerStatus) {
 	*out = *in
 	in.CommonComponentStatus.DeepCopyInto(&out.CommonComponentStatus)
-	out.ExternalAPIServerStatus = in.ExternalAPIServerStatus
+	in.ExternalAPIServerStatus.DeepCopyInto(&out.ExternalAPIServerStatus)
 	if in.AdminAccess != nil {
 		in, out := &in.AdminAccess, &out.AdminAccess
 		*out = new(APIServerAccess)
@@ -794,6 +820,183 @@ func (in ComponentConditionList) DeepCopy() ComponentConditionList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentDefinition) DeepCopyInto(out *ComponentDefinition) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentDefinition.
+func (in *ComponentDefinition) DeepCopy() *ComponentDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComponentDefinition) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentDefinitionList) DeepCopyInto(out *ComponentDefinitionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ComponentDefinition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentDefinitionList.
+func (in *ComponentDefinitionList) DeepCopy() *ComponentDefinitionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentDefinitionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComponentDefinitionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentDefinitionResource) DeepCopyInto(out *ComponentDefinitionResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentDefinitionResource.
+func (in *ComponentDefinitionResource) DeepCopy() *ComponentDefinitionResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentDefinitionResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentDefinitionSpec) DeepCopyInto(out *ComponentDefinitionSpec) {
+	*out = *in
+	out.Resource = in.Resource
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]ComponentType, len(*in))
+		copy(*out, *in)
+	}
+	if in.RoleAggregation != nil {
+		in, out := &in.RoleAggregation, &out.RoleAggregation
+		*out = new(ComponentRoleAggregation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentDefinitionSpec.
+func (in *ComponentDefinitionSpec) DeepCopy() *ComponentDefinitionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentDefinitionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentDefinitionStatus) DeepCopyInto(out *ComponentDefinitionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentDefinitionStatus.
+func (in *ComponentDefinitionStatus) DeepCopy() *ComponentDefinitionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentDefinitionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentProgress) DeepCopyInto(out *ComponentProgress) {
+	*out = *in
+	if in.FirstReadyTime != nil {
+		in, out := &in.FirstReadyTime, &out.FirstReadyTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentProgress.
+func (in *ComponentProgress) DeepCopy() *ComponentProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentRoleAggregation) DeepCopyInto(out *ComponentRoleAggregation) {
+	*out = *in
+	if in.ClusterAdmin != nil {
+		in, out := &in.ClusterAdmin, &out.ClusterAdmin
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClusterView != nil {
+		in, out := &in.ClusterView, &out.ClusterView
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Admin != nil {
+		in, out := &in.Admin, &out.Admin
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.View != nil {
+		in, out := &in.View, &out.View
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentRoleAggregation.
+func (in *ComponentRoleAggregation) DeepCopy() *ComponentRoleAggregation {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentRoleAggregation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CrossplaneConfig) DeepCopyInto(out *CrossplaneConfig) {
 	*out = *in
@@ -858,6 +1061,21 @@ func (in *EncryptionConfig) DeepCopy() *EncryptionConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalAPIServerStatus) DeepCopyInto(out *ExternalAPIServerStatus) {
 	*out = *in
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(APIServerPlacement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesVersion != nil {
+		in, out := &in.KubernetesVersion, &out.KubernetesVersion
+		*out = new(KubernetesVersionStatus)
+		**out = **in
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAPIServerStatus.
@@ -983,6 +1201,16 @@ func (in *GardenerConfiguration) DeepCopyInto(out *GardenerConfiguration) {
 		*out = new(EncryptionConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenerConfiguration.
@@ -1035,6 +1263,56 @@ func (in *GardenerStatus) DeepCopy() *GardenerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationConfig) DeepCopyInto(out *HibernationConfig) {
+	*out = *in
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]HibernationSchedule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationConfig.
+func (in *HibernationConfig) DeepCopy() *HibernationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationSchedule) DeepCopyInto(out *HibernationSchedule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationSchedule.
+func (in *HibernationSchedule) DeepCopy() *HibernationSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationStatus) DeepCopyInto(out *HibernationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationStatus.
+func (in *HibernationStatus) DeepCopy() *HibernationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HighAvailabilityConfig) DeepCopyInto(out *HighAvailabilityConfig) {
 	*out = *in
@@ -1192,6 +1470,21 @@ func (in *InternalConfigurationSpec) DeepCopy() *InternalConfigurationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesVersionStatus) DeepCopyInto(out *KubernetesVersionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesVersionStatus.
+func (in *KubernetesVersionStatus) DeepCopy() *KubernetesVersionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesVersionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KyvernoConfig) DeepCopyInto(out *KyvernoConfig) {
 	*out = *in
@@ -1354,6 +1647,71 @@ func (in *LocalSecretReference) DeepCopy() *LocalSecretReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceAutoUpdate) DeepCopyInto(out *MaintenanceAutoUpdate) {
+	*out = *in
+	if in.KubernetesVersion != nil {
+		in, out := &in.KubernetesVersion, &out.KubernetesVersion
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MachineImageVersion != nil {
+		in, out := &in.MachineImageVersion, &out.MachineImageVersion
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceAutoUpdate.
+func (in *MaintenanceAutoUpdate) DeepCopy() *MaintenanceAutoUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceAutoUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceConfig) DeepCopyInto(out *MaintenanceConfig) {
+	*out = *in
+	if in.TimeWindow != nil {
+		in, out := &in.TimeWindow, &out.TimeWindow
+		*out = new(MaintenanceTimeWindow)
+		**out = **in
+	}
+	if in.AutoUpdate != nil {
+		in, out := &in.AutoUpdate, &out.AutoUpdate
+		*out = new(MaintenanceAutoUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceConfig.
+func (in *MaintenanceConfig) DeepCopy() *MaintenanceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceTimeWindow) DeepCopyInto(out *MaintenanceTimeWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceTimeWindow.
+func (in *MaintenanceTimeWindow) DeepCopy() *MaintenanceTimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceTimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedComponent) DeepCopyInto(out *ManagedComponent) {
 	*out = *in
@@ -1505,6 +1863,11 @@ func (in *ManagedControlPlaneComponents) DeepCopyInto(out *ManagedControlPlaneCo
 		(*in).DeepCopyInto(*out)
 	}
 	in.CloudOrchestratorConfiguration.DeepCopyInto(&out.CloudOrchestratorConfiguration)
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedControlPlaneComponents.
@@ -1523,7 +1886,7 @@ func (in *ManagedControlPlaneComponentsStatus) DeepCopyInto(out *ManagedControlP
 	if in.APIServer != nil {
 		in, out := &in.APIServer, &out.APIServer
 		*out = new(ExternalAPIServerStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Landscaper != nil {
 		in, out := &in.Landscaper, &out.Landscaper
@@ -1557,6 +1920,32 @@ func (in *ManagedControlPlaneComponentsStatus) DeepCopy() *ManagedControlPlaneCo
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedControlPlaneDeletionStatus) DeepCopyInto(out *ManagedControlPlaneDeletionStatus) {
+	*out = *in
+	if in.Stage != nil {
+		in, out := &in.Stage, &out.Stage
+		*out = make([]ComponentType, len(*in))
+		copy(*out, *in)
+	}
+	in.StageStartTime.DeepCopyInto(&out.StageStartTime)
+	if in.Waiting != nil {
+		in, out := &in.Waiting, &out.Waiting
+		*out = make([]ComponentType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedControlPlaneDeletionStatus.
+func (in *ManagedControlPlaneDeletionStatus) DeepCopy() *ManagedControlPlaneDeletionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedControlPlaneDeletionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedControlPlaneList) DeepCopyInto(out *ManagedControlPlaneList) {
 	*out = *in
@@ -1604,6 +1993,72 @@ func (in *ManagedControlPlaneMetaStatus) DeepCopy() *ManagedControlPlaneMetaStat
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedControlPlanePendingDeletionStatus) DeepCopyInto(out *ManagedControlPlanePendingDeletionStatus) {
+	*out = *in
+	in.ConfirmedAt.DeepCopyInto(&out.ConfirmedAt)
+	in.DeleteAfter.DeepCopyInto(&out.DeleteAfter)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedControlPlanePendingDeletionStatus.
+func (in *ManagedControlPlanePendingDeletionStatus) DeepCopy() *ManagedControlPlanePendingDeletionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedControlPlanePendingDeletionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedControlPlaneProgress) DeepCopyInto(out *ManagedControlPlaneProgress) {
+	*out = *in
+	in.PhaseTransitionTime.DeepCopyInto(&out.PhaseTransitionTime)
+	if in.FirstReadyTime != nil {
+		in, out := &in.FirstReadyTime, &out.FirstReadyTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ComponentProgress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RemainingSteps != nil {
+		in, out := &in.RemainingSteps, &out.RemainingSteps
+		*out = make([]ManagedControlPlaneStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedControlPlaneProgress.
+func (in *ManagedControlPlaneProgress) DeepCopy() *ManagedControlPlaneProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedControlPlaneProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedControlPlaneReadinessGate) DeepCopyInto(out *ManagedControlPlaneReadinessGate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedControlPlaneReadinessGate.
+func (in *ManagedControlPlaneReadinessGate) DeepCopy() *ManagedControlPlaneReadinessGate {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedControlPlaneReadinessGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedControlPlaneSpec) DeepCopyInto(out *ManagedControlPlaneSpec) {
 	*out = *in
@@ -1628,6 +2083,21 @@ func (in *ManagedControlPlaneSpec) DeepCopyInto(out *ManagedControlPlaneSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Components.DeepCopyInto(&out.Components)
+	if in.ReadinessGates != nil {
+		in, out := &in.ReadinessGates, &out.ReadinessGates
+		*out = make([]ManagedControlPlaneReadinessGate, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeletionGracePeriod != nil {
+		in, out := &in.DeletionGracePeriod, &out.DeletionGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(ManagedControlPlaneSuspension)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedControlPlaneSpec.
@@ -1652,6 +2122,26 @@ func (in *ManagedControlPlaneStatus) DeepCopyInto(out *ManagedControlPlaneStatus
 		}
 	}
 	in.Components.DeepCopyInto(&out.Components)
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(ManagedControlPlaneProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PendingDeletion != nil {
+		in, out := &in.PendingDeletion, &out.PendingDeletion
+		*out = new(ManagedControlPlanePendingDeletionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Suspension != nil {
+		in, out := &in.Suspension, &out.Suspension
+		*out = new(ManagedControlPlaneSuspensionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Deletion != nil {
+		in, out := &in.Deletion, &out.Deletion
+		*out = new(ManagedControlPlaneDeletionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedControlPlaneStatus.
@@ -1664,6 +2154,57 @@ func (in *ManagedControlPlaneStatus) DeepCopy() *ManagedControlPlaneStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedControlPlaneStep) DeepCopyInto(out *ManagedControlPlaneStep) {
+	*out = *in
+	if in.BlockedBy != nil {
+		in, out := &in.BlockedBy, &out.BlockedBy
+		*out = make([]ComponentType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedControlPlaneStep.
+func (in *ManagedControlPlaneStep) DeepCopy() *ManagedControlPlaneStep {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedControlPlaneStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedControlPlaneSuspension) DeepCopyInto(out *ManagedControlPlaneSuspension) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedControlPlaneSuspension.
+func (in *ManagedControlPlaneSuspension) DeepCopy() *ManagedControlPlaneSuspension {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedControlPlaneSuspension)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedControlPlaneSuspensionStatus) DeepCopyInto(out *ManagedControlPlaneSuspensionStatus) {
+	*out = *in
+	in.SuspendedAt.DeepCopyInto(&out.SuspendedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedControlPlaneSuspensionStatus.
+func (in *ManagedControlPlaneSuspensionStatus) DeepCopy() *ManagedControlPlaneSuspensionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedControlPlaneSuspensionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespacedObjectReference) DeepCopyInto(out *NamespacedObjectReference) {
 	*out = *in
@@ -1694,6 +2235,31 @@ func (in *ObservedGenerations) DeepCopy() *ObservedGenerations {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionSelection) DeepCopyInto(out *RegionSelection) {
+	*out = *in
+	if in.DesiredRegion != nil {
+		in, out := &in.DesiredRegion, &out.DesiredRegion
+		*out = new(RegionSpecification)
+		**out = **in
+	}
+	if in.Distance != nil {
+		in, out := &in.Distance, &out.Distance
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionSelection.
+func (in *RegionSelection) DeepCopy() *RegionSelection {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionSelection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegionSpecification) DeepCopyInto(out *RegionSpecification) {
 	*out = *in
@@ -11,10 +11,12 @@ import (
 
 	mcpocfg "github.com/openmcp-project/mcp-operator/internal/config"
 	apiservercontroller "github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver"
+	apiservergardener "github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/handler/gardener"
 	authenticationcontroller "github.com/openmcp-project/mcp-operator/internal/controller/core/authentication"
 	authorizationcontroller "github.com/openmcp-project/mcp-operator/internal/controller/core/authorization"
 	clusteradmincontroller "github.com/openmcp-project/mcp-operator/internal/controller/core/authorization/clusteradmin"
 	cloudorchestratorcontroller "github.com/openmcp-project/mcp-operator/internal/controller/core/cloudorchestrator"
+	componentdefinitioncontroller "github.com/openmcp-project/mcp-operator/internal/controller/core/componentdefinition"
 	landscapercontroller "github.com/openmcp-project/mcp-operator/internal/controller/core/landscaper"
 	mcpcontroller "github.com/openmcp-project/mcp-operator/internal/controller/core/managedcontrolplane"
 
@@ -24,6 +26,7 @@ import (
 	v2install "github.com/openmcp-project/openmcp-operator/api/install"
 	lsv2install "github.com/openmcp-project/service-provider-landscaper/api/install"
 
+	gcpv1alpha1 "github.com/openmcp-project/cluster-provider-gardener/api/core/v1alpha1"
 	cocorev1beta1 "github.com/openmcp-project/control-plane-operator/api/v1beta1"
 	"github.com/openmcp-project/controller-utils/pkg/init/webhooks"
 	"github.com/openmcp-project/controller-utils/pkg/logging"
@@ -32,6 +35,7 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/ptr"
 
@@ -43,6 +47,7 @@ import (
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	ctrlcfg "sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
@@ -216,6 +221,11 @@ func (o *Options) runInit(ctx context.Context) error {
 					Name:       "oldArchLabel",
 					Expression: fmt.Sprintf(`(oldObject != null && has(oldObject.metadata.labels) && "%s" in oldObject.metadata.labels) ? oldObject.metadata.labels["%s"] : ""`, openmcpv1alpha1.ArchitectureVersionLabel, openmcpv1alpha1.ArchitectureVersionLabel),
 				},
+				{
+					// switching from v1 to v2 is allowed for components which are being migrated, see the migration annotation
+					Name:       "migrationRequested",
+					Expression: fmt.Sprintf(`oldObject != null && has(object.metadata.annotations) && "%[1]s" in object.metadata.annotations && object.metadata.annotations["%[1]s"] == "%[2]s" && has(oldObject.metadata.annotations) && "%[1]s" in oldObject.metadata.annotations && oldObject.metadata.annotations["%[1]s"] == "%[2]s"`, openmcpv1alpha1.ArchitectureMigrationAnnotation, openmcpv1alpha1.ArchitectureV2),
+				},
 			},
 			Validations: []admissionv1.Validation{
 				{
@@ -223,8 +233,8 @@ func (o *Options) runInit(ctx context.Context) error {
 					Message:    fmt.Sprintf(`The label "%s" must be set and its value must be either "%s" or "%s".`, openmcpv1alpha1.ArchitectureVersionLabel, openmcpv1alpha1.ArchitectureV1, openmcpv1alpha1.ArchitectureV2),
 				},
 				{
-					Expression: fmt.Sprintf(`request.operation == "CREATE" || (variables.oldArchLabel == "" && variables.archLabel == "%s") || (variables.oldArchLabel == variables.archLabel)`, openmcpv1alpha1.ArchitectureV1),
-					Message:    fmt.Sprintf(`The label "%s" is immutable, it may not be changed or removed once set. Adding it to existing resources is only allowed with "%s" as value.`, openmcpv1alpha1.ArchitectureVersionLabel, openmcpv1alpha1.ArchitectureV1),
+					Expression: fmt.Sprintf(`request.operation == "CREATE" || (variables.oldArchLabel == "" && variables.archLabel == "%s") || (variables.oldArchLabel == variables.archLabel) || (variables.migrationRequested && (variables.oldArchLabel == "" || variables.oldArchLabel == "%s") && variables.archLabel == "%s")`, openmcpv1alpha1.ArchitectureV1, openmcpv1alpha1.ArchitectureV1, openmcpv1alpha1.ArchitectureV2),
+					Message:    fmt.Sprintf(`The label "%s" is immutable, it may not be changed or removed once set. Adding it to existing resources is only allowed with "%s" as value. Switching it from "%s" to "%s" is only allowed during a migration, which is requested via the "%s" annotation.`, openmcpv1alpha1.ArchitectureVersionLabel, openmcpv1alpha1.ArchitectureV1, openmcpv1alpha1.ArchitectureV1, openmcpv1alpha1.ArchitectureV2, openmcpv1alpha1.ArchitectureMigrationAnnotation),
 				},
 			},
 		})
@@ -236,6 +246,11 @@ func (o *Options) runInit(ctx context.Context) error {
 			return fmt.Errorf("error creating/updating ValidatingAdmissionPolicy for architecture immutability: %w", err)
 		}
 
+		// the binding covers the resources of the built-in components and of all other components for which a v1-v2 bridge exists
+		bridgedResources, err := bridgedComponentResources(crateClient)
+		if err != nil {
+			return fmt.Errorf("error determining resources for architecture immutability: %w", err)
+		}
 		vapbm := resources.NewValidatingAdmissionPolicyBindingMutator(mcpocfg.Config.Architecture.Immutability.PolicyName, admissionv1.ValidatingAdmissionPolicyBindingSpec{
 			PolicyName: mcpocfg.Config.Architecture.Immutability.PolicyName,
 			ValidationActions: []admissionv1.ValidationAction{
@@ -252,13 +267,7 @@ func (o *Options) runInit(ctx context.Context) error {
 							Rule: admissionv1.Rule{
 								APIGroups:   []string{openmcpv1alpha1.GroupVersion.Group},
 								APIVersions: []string{openmcpv1alpha1.GroupVersion.Version},
-								Resources: []string{
-									"apiservers",
-									"landscapers",
-									"cloudorchestrators",
-									"authentications",
-									"authorizations",
-								},
+								Resources:   bridgedResources,
 							},
 						},
 					},
@@ -298,6 +307,7 @@ func (o *Options) run(ctx context.Context) error {
 	openmcpinstall.Install(sc)
 	utilruntime.Must(clientgoscheme.AddToScheme(sc))
 	lsv2install.InstallProviderAPIs(sc)
+	v2install.InstallOperatorAPIsOnboarding(sc)
 	mgr, err := ctrl.NewManager(o.CrateClusterConfig, ctrl.Options{
 		Scheme: sc,
 		Metrics: server.Options{
@@ -338,29 +348,54 @@ func (o *Options) run(ctx context.Context) error {
 
 	// run WebHooks if configured
 	if o.WebhooksFlags.Install {
+		validators := []openmcpv1alpha1.ManagedControlPlaneValidator{mcpcontroller.ValidateManagedControlPlane}
+		if o.ActiveControllers.Has(ControllerIDCloudOrchestrator) {
+			// the release channels are only mirrored into ManagedComponents if the CloudOrchestrator controller is active
+			validators = append(validators, releasechannel.NewVersionValidator(mgr.GetClient()).Validate)
+		}
+		if o.ActiveControllers.Has(ControllerIDAPIServer) && o.APIServerConfig.GardenerConfig != nil {
+			// the maintenance bounds are part of the APIServer provider config, which is only loaded if the APIServer controller is active
+			validators = append(validators, apiservergardener.NewMaintenanceValidator(mgr.GetClient(), o.APIServerConfig.GardenerConfig).Validate)
+		}
+		openmcpv1alpha1.RegisterManagedControlPlaneValidators(validators...)
 		if err := (&openmcpv1alpha1.ManagedControlPlane{}).SetupWebhookWithManager(mgr); err != nil {
 			return fmt.Errorf("failed to setup webhook: %w", err)
 		}
 	}
 
+	var onComponentRegistered func(components.Component) error
 	if o.ActiveControllers.Has(ControllerIDManagedControlPlane) {
 		// ManagedControlPlane controller
 		cpc := mcpcontroller.NewManagedControlPlaneController(mgr.GetClient())
 		if err := cpc.SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("error adding controller '%s' to manager: %w", mcpcontroller.ControllerName, err)
 		}
+		onComponentRegistered = cpc.WatchComponent
 	}
 
-	if o.ActiveControllers.Has(ControllerIDAPIServer) {
-		// APIServer controller
-		// build platform cluster client for v2 path
+	// ComponentDefinition controller
+	// it is always active, because the components registered by it are used by the ManagedControlPlane controller, the Authorization controller, and the webhooks
+	if err := componentdefinitioncontroller.NewComponentDefinitionReconciler(mgr.GetClient(), mgr.GetRESTMapper(), onComponentRegistered).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("error adding controller '%s' to manager: %w", componentdefinitioncontroller.ControllerName, err)
+	}
+
+	// platform cluster client for the v2 path
+	// it is shared by the APIServer and the CloudOrchestrator controllers
+	var platformClient client.Client
+	if o.LaaSClusterConfig != nil || o.ActiveControllers.Has(ControllerIDAPIServer) {
 		v2scheme := v2install.InstallOperatorAPIsPlatform(runtime.NewScheme())
-		platformClient, err := client.New(o.LaaSClusterConfig, client.Options{
+		// the Gardener cluster provider's resources are read to verify the cluster profile used for migrations
+		utilruntime.Must(gcpv1alpha1.AddToScheme(v2scheme))
+		platformClient, err = client.New(o.LaaSClusterConfig, client.Options{
 			Scheme: v2scheme,
 		})
 		if err != nil {
 			return fmt.Errorf("error creating platform cluster client: %w", err)
 		}
+	}
+
+	if o.ActiveControllers.Has(ControllerIDAPIServer) {
+		// APIServer controller
 		apiServerProvider, err := apiservercontroller.NewAPIServerProvider(ctx, mgr.GetClient(), platformClient, o.APIServerConfig)
 		if err != nil {
 			return fmt.Errorf("error creating %s: %w", apiservercontroller.ControllerName, err)
@@ -411,7 +446,7 @@ func (o *Options) run(ctx context.Context) error {
 			return fmt.Errorf("error adding core cluster to manager: %w", err)
 		}
 		// add controller
-		if err := cloudorchestratorcontroller.NewCloudOrchestratorController(mgr.GetClient(), cloudOrchestratorClient, coreCluster).SetupWithManager(mgr); err != nil {
+		if err := cloudorchestratorcontroller.NewCloudOrchestratorController(mgr.GetClient(), cloudOrchestratorClient, platformClient, coreCluster).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("error adding controller '%s' to manager: %w", cloudorchestratorcontroller.ControllerName, err)
 		}
 
@@ -465,3 +500,31 @@ func (o *Options) run(ctx context.Context) error {
 
 	return nil
 }
+
+// bridgedComponentResources returns the plural resource names of the built-in components and of all other components for which a v1-v2 bridge exists.
+// The resources of the built-in components are always contained, independent of the bridge configuration.
+func bridgedComponentResources(c client.Client) ([]string, error) {
+	res := sets.New(
+		"apiservers",
+		"landscapers",
+		"cloudorchestrators",
+		"authentications",
+		"authorizations",
+	)
+	for _, ct := range mcpocfg.Config.Architecture.BridgedComponents() {
+		ch := components.Registry.GetComponent(ct)
+		if ch == nil {
+			continue
+		}
+		gvk, err := apiutil.GVKForObject(ch.Resource(), components.Registry.Scheme())
+		if err != nil {
+			return nil, fmt.Errorf("error determining GroupVersionKind of component '%s': %w", string(ct), err)
+		}
+		mapping, err := c.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return nil, fmt.Errorf("error determining resource of component '%s': %w", string(ct), err)
+		}
+		res.Insert(mapping.Resource.Resource)
+	}
+	return sets.List(res), nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/config"
 	configauthn "github.com/openmcp-project/mcp-operator/internal/controller/core/authentication/config"
 	configauthz "github.com/openmcp-project/mcp-operator/internal/controller/core/authorization/config"
+	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
 
 	colactrlutil "github.com/openmcp-project/controller-utils/pkg/controller"
 	"github.com/openmcp-project/controller-utils/pkg/init/crds"
@@ -259,6 +260,10 @@ func (o *Options) Complete() error {
 	o.ActiveControllers = sets.New(strings.Split(o.ControllerList, ",")...)
 	// remove empty string, if part of active controllers
 	delete(o.ActiveControllers, "")
+	// verify the declared component dependencies before any components are unregistered
+	if err := componentutils.ValidateDependencies(components.Registry); err != nil {
+		return fmt.Errorf("invalid component dependencies: %w", err)
+	}
 	// unregister components for inactive controllers
 	for ct := range components.Registry.GetKnownComponents() {
 		if !o.ActiveControllers.Has(strings.ToLower(string(ct))) {
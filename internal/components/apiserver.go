@@ -3,6 +3,8 @@ package components
 import (
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
 	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
 	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
 )
@@ -11,6 +13,9 @@ import (
 
 type APIServerConverter struct{}
 
+// apiServerPath is the path of the APIServer configuration in the ManagedControlPlane.
+var apiServerPath = field.NewPath("spec", "components", "apiServer")
+
 var _ Component = &openmcpv1alpha1.APIServer{}
 var _ ComponentConverter = &APIServerConverter{}
 
@@ -36,13 +41,27 @@ func (*APIServerConverter) ConvertToResourceSpec(mcp *openmcpv1alpha1.ManagedCon
 	}
 
 	res.Default()
-	if err := res.Validate("spec", "apiserver"); err != nil {
-		return nil, fmt.Errorf("invalid APIServer configuration: %w", err)
+	if errs := res.Validate(apiServerPath); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid APIServer configuration: %w", errs.ToAggregate())
 	}
 
 	return res, nil
 }
 
+// ValidateConfiguration implements ComponentConverter.
+func (*APIServerConverter) ValidateConfiguration(mcp *openmcpv1alpha1.ManagedControlPlane) field.ErrorList {
+	apiServerConfig := mcp.Spec.Components.APIServer
+	if apiServerConfig == nil {
+		return field.ErrorList{field.Required(apiServerPath, "APIServer configuration is missing")}
+	}
+
+	res := &openmcpv1alpha1.APIServerSpec{
+		APIServerConfiguration: *apiServerConfig.DeepCopy(),
+	}
+	res.Default()
+	return res.Validate(apiServerPath)
+}
+
 // InjectStatus implements ComponentConverter.
 func (*APIServerConverter) InjectStatus(raw any, mcpStatus *openmcpv1alpha1.ManagedControlPlaneStatus) error {
 	status, ok := raw.(openmcpv1alpha1.ExternalAPIServerStatus)
@@ -94,6 +94,93 @@ var _ = Describe("APIServerConverter", func() {
 			Expect(apiServerSpecT.DesiredRegion).To(Equal(mcp.Spec.DesiredRegion))
 		})
 
+		It("should return an error if the maintenance time window is invalid", func() {
+			conv := &components.APIServerConverter{}
+			mcp := &openmcpv1alpha1.ManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "test",
+				},
+				Spec: openmcpv1alpha1.ManagedControlPlaneSpec{
+					Components: openmcpv1alpha1.ManagedControlPlaneComponents{
+						APIServer: &openmcpv1alpha1.APIServerConfiguration{
+							Type: openmcpv1alpha1.Gardener,
+							GardenerConfig: &openmcpv1alpha1.GardenerConfiguration{
+								Maintenance: &openmcpv1alpha1.MaintenanceConfig{
+									TimeWindow: &openmcpv1alpha1.MaintenanceTimeWindow{
+										Begin:     "22:00",
+										End:       "22:15",
+										UTCOffset: "Europe/Berlin",
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			apiServerSpec, err := conv.ConvertToResourceSpec(mcp, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.components.apiServer.gardener.maintenance.timeWindow: Invalid value"))
+			Expect(err.Error()).To(ContainSubstring("spec.components.apiServer.gardener.maintenance.timeWindow.utcOffset"))
+			Expect(apiServerSpec).To(BeNil())
+
+			mcp.Spec.Components.APIServer.GardenerConfig.Maintenance.TimeWindow.End = "01:00"
+			mcp.Spec.Components.APIServer.GardenerConfig.Maintenance.TimeWindow.UTCOffset = "+01:00"
+			_, err = conv.ConvertToResourceSpec(mcp, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			mcp.Spec.Components.APIServer.GardenerConfig.Maintenance.TimeWindow.Location = "Europe/Berlin"
+			_, err = conv.ConvertToResourceSpec(mcp, nil)
+			Expect(err).To(MatchError(ContainSubstring("spec.components.apiServer.gardener.maintenance.timeWindow.utcOffset: Forbidden")))
+
+			mcp.Spec.Components.APIServer.GardenerConfig.Maintenance.TimeWindow.UTCOffset = ""
+			_, err = conv.ConvertToResourceSpec(mcp, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			mcp.Spec.Components.APIServer.GardenerConfig.Maintenance.TimeWindow.Location = "Europe/Nowhere"
+			_, err = conv.ConvertToResourceSpec(mcp, nil)
+			Expect(err).To(MatchError(ContainSubstring("spec.components.apiServer.gardener.maintenance.timeWindow.location: Invalid value")))
+		})
+
+		It("should return an error if the hibernation configuration is invalid", func() {
+			conv := &components.APIServerConverter{}
+			mcp := &openmcpv1alpha1.ManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "test",
+				},
+				Spec: openmcpv1alpha1.ManagedControlPlaneSpec{
+					Components: openmcpv1alpha1.ManagedControlPlaneComponents{
+						APIServer: &openmcpv1alpha1.APIServerConfiguration{
+							Type: openmcpv1alpha1.Gardener,
+							GardenerConfig: &openmcpv1alpha1.GardenerConfiguration{
+								Hibernation: &openmcpv1alpha1.HibernationConfig{
+									Schedules: []openmcpv1alpha1.HibernationSchedule{
+										{Start: "0 20 * *", End: "0 7 * * 1-5"},
+										{},
+									},
+									Location: "Middle/Earth",
+								},
+							},
+						},
+					},
+				},
+			}
+
+			apiServerSpec, err := conv.ConvertToResourceSpec(mcp, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.components.apiServer.gardener.hibernation.schedules[0].start: Invalid value"))
+			Expect(err.Error()).To(ContainSubstring("spec.components.apiServer.gardener.hibernation.schedules[1]: Required value"))
+			Expect(err.Error()).To(ContainSubstring("spec.components.apiServer.gardener.hibernation.location: Invalid value"))
+			Expect(apiServerSpec).To(BeNil())
+
+			mcp.Spec.Components.APIServer.GardenerConfig.Hibernation.Schedules = []openmcpv1alpha1.HibernationSchedule{{Start: "0 20 * * MON-FRI", End: "30 6,7 * * */2"}}
+			mcp.Spec.Components.APIServer.GardenerConfig.Hibernation.Location = "Europe/Berlin"
+			_, err = conv.ConvertToResourceSpec(mcp, nil)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		It("should return an error if the spec is not configured", func() {
 			conv := &components.APIServerConverter{}
 			mcp := &openmcpv1alpha1.ManagedControlPlane{
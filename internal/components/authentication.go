@@ -3,12 +3,17 @@ package components
 import (
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
 	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
 	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
 )
 
 type AuthenticationConverter struct{}
 
+// authenticationPath is the path of the Authentication configuration in the ManagedControlPlane.
+var authenticationPath = field.NewPath("spec", "authentication")
+
 var _ Component = &openmcpv1alpha1.Authentication{}
 var _ ComponentConverter = &AuthenticationConverter{}
 
@@ -24,13 +29,27 @@ func (ac *AuthenticationConverter) ConvertToResourceSpec(mcp *openmcpv1alpha1.Ma
 	}
 
 	res.Default()
-	if err := res.Validate("spec", "authentication"); err != nil {
-		return nil, fmt.Errorf("invalid Authentication configuration: %w", err)
+	if errs := res.Validate(authenticationPath); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid Authentication configuration: %w", errs.ToAggregate())
 	}
 
 	return res, nil
 }
 
+// ValidateConfiguration implements ComponentConverter.
+func (ac *AuthenticationConverter) ValidateConfiguration(mcp *openmcpv1alpha1.ManagedControlPlane) field.ErrorList {
+	acConfig := mcp.Spec.Authentication
+	if acConfig == nil {
+		acConfig = &openmcpv1alpha1.AuthenticationConfiguration{}
+	}
+
+	res := &openmcpv1alpha1.AuthenticationSpec{
+		AuthenticationConfiguration: *acConfig.DeepCopy(),
+	}
+	res.Default()
+	return res.Validate(authenticationPath)
+}
+
 // InjectStatus implements ComponentConverter.
 func (ac *AuthenticationConverter) InjectStatus(raw any, mcpStatus *openmcpv1alpha1.ManagedControlPlaneStatus) error {
 	status, ok := raw.(openmcpv1alpha1.ExternalAuthenticationStatus)
@@ -3,12 +3,17 @@ package components
 import (
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
 	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
 	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
 )
 
 type AuthorizationConverter struct{}
 
+// authorizationPath is the path of the Authorization configuration in the ManagedControlPlane.
+var authorizationPath = field.NewPath("spec", "authorization")
+
 var _ Component = &openmcpv1alpha1.Authorization{}
 var _ ComponentConverter = &AuthorizationConverter{}
 
@@ -24,13 +29,27 @@ func (ac *AuthorizationConverter) ConvertToResourceSpec(mcp *openmcpv1alpha1.Man
 	}
 
 	res.Default()
-	if err := res.Validate("spec", "authorization"); err != nil {
-		return nil, fmt.Errorf("invalid Authorization configuration: %w", err)
+	if errs := res.Validate(authorizationPath); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid Authorization configuration: %w", errs.ToAggregate())
 	}
 
 	return res, nil
 }
 
+// ValidateConfiguration implements ComponentConverter.
+func (ac *AuthorizationConverter) ValidateConfiguration(mcp *openmcpv1alpha1.ManagedControlPlane) field.ErrorList {
+	acConfig := mcp.Spec.Authorization
+	if acConfig == nil {
+		return field.ErrorList{field.Required(authorizationPath, "authorization configuration is missing")}
+	}
+
+	res := &openmcpv1alpha1.AuthorizationSpec{
+		AuthorizationConfiguration: *acConfig.DeepCopy(),
+	}
+	res.Default()
+	return res.Validate(authorizationPath)
+}
+
 // InjectStatus implements ComponentConverter.
 func (ac *AuthorizationConverter) InjectStatus(raw any, mcpStatus *openmcpv1alpha1.ManagedControlPlaneStatus) error {
 	status, ok := raw.(openmcpv1alpha1.ExternalAuthorizationStatus)
@@ -3,6 +3,8 @@ package components
 import (
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
 	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
 	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
 )
@@ -15,6 +17,10 @@ var _ ComponentConverter = &CloudOrchestratorConverter{}
 // +kubebuilder:object:generate=false
 type CloudOrchestratorConverter struct{}
 
+// cloudOrchestratorPath is the path of the CloudOrchestrator configuration in the ManagedControlPlane.
+// The configuration is inlined into the components.
+var cloudOrchestratorPath = field.NewPath("spec", "components")
+
 // ConvertToResourceSpec implements ComponentConverter.
 func (*CloudOrchestratorConverter) ConvertToResourceSpec(mcp *openmcpv1alpha1.ManagedControlPlane, _ *openmcpv1alpha1.InternalConfiguration) (any, error) {
 	coCfg := mcp.Spec.Components.CloudOrchestratorConfiguration
@@ -23,13 +29,22 @@ func (*CloudOrchestratorConverter) ConvertToResourceSpec(mcp *openmcpv1alpha1.Ma
 	}
 
 	res.Default()
-	if err := res.Validate("spec", "cloudorchestrator"); err != nil {
-		return nil, fmt.Errorf("invalid CloudOrchestrator configuration: %w", err)
+	if errs := res.Validate(cloudOrchestratorPath); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid CloudOrchestrator configuration: %w", errs.ToAggregate())
 	}
 
 	return res, nil
 }
 
+// ValidateConfiguration implements ComponentConverter.
+func (*CloudOrchestratorConverter) ValidateConfiguration(mcp *openmcpv1alpha1.ManagedControlPlane) field.ErrorList {
+	res := &openmcpv1alpha1.CloudOrchestratorSpec{
+		CloudOrchestratorConfiguration: *mcp.Spec.Components.CloudOrchestratorConfiguration.DeepCopy(),
+	}
+	res.Default()
+	return res.Validate(cloudOrchestratorPath)
+}
+
 // InjectStatus implements ComponentConverter.
 func (*CloudOrchestratorConverter) InjectStatus(raw any, mcpStatus *openmcpv1alpha1.ManagedControlPlaneStatus) error {
 	status, ok := raw.(openmcpv1alpha1.ExternalCloudOrchestratorStatus)
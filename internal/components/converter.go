@@ -1,6 +1,8 @@
 package components
 
 import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
 	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
 )
 
@@ -10,6 +12,11 @@ type ComponentConverter interface {
 	// The result of this function will be fed into the SetSpec function by the ManagedControlPlane controller.
 	ConvertToResourceSpec(mcp *openmcpv1alpha1.ManagedControlPlane, ic *openmcpv1alpha1.InternalConfiguration) (any, error)
 
+	// ValidateConfiguration validates the configuration of this component in the given ManagedControlPlane.
+	// It is used by the ManagedControlPlane webhook and only called if the component is configured in the ManagedControlPlane.
+	// The field paths of the returned errors refer to the ManagedControlPlane.
+	ValidateConfiguration(mcp *openmcpv1alpha1.ManagedControlPlane) field.ErrorList
+
 	// IsConfigured returns true if the given ManagedControlPlane contains configuration for this component.
 	IsConfigured(mcp *openmcpv1alpha1.ManagedControlPlane) bool
 
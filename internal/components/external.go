@@ -0,0 +1,222 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
+)
+
+var _ Component = &ExternalComponent{}
+var _ ComponentConverter = &ExternalComponentConverter{}
+
+// ExternalComponent is the in-cluster resource of a component which has been registered via a ComponentDefinition.
+// Since the operator doesn't know the resource's type, it is handled as unstructured object.
+// The resource is expected to have the conditions and observed generations in its status, like the built-in components.
+type ExternalComponent struct {
+	*unstructured.Unstructured
+	// ComponentType is the type of the component, as specified in the ComponentDefinition.
+	// It is exported, because objects are compared via reflection, e.g. by controllerutil.CreateOrUpdate.
+	ComponentType openmcpv1alpha1.ComponentType `json:"-"`
+}
+
+// NewExternalComponent returns an empty ExternalComponent of the given type with the given GroupVersionKind.
+func NewExternalComponent(ct openmcpv1alpha1.ComponentType, gvk schema.GroupVersionKind) *ExternalComponent {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	return &ExternalComponent{
+		Unstructured:  u,
+		ComponentType: ct,
+	}
+}
+
+// DeepCopyObject implements runtime.Object.
+// It needs to be overwritten, because the one of the embedded Unstructured would return an *unstructured.Unstructured.
+func (ec *ExternalComponent) DeepCopyObject() runtime.Object {
+	return &ExternalComponent{
+		Unstructured:  ec.Unstructured.DeepCopy(),
+		ComponentType: ec.ComponentType,
+	}
+}
+
+// Type implements Component.
+func (ec *ExternalComponent) Type() openmcpv1alpha1.ComponentType {
+	return ec.ComponentType
+}
+
+// GetSpec implements Component.
+// It returns the spec of the resource as map.
+func (ec *ExternalComponent) GetSpec() any {
+	spec, _, _ := unstructured.NestedMap(ec.Object, "spec")
+	return spec
+}
+
+// SetSpec implements Component.
+// It expects a map as returned by the ExternalComponentConverter.
+func (ec *ExternalComponent) SetSpec(cfg any) error {
+	spec, ok := cfg.(map[string]any)
+	if !ok {
+		return openmcperrors.ErrWrongComponentConfigType
+	}
+	if ec.Object == nil {
+		ec.Object = map[string]any{}
+	}
+	ec.Object["spec"] = runtime.DeepCopyJSON(spec)
+	return nil
+}
+
+// GetCommonStatus implements Component.
+// Fields which cannot be converted are ignored.
+func (ec *ExternalComponent) GetCommonStatus() openmcpv1alpha1.CommonComponentStatus {
+	res := openmcpv1alpha1.CommonComponentStatus{}
+	status, _, _ := unstructured.NestedMap(ec.Object, "status")
+	if status == nil {
+		return res
+	}
+	common := map[string]any{}
+	for _, key := range commonStatusKeys {
+		if v, ok := status[key]; ok {
+			common[key] = v
+		}
+	}
+	_ = runtime.DefaultUnstructuredConverter.FromUnstructured(common, &res)
+	return res
+}
+
+// SetCommonStatus implements Component.
+func (ec *ExternalComponent) SetCommonStatus(status openmcpv1alpha1.CommonComponentStatus) {
+	common, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&status)
+	if err != nil {
+		return
+	}
+	if ec.Object == nil {
+		ec.Object = map[string]any{}
+	}
+	current, _, _ := unstructured.NestedMap(ec.Object, "status")
+	if current == nil {
+		current = map[string]any{}
+	}
+	for _, key := range commonStatusKeys {
+		if v, ok := common[key]; ok {
+			current[key] = v
+		} else {
+			delete(current, key)
+		}
+	}
+	ec.Object["status"] = current
+}
+
+// GetExternalStatus implements Component.
+// External components cannot contribute to the ManagedControlPlane's status apart from their conditions, so this returns nil.
+func (ec *ExternalComponent) GetExternalStatus() any {
+	return nil
+}
+
+// GetRequiredConditions implements Component.
+func (ec *ExternalComponent) GetRequiredConditions() sets.Set[string] {
+	return sets.New(ec.Type().HealthyCondition(), ec.Type().ReconciliationCondition())
+}
+
+// commonStatusKeys are the json keys of the fields of openmcpv1alpha1.CommonComponentStatus.
+var commonStatusKeys = []string{"conditions", "observedGenerations"}
+
+// ExternalComponentConverter is the ComponentConverter for components which have been registered via a ComponentDefinition.
+// It copies the configuration from the ManagedControlPlane's spec into the spec of the component's resource without any modifications.
+type ExternalComponentConverter struct {
+	// SpecPath is the path to the component's configuration in the ManagedControlPlane's spec.
+	SpecPath []string
+}
+
+// NewExternalComponentConverter returns an ExternalComponentConverter for the given dot-separated path in the ManagedControlPlane's spec.
+func NewExternalComponentConverter(specPath string) *ExternalComponentConverter {
+	return &ExternalComponentConverter{
+		SpecPath: strings.Split(specPath, "."),
+	}
+}
+
+// getConfiguration returns the configuration at the converter's path in the ManagedControlPlane's spec, if any.
+func (ec *ExternalComponentConverter) getConfiguration(mcp *openmcpv1alpha1.ManagedControlPlane) (any, bool, error) {
+	if mcp == nil {
+		return nil, false, nil
+	}
+	spec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&mcp.Spec)
+	if err != nil {
+		return nil, false, fmt.Errorf("error converting ManagedControlPlane spec: %w", err)
+	}
+	cfg, found, err := unstructured.NestedFieldCopy(spec, ec.SpecPath...)
+	if err != nil || !found || cfg == nil {
+		return nil, false, nil
+	}
+	return cfg, true, nil
+}
+
+// ConvertToResourceSpec implements ComponentConverter.
+func (ec *ExternalComponentConverter) ConvertToResourceSpec(mcp *openmcpv1alpha1.ManagedControlPlane, _ *openmcpv1alpha1.InternalConfiguration) (any, error) {
+	cfg, found, err := ec.getConfiguration(mcp)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return map[string]any{}, nil
+	}
+	spec, ok := cfg.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid configuration at '%s': expected an object, got %T", ec.fieldPath().String(), cfg)
+	}
+	return spec, nil
+}
+
+// ValidateConfiguration implements ComponentConverter.
+// Apart from requiring the configuration to be an object, the validation is left to the component's controller.
+func (ec *ExternalComponentConverter) ValidateConfiguration(mcp *openmcpv1alpha1.ManagedControlPlane) field.ErrorList {
+	allErrs := field.ErrorList{}
+	cfg, _, err := ec.getConfiguration(mcp)
+	if err != nil {
+		return append(allErrs, field.InternalError(ec.fieldPath(), err))
+	}
+	if _, ok := cfg.(map[string]any); cfg != nil && !ok {
+		allErrs = append(allErrs, field.Invalid(ec.fieldPath(), cfg, "must be an object"))
+	}
+	return allErrs
+}
+
+// IsConfigured implements ComponentConverter.
+func (ec *ExternalComponentConverter) IsConfigured(mcp *openmcpv1alpha1.ManagedControlPlane) bool {
+	_, found, _ := ec.getConfiguration(mcp)
+	return found
+}
+
+// InjectStatus implements ComponentConverter.
+// External components don't have a dedicated field in the ManagedControlPlane's status, so this does nothing.
+func (ec *ExternalComponentConverter) InjectStatus(_ any, _ *openmcpv1alpha1.ManagedControlPlaneStatus) error {
+	return nil
+}
+
+// fieldPath returns the converter's path as field path relative to the ManagedControlPlane.
+func (ec *ExternalComponentConverter) fieldPath() *field.Path {
+	return field.NewPath("spec", ec.SpecPath...)
+}
+
+// NewExternalComponentHandlerFn returns a function which can be registered in the Registry for the given ComponentDefinition.
+func NewExternalComponentHandlerFn(cd *openmcpv1alpha1.ComponentDefinition) func() *ComponentHandler {
+	ct := cd.Spec.Type
+	gvk := cd.Spec.Resource.GroupVersionKind()
+	specPath := cd.Spec.SpecPath
+	roleAggregation := cd.Spec.RoleAggregation.DeepCopy()
+	return func() *ComponentHandler {
+		return NewComponentHandler(NewExternalComponent(ct, gvk), NewExternalComponentConverter(specPath), roleAggregation.LabelSelectorsForRole)
+	}
+}
+
+// IsExternalComponent returns true if the given component has been registered via a ComponentDefinition.
+func IsExternalComponent(comp Component) bool {
+	_, ok := comp.(*ExternalComponent)
+	return ok
+}
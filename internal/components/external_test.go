@@ -0,0 +1,124 @@
+package components_test
+
+import (
+	"github.com/openmcp-project/mcp-operator/internal/components"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
+)
+
+var _ = Describe("ExternalComponent", func() {
+	gvk := schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Foo"}
+
+	It("should get and set the spec and the common status", func() {
+		comp := components.NewExternalComponent("Foo", gvk)
+		Expect(comp.Type()).To(Equal(openmcpv1alpha1.ComponentType("Foo")))
+		Expect(comp.GetObjectKind().GroupVersionKind()).To(Equal(gvk))
+		Expect(comp.GetRequiredConditions().UnsortedList()).To(ConsistOf("FooHealthy", "FooReconciliation"))
+
+		Expect(comp.SetSpec(&openmcpv1alpha1.APIServerSpec{})).To(MatchError(openmcperrors.ErrWrongComponentConfigType))
+		Expect(comp.SetSpec(map[string]any{"replicas": int64(3)})).To(Succeed())
+		Expect(comp.GetSpec()).To(Equal(map[string]any{"replicas": int64(3)}))
+
+		comp.Object["status"] = map[string]any{"endpoint": "https://foo.example.org"}
+		status := openmcpv1alpha1.CommonComponentStatus{
+			Conditions: openmcpv1alpha1.ComponentConditionList{
+				{Type: "FooHealthy", Status: openmcpv1alpha1.ComponentConditionStatusTrue, LastTransitionTime: metav1.Unix(1700000000, 0)},
+			},
+			ObservedGenerations: openmcpv1alpha1.ObservedGenerations{Resource: 2, ManagedControlPlane: 1, InternalConfiguration: -1},
+		}
+		comp.SetCommonStatus(status)
+		Expect(comp.Object["status"]).To(HaveKeyWithValue("endpoint", "https://foo.example.org"))
+		Expect(comp.GetCommonStatus()).To(Equal(status))
+		Expect(comp.GetExternalStatus()).To(BeNil())
+
+		cp, ok := comp.DeepCopyObject().(*components.ExternalComponent)
+		Expect(ok).To(BeTrue())
+		Expect(cp.Type()).To(Equal(comp.Type()))
+		Expect(cp.Object).To(Equal(comp.Object))
+	})
+
+	It("should ignore status fields which cannot be converted", func() {
+		comp := components.NewExternalComponent("Foo", gvk)
+		Expect(comp.GetCommonStatus()).To(Equal(openmcpv1alpha1.CommonComponentStatus{}))
+		comp.Object["status"] = map[string]any{"conditions": "invalid"}
+		Expect(comp.GetCommonStatus().Conditions).To(BeEmpty())
+	})
+})
+
+var _ = Describe("ExternalComponentConverter", func() {
+	var mcp *openmcpv1alpha1.ManagedControlPlane
+
+	BeforeEach(func() {
+		mcp = &openmcpv1alpha1.ManagedControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+			Spec: openmcpv1alpha1.ManagedControlPlaneSpec{
+				Components: openmcpv1alpha1.ManagedControlPlaneComponents{
+					External: &runtime.RawExtension{Raw: []byte(`{"foo":{"replicas":3,"settings":{"debug":true}},"bar":"invalid"}`)},
+				},
+			},
+		}
+	})
+
+	It("should copy the configuration at the spec path into the resource's spec", func() {
+		conv := components.NewExternalComponentConverter("components.external.foo")
+		Expect(conv.IsConfigured(mcp)).To(BeTrue())
+		Expect(conv.ValidateConfiguration(mcp)).To(BeEmpty())
+		spec, err := conv.ConvertToResourceSpec(mcp, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec).To(Equal(map[string]any{
+			"replicas": int64(3),
+			"settings": map[string]any{"debug": true},
+		}))
+	})
+
+	It("should detect whether the component is configured", func() {
+		Expect(components.NewExternalComponentConverter("components.external.baz").IsConfigured(mcp)).To(BeFalse())
+		mcp.Spec.Components.External = nil
+		Expect(components.NewExternalComponentConverter("components.external.foo").IsConfigured(mcp)).To(BeFalse())
+		Expect(components.NewExternalComponentConverter("components.external.foo").IsConfigured(nil)).To(BeFalse())
+	})
+
+	It("should reject configuration which is not an object", func() {
+		conv := components.NewExternalComponentConverter("components.external.bar")
+		Expect(conv.IsConfigured(mcp)).To(BeTrue())
+		errs := conv.ValidateConfiguration(mcp)
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Field).To(Equal("spec.components.external.bar"))
+		_, err := conv.ConvertToResourceSpec(mcp, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should create handlers from ComponentDefinitions", func() {
+		cd := &openmcpv1alpha1.ComponentDefinition{
+			Spec: openmcpv1alpha1.ComponentDefinitionSpec{
+				Type:     "Foo",
+				Resource: openmcpv1alpha1.ComponentDefinitionResource{Group: "example.org", Version: "v1", Kind: "Foo"},
+				SpecPath: "components.external.foo",
+				RoleAggregation: &openmcpv1alpha1.ComponentRoleAggregation{
+					ClusterAdmin: []metav1.LabelSelector{{MatchLabels: map[string]string{"example.org/aggregate-to-admin": "true"}}},
+					View:         []metav1.LabelSelector{{MatchLabels: map[string]string{"example.org/aggregate-to-view": "true"}}},
+				},
+			},
+		}
+		provide := components.NewExternalComponentHandlerFn(cd)
+		ch := provide()
+		Expect(ch).ToNot(BeIdenticalTo(provide()))
+		Expect(ch.Resource()).ToNot(BeIdenticalTo(provide().Resource()))
+		Expect(components.IsExternalComponent(ch.Resource())).To(BeTrue())
+		Expect(ch.Resource().Type()).To(Equal(openmcpv1alpha1.ComponentType("Foo")))
+		Expect(ch.Converter().IsConfigured(mcp)).To(BeTrue())
+		Expect(ch.LabelSelectorsForRole(openmcpv1alpha1.AdminClusterScopeRole)).To(Equal(cd.Spec.RoleAggregation.ClusterAdmin))
+		Expect(ch.LabelSelectorsForRole(openmcpv1alpha1.ViewClusterScopeRole)).To(BeEmpty())
+		Expect(ch.LabelSelectorsForRole(openmcpv1alpha1.ViewNamespaceScopeRole)).To(Equal(cd.Spec.RoleAggregation.View))
+
+		cd.Spec.RoleAggregation = nil
+		Expect(components.NewExternalComponentHandlerFn(cd)().LabelSelectorsForRole(openmcpv1alpha1.AdminClusterScopeRole)).To(BeNil())
+	})
+})
@@ -3,6 +3,8 @@ package components
 import (
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
 	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
 	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
 )
@@ -11,6 +13,9 @@ import (
 
 type LandscaperConverter struct{}
 
+// landscaperPath is the path of the Landscaper configuration in the ManagedControlPlane.
+var landscaperPath = field.NewPath("spec", "components", "landscaper")
+
 var _ Component = &openmcpv1alpha1.Landscaper{}
 var _ ComponentConverter = &LandscaperConverter{}
 
@@ -26,13 +31,27 @@ func (*LandscaperConverter) ConvertToResourceSpec(mcp *openmcpv1alpha1.ManagedCo
 	}
 
 	res.Default()
-	if err := res.Validate("spec", "landscaper"); err != nil {
-		return nil, fmt.Errorf("invalid Landscaper configuration: %w", err)
+	if errs := res.Validate(landscaperPath); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid Landscaper configuration: %w", errs.ToAggregate())
 	}
 
 	return res, nil
 }
 
+// ValidateConfiguration implements ComponentConverter.
+func (*LandscaperConverter) ValidateConfiguration(mcp *openmcpv1alpha1.ManagedControlPlane) field.ErrorList {
+	lcConfig := mcp.Spec.Components.Landscaper
+	if lcConfig == nil {
+		return field.ErrorList{field.Required(landscaperPath, "landscaper configuration is missing")}
+	}
+
+	res := &openmcpv1alpha1.LandscaperSpec{
+		LandscaperConfiguration: *lcConfig.DeepCopy(),
+	}
+	res.Default()
+	return res.Validate(landscaperPath)
+}
+
 // InjectStatus implements ComponentConverter.
 func (*LandscaperConverter) InjectStatus(raw any, mcpStatus *openmcpv1alpha1.ManagedControlPlaneStatus) error {
 	status, ok := raw.(openmcpv1alpha1.ExternalLandscaperStatus)
@@ -0,0 +1,100 @@
+package components
+
+import (
+	"context"
+	"sync"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+)
+
+// PlanAction describes what would happen to a resource if a plan was applied.
+type PlanAction string
+
+const (
+	PlanActionCreate PlanAction = "Create"
+	PlanActionUpdate PlanAction = "Update"
+	PlanActionDelete PlanAction = "Delete"
+	PlanActionNone   PlanAction = "None"
+)
+
+// Plan describes the changes which would be made if the current spec of a ManagedControlPlane was applied.
+type Plan struct {
+	// Generation is the generation of the ManagedControlPlane which the plan has been computed for.
+	Generation int64 `json:"generation"`
+	// Error contains the error which prevented the plan from being computed, if any.
+	Error string `json:"error,omitempty"`
+	// Components contains the planned changes of the component resources, sorted by component type.
+	Components []ComponentPlan `json:"components,omitempty"`
+}
+
+// ComponentPlan describes the planned changes of a single component resource.
+type ComponentPlan struct {
+	// Component is the type of the component.
+	Component openmcpv1alpha1.ComponentType `json:"component"`
+	// Action is the action which would be performed on the component resource.
+	Action PlanAction `json:"action"`
+	// ChangedFields contains the paths of the fields of the component resource which would be changed by an update.
+	ChangedFields []string `json:"changedFields,omitempty"`
+	// Spec is the desired spec of the component resource. It is not set if the resource would be deleted.
+	Spec any `json:"spec,omitempty"`
+	// Downstream contains the planned changes of the resources which are managed by the component's controller.
+	// It is only set if the component's controller has registered a DownstreamPlanner.
+	Downstream []DownstreamChange `json:"downstream,omitempty"`
+	// Message contains additional information, e.g. why the downstream changes could not be planned.
+	Message string `json:"message,omitempty"`
+}
+
+// DownstreamChange describes the planned change of a resource which is managed by a component's controller.
+type DownstreamChange struct {
+	// Kind is the kind of the resource.
+	Kind string `json:"kind"`
+	// Name is the name of the resource, prefixed with its namespace for namespaced resources.
+	Name string `json:"name"`
+	// Action is the action which would be performed on the resource.
+	Action PlanAction `json:"action"`
+	// ChangedFields contains the paths of the fields which would be changed by an update.
+	ChangedFields []string `json:"changedFields,omitempty"`
+	// Desired contains the relevant parts of the desired resource. Sensitive data is removed.
+	Desired map[string]any `json:"desired,omitempty"`
+	// Message contains additional information.
+	Message string `json:"message,omitempty"`
+}
+
+// DownstreamPlanner can be implemented by component controllers to render the resources they would write for a component resource, without writing them.
+type DownstreamPlanner interface {
+	// PlanDownstream returns the planned changes of the resources which are managed for the given component resource.
+	// desired is the component resource as it would look like after applying the plan, existing is the component resource as it currently is in the cluster.
+	// existing is nil if the component resource doesn't exist yet.
+	// Implementations must not modify any resources.
+	PlanDownstream(ctx context.Context, desired, existing Component) ([]DownstreamChange, error)
+}
+
+// Planners holds the DownstreamPlanners of the component controllers.
+// Controllers register themselves here during setup, so that the ManagedControlPlane controller can use them in plan mode.
+var Planners = &plannerRegistry{
+	planners: map[openmcpv1alpha1.ComponentType]DownstreamPlanner{},
+}
+
+type plannerRegistry struct {
+	lock     sync.RWMutex
+	planners map[openmcpv1alpha1.ComponentType]DownstreamPlanner
+}
+
+// Register registers the DownstreamPlanner for the given component type.
+// Registering a nil planner unregisters the planner for the given component type.
+func (r *plannerRegistry) Register(ct openmcpv1alpha1.ComponentType, p DownstreamPlanner) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if p == nil {
+		delete(r.planners, ct)
+		return
+	}
+	r.planners[ct] = p
+}
+
+// Get returns the DownstreamPlanner for the given component type, or nil if none is registered.
+func (r *plannerRegistry) Get(ct openmcpv1alpha1.ComponentType) DownstreamPlanner {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.planners[ct]
+}
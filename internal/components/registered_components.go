@@ -1,10 +1,14 @@
 package components
 
 import (
+	"slices"
+	"sync"
+
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 
 	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
@@ -56,7 +60,7 @@ func init() {
 				},
 			}
 		})
-	})
+	}, openmcpv1alpha1.APIServerComponent, openmcpv1alpha1.AuthenticationComponent, openmcpv1alpha1.AuthorizationComponent)
 	Registry.Register(openmcpv1alpha1.CloudOrchestratorComponent, func() *ComponentHandler {
 		return NewComponentHandler(&openmcpv1alpha1.CloudOrchestrator{}, &CloudOrchestratorConverter{}, func(roleName string) []metav1.LabelSelector {
 			if openmcpv1alpha1.IsClusterScopedRole(roleName) {
@@ -89,19 +93,32 @@ func init() {
 			}
 			return nil
 		})
-	})
+	}, openmcpv1alpha1.APIServerComponent, openmcpv1alpha1.AuthenticationComponent, openmcpv1alpha1.AuthorizationComponent)
 	Registry.Register(openmcpv1alpha1.AuthenticationComponent, func() *ComponentHandler {
 		return NewComponentHandler(&openmcpv1alpha1.Authentication{}, &AuthenticationConverter{}, nil)
-	})
+	}, openmcpv1alpha1.APIServerComponent)
 	Registry.Register(openmcpv1alpha1.AuthorizationComponent, func() *ComponentHandler {
 		return NewComponentHandler(&openmcpv1alpha1.Authorization{}, &AuthorizationConverter{}, nil)
-	})
+	}, openmcpv1alpha1.APIServerComponent)
 
 	// add new components here
+	// dependencies are declared as additional arguments, they must not be cyclic (see componentutils.ValidateDependencies)
 
 	// Note that the function argument must be an anonymous function and not be wrapped within a NewComponentHandlerFn function or similar,
 	// otherwise repeated calls to Registry.GetKnownComponents() will return pointers to the same instance of the resource struct, which breaks the ManagedControlPlane controller's logic!
 	// The whole idea behind registering a function instead of just a fixed ComponentHandler is that the registry will always return new ComponentHandlers, never the same one as returned before.
+
+	// all components registered so far are built into the operator, ComponentDefinitions must not use their types
+	builtinComponents = sets.KeySet(Registry.reg)
+}
+
+// builtinComponents contains the types of all components which are built into the operator.
+var builtinComponents sets.Set[openmcpv1alpha1.ComponentType]
+
+// IsBuiltinComponent returns true if the given component type belongs to a component which is built into the operator.
+// This is independent of whether the component is currently registered or not.
+func IsBuiltinComponent(ct openmcpv1alpha1.ComponentType) bool {
+	return builtinComponents.Has(ct)
 }
 
 var _ ComponentRegistry[*ComponentHandler] = &registry{}
@@ -144,20 +161,26 @@ func (ch *ComponentHandler) LabelSelectorsForRole(roleName string) []metav1.Labe
 	return ch.aggregationLabelSelectorFunc(roleName)
 }
 
+// registry is safe for concurrent use, because components can be registered at runtime via ComponentDefinitions.
 type registry struct {
-	reg map[openmcpv1alpha1.ComponentType]func() *ComponentHandler
-	sc  *runtime.Scheme
+	lock sync.RWMutex
+	reg  map[openmcpv1alpha1.ComponentType]func() *ComponentHandler
+	deps map[openmcpv1alpha1.ComponentType][]openmcpv1alpha1.ComponentType
+	sc   *runtime.Scheme
 }
 
 func newRegistry(baseScheme *runtime.Scheme) *registry {
 	return &registry{
-		reg: map[openmcpv1alpha1.ComponentType]func() *ComponentHandler{},
-		sc:  baseScheme,
+		reg:  map[openmcpv1alpha1.ComponentType]func() *ComponentHandler{},
+		deps: map[openmcpv1alpha1.ComponentType][]openmcpv1alpha1.ComponentType{},
+		sc:   baseScheme,
 	}
 }
 
 // GetComponent implements ComponentRegistry.
 func (r *registry) GetComponent(ct openmcpv1alpha1.ComponentType) *ComponentHandler {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
 	if chp, ok := r.reg[ct]; ok {
 		return chp()
 	}
@@ -166,6 +189,8 @@ func (r *registry) GetComponent(ct openmcpv1alpha1.ComponentType) *ComponentHand
 
 // GetKnownComponents implements ComponentRegistry.
 func (r *registry) GetKnownComponents() map[openmcpv1alpha1.ComponentType]*ComponentHandler {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
 	res := make(map[openmcpv1alpha1.ComponentType]*ComponentHandler, len(r.reg))
 	for ct, chp := range r.reg {
 		res[ct] = chp()
@@ -175,17 +200,30 @@ func (r *registry) GetKnownComponents() map[openmcpv1alpha1.ComponentType]*Compo
 
 // Has implements ComponentRegistry.
 func (r *registry) Has(ct openmcpv1alpha1.ComponentType) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
 	_, ok := r.reg[ct]
 	return ok
 }
 
 // Register implements ComponentRegistry.
-func (r *registry) Register(ct openmcpv1alpha1.ComponentType, provideCh func() *ComponentHandler) {
+func (r *registry) Register(ct openmcpv1alpha1.ComponentType, provideCh func() *ComponentHandler, dependencies ...openmcpv1alpha1.ComponentType) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
 	if provideCh == nil {
 		delete(r.reg, ct)
+		delete(r.deps, ct)
 		return
 	}
 	r.reg[ct] = provideCh
+	r.deps[ct] = slices.Clone(dependencies)
+}
+
+// Dependencies implements ComponentRegistry.
+func (r *registry) Dependencies(ct openmcpv1alpha1.ComponentType) []openmcpv1alpha1.ComponentType {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return slices.Clone(r.deps[ct])
 }
 
 // Scheme returns the scheme of the Registry.
@@ -35,6 +35,13 @@ var _ = Describe("ComponentHandler", func() {
 			Expect(components.Registry.Has("unknown")).To(BeFalse())
 		})
 
+		It("should return the declared dependencies", func() {
+			Expect(components.Registry.Dependencies(openmcpv1alpha1.APIServerComponent)).To(BeEmpty())
+			Expect(components.Registry.Dependencies(openmcpv1alpha1.AuthenticationComponent)).To(ConsistOf(openmcpv1alpha1.APIServerComponent))
+			Expect(components.Registry.Dependencies(openmcpv1alpha1.CloudOrchestratorComponent)).To(ConsistOf(openmcpv1alpha1.APIServerComponent, openmcpv1alpha1.AuthenticationComponent, openmcpv1alpha1.AuthorizationComponent))
+			Expect(components.Registry.Dependencies("unknown")).To(BeEmpty())
+		})
+
 		It("should return the scheme", func() {
 			scheme := components.Registry.Scheme()
 			Expect(scheme).ToNot(BeNil())
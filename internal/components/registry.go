@@ -20,7 +20,13 @@ type ComponentRegistry[T ManagedComponent] interface {
 	// The given function is supposed to return a 'fresh' ManagedComponent, so that each call to 'GetComponent' or 'GetKnownComponents' returns a new object.
 	// The type is used as key, calling this function multiple times with the same type argument will cause the last call to overwrite anything registered with the previous ones.
 	// Calling Register with a nil function is expected to unregister the given component type.
-	Register(openmcpv1alpha1.ComponentType, func() T)
+	// The optional component types are the dependencies of the registered component.
+	// Component controllers are expected to wait for their dependencies to be ready and to protect them from deletion via dependency finalizers.
+	Register(openmcpv1alpha1.ComponentType, func() T, ...openmcpv1alpha1.ComponentType)
+
+	// Dependencies returns the component types the given component type depends on, as declared during registration.
+	// Any modification of the returned list must not influence the return value of future calls.
+	Dependencies(openmcpv1alpha1.ComponentType) []openmcpv1alpha1.ComponentType
 
 	// Has returns true if the given component type is registered in this registry.
 	Has(openmcpv1alpha1.ComponentType) bool
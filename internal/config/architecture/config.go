@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"maps"
 	"reflect"
+	"slices"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -23,10 +24,18 @@ var AllowedVersions = sets.New(openmcpv1alpha1.ArchitectureV1, openmcpv1alpha1.A
 type ArchConfig struct {
 	// Immutability contains the configuration for the immutability check.
 	Immutability ImmutabilityConfig `json:"immutability"`
+	// Migration contains the configuration for migrating existing components from v1 to v2.
+	Migration MigrationConfig `json:"migration"`
 	// APIServer contains the configuration for the APIServer component v1-v2 bridge.
 	APIServer BridgeConfig `json:"apiServer"`
 	// Landscaper contains the configuration for the Landscaper component v1-v2 bridge.
 	Landscaper BridgeConfig `json:"landscaper"`
+	// Authentication contains the configuration for the Authentication component v1-v2 bridge.
+	Authentication BridgeConfig `json:"authentication"`
+	// Authorization contains the configuration for the Authorization component v1-v2 bridge.
+	Authorization BridgeConfig `json:"authorization"`
+	// CloudOrchestrator contains the configuration for the CloudOrchestrator component v1-v2 bridge.
+	CloudOrchestrator BridgeConfig `json:"cloudOrchestrator"`
 }
 
 func (cfg *ArchConfig) Validate() field.ErrorList {
@@ -73,6 +82,14 @@ func (cfg *ArchConfig) GetBridgeConfigForComponent(compType openmcpv1alpha1.Comp
 	return res
 }
 
+// BridgedComponents returns the types of all components for which a v1-v2 bridge exists, sorted alphabetically.
+func (cfg *ArchConfig) BridgedComponents() []openmcpv1alpha1.ComponentType {
+	if cfg == nil {
+		cfg = &ArchConfig{}
+	}
+	return slices.Sorted(maps.Keys(cfg.componentBridgeConfigs()))
+}
+
 // componentBridgeConfigs returns a mapping from component types to their respective bridge configurations.
 // Note that pointers to the BridgeConfigs are returned, but you should only modify them if you know what you're doing.
 func (cfg *ArchConfig) componentBridgeConfigs() map[openmcpv1alpha1.ComponentType]*BridgeConfig {
@@ -177,3 +194,14 @@ func (cfg *ImmutabilityConfig) Validate() field.ErrorList {
 
 	return allErrs
 }
+
+/////////////////////
+// MigrationConfig //
+/////////////////////
+
+type MigrationConfig struct {
+	// ClusterProfile is the name of the ClusterProfile that is set on the Cluster resources
+	// which are created on the platform cluster to adopt the existing Gardener shoots of migrated APIServers.
+	// APIServers cannot be migrated from v1 to v2 if this is not specified.
+	ClusterProfile string `json:"clusterProfile"`
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/yaml"
 
@@ -19,6 +20,31 @@ func init() {
 type MCPOperatorConfig struct {
 	// Architecture contains the configuration regarding v1 and v2 architecture.
 	Architecture architecture.ArchConfig `json:"architecture"`
+
+	// ManagedControlPlane contains the configuration for the ManagedControlPlane controller.
+	ManagedControlPlane ManagedControlPlaneConfig `json:"managedControlPlane"`
+}
+
+type ManagedControlPlaneConfig struct {
+	// DeletionStageTimeout is the time after which a stage of a ManagedControlPlane's deletion is considered stuck.
+	// A stuck deletion is reported via the DeletionStuck condition, but not aborted.
+	// If not set or zero, deletion stages never time out.
+	// +optional
+	DeletionStageTimeout *metav1.Duration `json:"deletionStageTimeout,omitempty"`
+}
+
+func (cfg *ManagedControlPlaneConfig) Validate(fldPath *field.Path) field.ErrorList {
+	if cfg == nil {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+
+	if cfg.DeletionStageTimeout != nil && cfg.DeletionStageTimeout.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("deletionStageTimeout"), cfg.DeletionStageTimeout.Duration.String(), "must not be negative"))
+	}
+
+	return allErrs
 }
 
 func LoadConfig(path string) (*MCPOperatorConfig, error) {
@@ -53,8 +79,10 @@ func (cfg *MCPOperatorConfig) Validate() field.ErrorList {
 	}
 
 	archErrs := cfg.Architecture.Validate()
-	allErrs := make(field.ErrorList, 0, len(archErrs))
+	mcpErrs := cfg.ManagedControlPlane.Validate(field.NewPath("managedControlPlane"))
+	allErrs := make(field.ErrorList, 0, len(archErrs)+len(mcpErrs))
 	allErrs = append(allErrs, archErrs...)
+	allErrs = append(allErrs, mcpErrs...)
 
 	return allErrs
 }
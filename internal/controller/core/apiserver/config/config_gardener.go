@@ -5,16 +5,23 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/schemes"
 
+	"github.com/Masterminds/semver/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
 	gardenv1beta1 "github.com/openmcp-project/mcp-operator/api/external/gardener/pkg/apis/core/v1beta1"
+	"github.com/openmcp-project/mcp-operator/internal/utils/drift"
+	"github.com/openmcp-project/mcp-operator/internal/utils/region"
 )
 
 const (
@@ -45,6 +52,32 @@ type MultiGardenerConfiguration struct {
 	// Landscapes is a list of supported Gardener landscapes.
 	// Only required in multi-config mode.
 	Landscapes []GardenerLandscape `json:"landscapes,omitempty"`
+
+	// Scheduling configures how APIServers which don't specify a landscape configuration are assigned to one.
+	// If not set, these APIServers use the default landscape configuration.
+	// Only supported in multi-config mode.
+	Scheduling *SchedulingConfiguration `json:"scheduling,omitempty"`
+}
+
+// SchedulingConfiguration configures the assignment of APIServers to landscape configurations.
+type SchedulingConfiguration struct {
+	// Rules restrict the candidate landscape configurations for an APIServer based on its labels.
+	// They are evaluated in order and the first matching rule is used.
+	// If no rule matches, all landscape configurations are candidates.
+	Rules []SchedulingRule `json:"rules,omitempty"`
+}
+
+// SchedulingRule restricts the landscape configurations which are candidates for matching APIServers.
+type SchedulingRule struct {
+	// Selector is matched against the labels of the APIServer.
+	// These contain the project and workspace of the ManagedControlPlane, if known (see the 'openmcp.cloud/mcp-project' and 'openmcp.cloud/mcp-workspace' labels).
+	// If not set, the rule matches all APIServers.
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Configurations is the list of candidate landscape configurations for matching APIServers.
+	// Each entry is expected to follow the format '<landscape-name>/<config-name>'.
+	// If multiple candidates are equally suitable, the one listed first is chosen.
+	Configurations []string `json:"configs"`
 }
 
 // GardenerLandscape represents a Gardener landscape.
@@ -86,13 +119,189 @@ type GardenerConfiguration struct {
 	// It is relevant for APIServers for which spec.gardener.enableWorkers is true.
 	ShootTemplate *gardenv1beta1.ShootTemplate `json:"shootTemplate,omitempty"`
 
-	// Regions contains the supported regions and their zones.
-	Regions []gardenv1beta1.Region `json:"regions,omitempty"`
+	// Regions contains the supported regions.
+	// Their zones are taken from the CloudProfile.
+	Regions []RegionConfiguration `json:"regions,omitempty"`
 
 	// DefaultRegion is the default region for the workerless shoots.
 	// If not specified, a region must be chosen in the APIServer spec.
 	// If specified, this region will be used if there is none in the APIServer spec.
 	DefaultRegion string `json:"defaultRegion,omitempty"`
+
+	// RegionMapper configures how the generic regions from an APIServer's desiredRegion are mapped to the provider-specific regions.
+	// If specified, it overrides the predefined mapper for the cloud provider, if any.
+	// It is required for resolving desiredRegion on providers without a predefined mapper (e.g. OpenStack, where region names are landscape-specific).
+	RegionMapper *RegionMapperConfiguration `json:"regionMapper,omitempty"`
+
+	// KubernetesVersionPolicy configures how the Kubernetes version of the shoots is chosen and upgraded.
+	// If not specified, the 'Pin' policy without a version is used, which means that existing shoots keep their version.
+	KubernetesVersionPolicy *KubernetesVersionPolicy `json:"kubernetesVersionPolicy,omitempty"`
+
+	// Maintenance restricts the maintenance settings which can be chosen in the APIServer spec.
+	// If not specified, all maintenance time windows which are accepted by Gardener can be chosen.
+	Maintenance *MaintenanceConfiguration `json:"maintenance,omitempty"`
+
+	// SizingProfiles are the sizing profiles which can be chosen in the APIServer spec.
+	// A sizing profile determines the purpose of the shoot and the sizing of its workers.
+	// If empty, no sizing profile can be chosen and the shoot template is used as it is.
+	SizingProfiles []SizingProfile `json:"sizingProfiles,omitempty"`
+
+	// DefaultSizingProfile is the name of the sizing profile which is used for APIServers that don't choose one.
+	// If not specified, the shoot template is used as it is for these APIServers.
+	DefaultSizingProfile string `json:"defaultSizingProfile,omitempty"`
+
+	// Drift configures how differences between the desired and the actual state of the shoots are detected.
+	// +optional
+	Drift *DriftConfiguration `json:"drift,omitempty"`
+}
+
+// DriftConfiguration configures how differences between the desired and the actual state of the shoots are detected.
+type DriftConfiguration struct {
+	// IgnorePaths are paths of shoot fields which are not compared, e.g. because they are defaulted by Gardener.
+	// Fields are separated by dots, list indices and map keys containing dots or slashes are written in brackets,
+	// e.g. 'spec.provider.workers[0].cri' or 'metadata.annotations[gardener.cloud/timestamp]'.
+	// '*' matches any field name and '[*]' matches any list index or bracketed map key. All fields below an ignored path are ignored too.
+	IgnorePaths []string `json:"ignorePaths,omitempty"`
+}
+
+// SizingProfile is a named set of overrides for the shoot template which can be chosen in the APIServer spec.
+// Fields which are not specified are taken from the shoot template.
+type SizingProfile struct {
+	// Name is the name of the sizing profile, e.g. 'small'.
+	Name string `json:"name"`
+
+	// Purpose is the purpose of the shoot.
+	// If not specified, the purpose defaults to 'production'.
+	// +optional
+	Purpose *gardenv1beta1.ShootPurpose `json:"purpose,omitempty"`
+
+	// MachineType is the machine type of the workers.
+	// +optional
+	MachineType string `json:"machineType,omitempty"`
+
+	// Minimum is the minimum number of workers.
+	// +optional
+	Minimum *int32 `json:"minimum,omitempty"`
+
+	// Maximum is the maximum number of workers.
+	// +optional
+	Maximum *int32 `json:"maximum,omitempty"`
+}
+
+// MaintenanceConfiguration restricts the maintenance settings which can be chosen in the APIServer spec.
+type MaintenanceConfiguration struct {
+	// AllowedTimeWindows are the time windows maintenance time windows from the APIServer spec must lie within.
+	// A maintenance time window is accepted if it lies completely within at least one of these time windows.
+	// If empty, the time of day is not restricted.
+	AllowedTimeWindows []MaintenanceTimeWindowConfiguration `json:"allowedTimeWindows,omitempty"`
+
+	// MinDuration is the minimum duration of maintenance time windows from the APIServer spec.
+	// +optional
+	MinDuration *metav1.Duration `json:"minDuration,omitempty"`
+
+	// MaxDuration is the maximum duration of maintenance time windows from the APIServer spec.
+	// +optional
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+}
+
+// MaintenanceTimeWindowConfiguration is a daily time window in UTC.
+type MaintenanceTimeWindowConfiguration struct {
+	// Begin is the beginning of the time window in the format 'HH:MM', in UTC.
+	Begin string `json:"begin"`
+
+	// End is the end of the time window in the format 'HH:MM', in UTC.
+	// If it is before the beginning, the time window spans midnight.
+	End string `json:"end"`
+}
+
+// KubernetesVersionPolicyType is the type of a Kubernetes version policy.
+type KubernetesVersionPolicyType string
+
+const (
+	// KubernetesVersionPolicyPin keeps the Kubernetes version of existing shoots.
+	// If a version is specified, shoots are upgraded to at least this version.
+	KubernetesVersionPolicyPin KubernetesVersionPolicyType = "Pin"
+	// KubernetesVersionPolicyLatestPatch upgrades shoots to the latest supported patch version of their minor version.
+	// If the minor version is not supported anymore, shoots are upgraded to the next supported minor version.
+	KubernetesVersionPolicyLatestPatch KubernetesVersionPolicyType = "LatestPatch"
+	// KubernetesVersionPolicyPreviousMinor upgrades shoots to the latest supported patch version of the second-highest supported minor version (N-1).
+	KubernetesVersionPolicyPreviousMinor KubernetesVersionPolicyType = "PreviousMinor"
+)
+
+// KubernetesVersionPolicy configures how the Kubernetes version of the shoots is chosen and upgraded.
+// The supported versions are taken from the CloudProfile on the garden cluster. Preview versions and expired versions are never chosen.
+// Upgrades of existing shoots are only performed during the shoot's maintenance time window, new shoots get the target version immediately.
+// Downgrades are never performed and a K8SVersionOverwrite in the APIServer's internal configuration still takes precedence, if it is higher.
+type KubernetesVersionPolicy struct {
+	// Type is the type of the policy.
+	// +kubebuilder:validation:Enum=Pin;LatestPatch;PreviousMinor
+	Type KubernetesVersionPolicyType `json:"type"`
+
+	// Version is the version the shoots are pinned to.
+	// Only allowed for the 'Pin' policy.
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// RegionConfiguration configures a region which can be used for shoot clusters.
+type RegionConfiguration struct {
+	// Name is the name of the region.
+	// It must also be contained in the CloudProfile.
+	Name string `json:"name"`
+
+	// Weight influences how new shoots are distributed among multiple regions which are equally close to an APIServer's desiredRegion.
+	// Regions receive new shoots proportionally to their weight, taking the number of already existing shoots into account.
+	// A weight of 0 prevents the region from being chosen via desiredRegion, which can be used to drain it.
+	// Defaults to 1.
+	Weight *int32 `json:"weight,omitempty"`
+
+	// MaxShoots is the maximum number of shoots in this region.
+	// Once it is reached, the region is not chosen via desiredRegion anymore.
+	// Explicitly requested regions and the default region are not affected by this limit.
+	// If not set, the number of shoots is not limited.
+	MaxShoots *int32 `json:"maxShoots,omitempty"`
+
+	// Zones is only kept to detect configurations which still specify zones for a region, as the former region format allowed.
+	// The zones of a region are always taken from the CloudProfile, so the validation rejects this field.
+	// Deprecated: remove the zones from the region configuration.
+	// +optional
+	Zones []gardenv1beta1.AvailabilityZone `json:"zones,omitempty"`
+}
+
+// GetWeight returns the weight of the region, defaulting to 1 if not specified.
+func (rc RegionConfiguration) GetWeight() int32 {
+	if rc.Weight == nil {
+		return 1
+	}
+	return *rc.Weight
+}
+
+// HasCapacity returns true if the region can accept another shoot via desiredRegion resolution, given the number of existing shoots in it.
+func (rc RegionConfiguration) HasCapacity(existingShoots int) bool {
+	if rc.GetWeight() <= 0 {
+		return false
+	}
+	return rc.MaxShoots == nil || existingShoots < int(*rc.MaxShoots)
+}
+
+// RegionMapperConfiguration contains the configuration for mapping generic regions to provider-specific regions.
+type RegionMapperConfiguration struct {
+	// Format is a regular expression which is used to filter the available regions.
+	// %R will be replaced by the mapped region and %D will be replaced by the mapped direction.
+	Format string `json:"format"`
+
+	// Regions maps generic regions to their provider-specific counterparts.
+	// Generic regions which are not contained in this map cannot be resolved.
+	Regions map[openmcpv1alpha1.Region]string `json:"regions,omitempty"`
+
+	// Directions maps generic directions to their provider-specific counterparts.
+	// Generic directions which are not contained in this map are replaced by an empty string.
+	Directions map[openmcpv1alpha1.Direction]string `json:"directions,omitempty"`
+}
+
+// Mapper returns a region mapper based on this configuration.
+func (rmc *RegionMapperConfiguration) Mapper() *region.BasicRegionMapper {
+	return region.NewRegionMapper(rmc.Format, rmc.Regions, rmc.Directions, true)
 }
 
 // InjectGardenClusterClient can be used to inject a fake client for testing purposes.
@@ -115,6 +324,28 @@ func (cfg *MultiGardenerConfiguration) InjectGardenClusterClient(landscape strin
 	}
 }
 
+// MaintenanceBounds returns the maintenance bounds of all landscape configurations, keyed by '<landscape-name>/<config-name>', as well as the name of the default landscape configuration.
+// Landscape configurations without bounds are contained with a nil value.
+// In contrast to the completed configuration, it doesn't require access to the Gardener landscapes, so it can be used by the webhook.
+func (cfg *MultiGardenerConfiguration) MaintenanceBounds() (map[string]*MaintenanceConfiguration, string) {
+	if cfg.DefaultLandscapeAndConfiguration == "" && len(cfg.Landscapes) == 0 {
+		// single config mode
+		defaultConfig := CombinedName(defaultGardenerLandscapeName, defaultGardenerConfigName)
+		var bounds *MaintenanceConfiguration
+		if cfg.GardenerConfiguration != nil {
+			bounds = cfg.Maintenance
+		}
+		return map[string]*MaintenanceConfiguration{defaultConfig: bounds}, defaultConfig
+	}
+	res := map[string]*MaintenanceConfiguration{}
+	for _, ls := range cfg.Landscapes {
+		for _, lscfg := range ls.Configurations {
+			res[CombinedName(ls.Name, lscfg.Name)] = lscfg.Maintenance
+		}
+	}
+	return res, cfg.DefaultLandscapeAndConfiguration
+}
+
 type CompletedMultiGardenerConfiguration struct {
 	// DefaultConfiguration is the name of the default Gardener configuration.
 	DefaultConfiguration string
@@ -124,6 +355,33 @@ type CompletedMultiGardenerConfiguration struct {
 
 	// Landscapes is a map of Gardener landscapes.
 	Landscapes map[string]CompletedGardenerLandscape
+
+	// Scheduling is the completed scheduling configuration.
+	// Nil if no scheduling is configured.
+	Scheduling *CompletedSchedulingConfiguration
+}
+
+type CompletedSchedulingConfiguration struct {
+	// Rules are the scheduling rules with parsed selectors, in the configured order.
+	Rules []CompletedSchedulingRule
+}
+
+type CompletedSchedulingRule struct {
+	// Selector is the parsed label selector of the rule.
+	Selector labels.Selector
+
+	// Configurations is the list of candidate landscape configurations in the format '<landscape-name>/<config-name>'.
+	Configurations []string
+}
+
+// CombinedName returns the name of the given landscape and configuration in the format '<landscape-name>/<config-name>'.
+func CombinedName(landscape, config string) string {
+	return fmt.Sprintf("%s/%s", landscape, config)
+}
+
+// DefaultLandscapeConfiguration returns the name of the default landscape configuration in the format '<landscape-name>/<config-name>'.
+func (ccfg *CompletedMultiGardenerConfiguration) DefaultLandscapeConfiguration() string {
+	return CombinedName(ccfg.DefaultLandscape, ccfg.DefaultConfiguration)
 }
 
 // LandscapeConfiguration returns the Gardener configuration with the given name, or an error if the configuration is unknown.
@@ -211,8 +469,39 @@ type CompletedGardenerConfiguration struct {
 	// whose names are listed in the APIServer config.
 	ValidRegions map[string]gardenv1beta1.Region
 
+	// RegionConfigurations maps the names of the valid regions to their configuration.
+	RegionConfigurations map[string]RegionConfiguration
+
 	// ValidK8SVersions is the set of valid k8s versions. It is extracted from the given CloudProfile.
 	ValidK8SVersions sets.Set[string]
+
+	// RegionMapper maps generic regions to the provider-specific regions.
+	// It is the configured mapper, if one exists, or the predefined mapper for the provider type otherwise.
+	// Might be nil, if neither exists.
+	RegionMapper region.GenericToSpecificRegionMapper
+
+	// DriftIgnorePaths are the parsed paths of the shoot fields which are ignored during drift detection.
+	DriftIgnorePaths []drift.Path
+}
+
+// SizingProfile returns the sizing profile with the given name.
+// If the name is empty, the default sizing profile is returned, which might be nil if no default is configured.
+// An error is returned if no sizing profile with the given name is configured.
+func (cfg *CompletedGardenerConfiguration) SizingProfile(name string) (*SizingProfile, error) {
+	if name == "" {
+		name = cfg.DefaultSizingProfile
+		if name == "" {
+			return nil, nil
+		}
+	}
+	validNames := make([]string, len(cfg.SizingProfiles))
+	for i := range cfg.SizingProfiles {
+		if cfg.SizingProfiles[i].Name == name {
+			return &cfg.SizingProfiles[i], nil
+		}
+		validNames[i] = cfg.SizingProfiles[i].Name
+	}
+	return nil, fmt.Errorf("unknown sizing profile '%s', supported sizing profiles are [%s]", name, strings.Join(validNames, ", "))
 }
 
 // Worker is the base definition of a worker group.
@@ -278,6 +567,13 @@ func (cfg *MultiGardenerConfiguration) complete(ctx context.Context) (*Completed
 						Project:       cfg.Project,
 						Regions:       cfg.Regions,
 						ShootTemplate: cfg.ShootTemplate,
+						RegionMapper:  cfg.RegionMapper,
+
+						KubernetesVersionPolicy: cfg.KubernetesVersionPolicy,
+						Maintenance:             cfg.Maintenance,
+						SizingProfiles:          cfg.SizingProfiles,
+						DefaultSizingProfile:    cfg.DefaultSizingProfile,
+						Drift:                   cfg.Drift,
 					},
 				},
 			},
@@ -343,12 +639,21 @@ func (cfg *MultiGardenerConfiguration) complete(ctx context.Context) (*Completed
 			// set provider type
 			clscfg.ProviderType = cp.Spec.Type
 
+			// set region mapper: a configured mapper overrides the predefined one for the provider type
+			if lscfg.RegionMapper != nil {
+				clscfg.RegionMapper = lscfg.RegionMapper.Mapper()
+			} else if mapper := region.GetPredefinedMapperByCloudprovider(clscfg.ProviderType); mapper != nil {
+				clscfg.RegionMapper = mapper
+			}
+
 			// set valid regions: select all regions from the cloud profile whose name is contained in the configured regions
 			clscfg.ValidRegions = map[string]gardenv1beta1.Region{}
+			clscfg.RegionConfigurations = map[string]RegionConfiguration{}
 			for _, cpRegion := range cp.Spec.Regions {
 				for _, cfgRegion := range lscfg.Regions {
 					if cpRegion.Name == cfgRegion.Name {
 						clscfg.ValidRegions[cpRegion.Name] = cpRegion
+						clscfg.RegionConfigurations[cpRegion.Name] = cfgRegion
 						break
 					}
 				}
@@ -362,6 +667,15 @@ func (cfg *MultiGardenerConfiguration) complete(ctx context.Context) (*Completed
 				clscfg.ValidK8SVersions.Insert(version.Version, minorVersion)
 			}
 
+			// parse drift ignore paths
+			if lscfg.Drift != nil {
+				var err error
+				clscfg.DriftIgnorePaths, err = drift.ParsePaths(lscfg.Drift.IgnorePaths...)
+				if err != nil {
+					return nil, fmt.Errorf("[%s/%s] invalid drift ignore path: %w", ls.Name, lscfg.Name, err)
+				}
+			}
+
 			// if a default region is given, check that it is valid
 			if lscfg.DefaultRegion != "" {
 				_, ok := clscfg.ValidRegions[lscfg.DefaultRegion]
@@ -376,6 +690,31 @@ func (cfg *MultiGardenerConfiguration) complete(ctx context.Context) (*Completed
 		res.Landscapes[ls.Name] = cls
 	}
 
+	if cfg.Scheduling != nil {
+		res.Scheduling = &CompletedSchedulingConfiguration{
+			Rules: make([]CompletedSchedulingRule, len(cfg.Scheduling.Rules)),
+		}
+		for i, rule := range cfg.Scheduling.Rules {
+			sel := labels.Everything()
+			if rule.Selector != nil {
+				var err error
+				sel, err = metav1.LabelSelectorAsSelector(rule.Selector)
+				if err != nil {
+					return nil, fmt.Errorf("invalid selector in scheduling rule %d: %w", i, err)
+				}
+			}
+			for _, lc := range rule.Configurations {
+				if _, _, err := res.LandscapeConfiguration(lc); err != nil {
+					return nil, fmt.Errorf("scheduling rule %d references unknown landscape configuration '%s': %w", i, lc, err)
+				}
+			}
+			res.Scheduling.Rules[i] = CompletedSchedulingRule{
+				Selector:       sel,
+				Configurations: rule.Configurations,
+			}
+		}
+	}
+
 	return res, nil
 }
 
@@ -400,12 +739,24 @@ func validateGardenerConfig(cfg *MultiGardenerConfiguration, fldPath *field.Path
 		}
 		if cfg.GardenerConfiguration == nil || len(cfg.Regions) == 0 {
 			allErrs = append(allErrs, field.Required(fldPath.Child("regions"), "regions must not be empty"))
+		} else {
+			allErrs = append(allErrs, validateRegions(cfg.Regions, fldPath.Child("regions"))...)
 		}
 		if cfg.GardenerConfiguration == nil || cfg.ShootTemplate == nil {
 			allErrs = append(allErrs, field.Required(fldPath.Child("shootTemplate"), "shootTemplate must not be empty"))
 		} else {
 			allErrs = append(allErrs, validateShootTemplate(cfg.ShootTemplate, fldPath.Child("shootTemplate"))...)
 		}
+		if cfg.GardenerConfiguration != nil {
+			allErrs = append(allErrs, validateRegionMapper(cfg.RegionMapper, fldPath.Child("regionMapper"))...)
+			allErrs = append(allErrs, validateKubernetesVersionPolicy(cfg.KubernetesVersionPolicy, fldPath.Child("kubernetesVersionPolicy"))...)
+			allErrs = append(allErrs, validateMaintenance(cfg.Maintenance, fldPath.Child("maintenance"))...)
+			allErrs = append(allErrs, validateSizingProfiles(cfg.SizingProfiles, cfg.DefaultSizingProfile, fldPath)...)
+			allErrs = append(allErrs, validateDrift(cfg.Drift, fldPath.Child("drift"))...)
+		}
+		if cfg.Scheduling != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("scheduling"), "scheduling is only supported in multi config mode"))
+		}
 	} else {
 		// multi config mode
 		if cfg.DefaultLandscapeAndConfiguration == "" {
@@ -455,9 +806,239 @@ func validateGardenerConfig(cfg *MultiGardenerConfiguration, fldPath *field.Path
 				}
 				if len(lscfg.Regions) == 0 {
 					allErrs = append(allErrs, field.Required(configPath.Child("regions"), "regions must not be empty"))
+				} else {
+					allErrs = append(allErrs, validateRegions(lscfg.Regions, configPath.Child("regions"))...)
 				}
 
 				allErrs = append(allErrs, validateShootTemplate(lscfg.ShootTemplate, configPath.Child("shootTemplate"))...)
+				allErrs = append(allErrs, validateRegionMapper(lscfg.RegionMapper, configPath.Child("regionMapper"))...)
+				allErrs = append(allErrs, validateKubernetesVersionPolicy(lscfg.KubernetesVersionPolicy, configPath.Child("kubernetesVersionPolicy"))...)
+				allErrs = append(allErrs, validateMaintenance(lscfg.Maintenance, configPath.Child("maintenance"))...)
+				allErrs = append(allErrs, validateSizingProfiles(lscfg.SizingProfiles, lscfg.DefaultSizingProfile, configPath)...)
+				allErrs = append(allErrs, validateDrift(lscfg.Drift, configPath.Child("drift"))...)
+			}
+		}
+
+		allErrs = append(allErrs, validateScheduling(cfg, fldPath.Child("scheduling"))...)
+	}
+
+	return allErrs
+}
+
+func validateKubernetesVersionPolicy(policy *KubernetesVersionPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy == nil {
+		return allErrs
+	}
+
+	switch policy.Type {
+	case KubernetesVersionPolicyPin:
+		if policy.Version != "" {
+			if _, err := semver.NewVersion(policy.Version); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("version"), policy.Version, fmt.Sprintf("invalid version: %s", err.Error())))
+			}
+		}
+	case KubernetesVersionPolicyLatestPatch, KubernetesVersionPolicyPreviousMinor:
+		if policy.Version != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("version"), fmt.Sprintf("version is only allowed for policy type '%s'", KubernetesVersionPolicyPin)))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), policy.Type, []KubernetesVersionPolicyType{KubernetesVersionPolicyPin, KubernetesVersionPolicyLatestPatch, KubernetesVersionPolicyPreviousMinor}))
+	}
+
+	return allErrs
+}
+
+func validateMaintenance(mcfg *MaintenanceConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if mcfg == nil {
+		return allErrs
+	}
+
+	for i, tw := range mcfg.AllowedTimeWindows {
+		twPath := fldPath.Child("allowedTimeWindows").Index(i)
+		if _, err := time.Parse("15:04", tw.Begin); err != nil {
+			allErrs = append(allErrs, field.Invalid(twPath.Child("begin"), tw.Begin, "must follow the format 'HH:MM'"))
+		}
+		if _, err := time.Parse("15:04", tw.End); err != nil {
+			allErrs = append(allErrs, field.Invalid(twPath.Child("end"), tw.End, "must follow the format 'HH:MM'"))
+		}
+	}
+	if mcfg.MinDuration != nil && mcfg.MinDuration.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minDuration"), mcfg.MinDuration.Duration.String(), "must not be negative"))
+	}
+	if mcfg.MaxDuration != nil && mcfg.MaxDuration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxDuration"), mcfg.MaxDuration.Duration.String(), "must be positive"))
+	}
+	if mcfg.MinDuration != nil && mcfg.MaxDuration != nil && mcfg.MinDuration.Duration > mcfg.MaxDuration.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minDuration"), mcfg.MinDuration.Duration.String(), "must not be greater than maxDuration"))
+	}
+
+	return allErrs
+}
+
+func validateSizingProfiles(profiles []SizingProfile, defaultProfile string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	validPurposes := []gardenv1beta1.ShootPurpose{gardenv1beta1.ShootPurposeEvaluation, gardenv1beta1.ShootPurposeTesting, gardenv1beta1.ShootPurposeDevelopment, gardenv1beta1.ShootPurposeProduction}
+	knownProfileNames := sets.New[string]()
+	for i, p := range profiles {
+		profilePath := fldPath.Child("sizingProfiles").Index(i)
+		if p.Name == "" {
+			allErrs = append(allErrs, field.Required(profilePath.Child("name"), "sizing profile name must not be empty"))
+		} else if knownProfileNames.Has(p.Name) {
+			allErrs = append(allErrs, field.Duplicate(profilePath.Child("name"), p.Name))
+		}
+		knownProfileNames.Insert(p.Name)
+		if p.Purpose != nil && !sets.New(validPurposes...).Has(*p.Purpose) {
+			allErrs = append(allErrs, field.NotSupported(profilePath.Child("purpose"), *p.Purpose, validPurposes))
+		}
+		if p.Minimum != nil && *p.Minimum < 0 {
+			allErrs = append(allErrs, field.Invalid(profilePath.Child("minimum"), *p.Minimum, "minimum must not be negative"))
+		}
+		if p.Maximum != nil && *p.Maximum < 1 {
+			allErrs = append(allErrs, field.Invalid(profilePath.Child("maximum"), *p.Maximum, "maximum must be positive"))
+		}
+		if p.Minimum != nil && p.Maximum != nil && *p.Minimum > *p.Maximum {
+			allErrs = append(allErrs, field.Invalid(profilePath.Child("minimum"), *p.Minimum, "minimum must not be greater than maximum"))
+		}
+	}
+
+	if defaultProfile != "" && !knownProfileNames.Has(defaultProfile) {
+		allErrs = append(allErrs, field.NotFound(fldPath.Child("defaultSizingProfile"), defaultProfile))
+	}
+
+	return allErrs
+}
+
+func validateDrift(dcfg *DriftConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if dcfg == nil {
+		return allErrs
+	}
+
+	for i, p := range dcfg.IgnorePaths {
+		if _, err := drift.ParsePath(p); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ignorePaths").Index(i), p, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func validateScheduling(cfg *MultiGardenerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.Scheduling == nil {
+		return allErrs
+	}
+
+	knownConfigs := sets.New[string]()
+	for _, ls := range cfg.Landscapes {
+		for _, lscfg := range ls.Configurations {
+			knownConfigs.Insert(CombinedName(ls.Name, lscfg.Name))
+		}
+	}
+
+	for i, rule := range cfg.Scheduling.Rules {
+		rulePath := fldPath.Child("rules").Index(i)
+		if rule.Selector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(rule.Selector); err != nil {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("selector"), rule.Selector, err.Error()))
+			}
+		}
+		if len(rule.Configurations) == 0 {
+			allErrs = append(allErrs, field.Required(rulePath.Child("configs"), "configs must not be empty"))
+		}
+		for j, lc := range rule.Configurations {
+			if !knownConfigs.Has(lc) {
+				allErrs = append(allErrs, field.NotFound(rulePath.Child("configs").Index(j), lc))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateRegions(regions []RegionConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	knownRegionNames := sets.New[string]()
+	for i, r := range regions {
+		regionPath := fldPath.Index(i)
+		if r.Name == "" {
+			allErrs = append(allErrs, field.Required(regionPath.Child("name"), "region name must not be empty"))
+		} else if knownRegionNames.Has(r.Name) {
+			allErrs = append(allErrs, field.Duplicate(regionPath.Child("name"), r.Name))
+		}
+		knownRegionNames.Insert(r.Name)
+		if r.Weight != nil && *r.Weight < 0 {
+			allErrs = append(allErrs, field.Invalid(regionPath.Child("weight"), *r.Weight, "weight must not be negative"))
+		}
+		if r.MaxShoots != nil && *r.MaxShoots < 0 {
+			allErrs = append(allErrs, field.Invalid(regionPath.Child("maxShoots"), *r.MaxShoots, "maxShoots must not be negative"))
+		}
+		if len(r.Zones) > 0 {
+			allErrs = append(allErrs, field.Forbidden(regionPath.Child("zones"), "zones are taken from the CloudProfile and must not be configured, remove them from the region configuration"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateRegionMapper(rm *RegionMapperConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if rm == nil {
+		return allErrs
+	}
+
+	if rm.Format == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("format"), "format must not be empty"))
+	} else if !strings.Contains(rm.Format, "%R") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("format"), rm.Format, "format must contain the region placeholder '%R'"))
+	}
+
+	if len(rm.Regions) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("regions"), "regions must not be empty"))
+	}
+	validRegions := sets.New(openmcpv1alpha1.AllRegions...)
+	validRegionNames := make([]string, len(openmcpv1alpha1.AllRegions))
+	for i, r := range openmcpv1alpha1.AllRegions {
+		validRegionNames[i] = string(r)
+	}
+	for _, r := range sets.List(sets.KeySet(rm.Regions)) {
+		if !validRegions.Has(r) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("regions").Key(string(r)), r, validRegionNames))
+		}
+	}
+
+	validDirections := sets.New(openmcpv1alpha1.AllDirections...)
+	validDirectionNames := make([]string, len(openmcpv1alpha1.AllDirections))
+	for i, d := range openmcpv1alpha1.AllDirections {
+		validDirectionNames[i] = string(d)
+	}
+	for _, d := range sets.List(sets.KeySet(rm.Directions)) {
+		if !validDirections.Has(d) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("directions").Key(string(d)), d, validDirectionNames))
+		}
+	}
+
+	if rm.Format == "" {
+		return allErrs
+	}
+
+	// verify that all regular expressions which can be produced by the mapper are valid
+	mapper := rm.Mapper()
+	for _, r := range sets.List(sets.KeySet(rm.Regions)) {
+		for _, d := range openmcpv1alpha1.AllDirections {
+			expr := mapper.MapGenericToSpecific(r, d)
+			if _, err := regexp.Compile(expr); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("regions").Key(string(r)), rm.Regions[r], fmt.Sprintf("mapping region '%s' with direction '%s' results in an invalid regular expression '%s': %v", r, d, expr, err)))
+				break
 			}
 		}
 	}
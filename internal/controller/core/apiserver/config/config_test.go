@@ -14,6 +14,7 @@ import (
 	openmcptesting "github.com/openmcp-project/controller-utils/pkg/testing"
 
 	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	"github.com/openmcp-project/mcp-operator/internal/utils/region"
 	testutils "github.com/openmcp-project/mcp-operator/test/utils"
 )
 
@@ -61,6 +62,32 @@ var _ = Describe("APIServer Config", func() {
 
 		Context("Gardener Config Loading", func() {
 
+			It("should prefer a configured region mapper over the predefined one", func() {
+				cfgFile := path.Join("testdata", "config_multi_valid.yaml")
+				cfg, err := apiserverconfig.LoadConfig(cfgFile)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cfg).ToNot(BeNil())
+
+				env := openmcptesting.NewComplexEnvironmentBuilder().WithFakeClient(gardenCluster, testutils.Scheme).WithInitObjectPath(gardenCluster, "testdata", "garden_cluster").WithFakeClient(gardenCluster2, testutils.Scheme).WithInitObjectPath(gardenCluster2, "testdata", "garden_cluster_2").Build()
+				cfg.GardenerConfig.InjectGardenClusterClient("default", env.Client(gardenCluster))
+				cfg.GardenerConfig.InjectGardenClusterClient("extra", env.Client(gardenCluster2))
+
+				cc, err := cfg.Complete(context.TODO())
+				Expect(err).ToNot(HaveOccurred())
+
+				// default/extra has a configured region mapper
+				mapper := cc.GardenerConfig.Landscapes["default"].Configurations["extra"].RegionMapper
+				Expect(mapper).ToNot(BeNil())
+				Expect(mapper.MapGenericToSpecific(openmcpv1alpha1.EUROPE, openmcpv1alpha1.CENTRAL)).To(Equal("^europe-(central|west)[0-9]+$"))
+				Expect(mapper.MapGenericToSpecific(openmcpv1alpha1.ASIA, openmcpv1alpha1.CENTRAL)).To(BeEmpty())
+				regions, err := region.GetClosestRegions(openmcpv1alpha1.RegionSpecification{Name: openmcpv1alpha1.EUROPE, Direction: openmcpv1alpha1.CENTRAL}, mapper, []string{"europe-west1", "europe-central2", "us-central1"}, true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(regions).To(ConsistOf("europe-west1", "europe-central2"))
+
+				// default/default uses the predefined mapper for gcp
+				Expect(cc.GardenerConfig.Landscapes["default"].Configurations["default"].RegionMapper).To(Equal(region.GCPMapper()))
+			})
+
 			for _, configMode := range []string{"single", "multi"} {
 				var affix string
 				var injectKubeconfigs func(cfg *apiserverconfig.MultiGardenerConfiguration, env *openmcptesting.ComplexEnvironment)
@@ -208,6 +235,111 @@ var _ = Describe("APIServer Config", func() {
 						Expect(err.Error()).To(ContainSubstring("kubeconfig"))
 					})
 
+					It("should detect invalid region configurations", func() {
+						cfgFile := path.Join("testdata", fmt.Sprintf("config_%sinvalid-8.yaml", affix))
+						cfg, err := apiserverconfig.LoadConfig(cfgFile)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(cfg).ToNot(BeNil())
+						err = apiserverconfig.Validate(cfg)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring("regions[0].weight"))
+						Expect(err.Error()).To(ContainSubstring("regions[1].maxShoots"))
+						Expect(err.Error()).To(ContainSubstring("regions[2].name: Duplicate value"))
+						Expect(err.Error()).To(ContainSubstring("regions[3].zones: Forbidden"))
+					})
+
+					It("should detect an invalid region mapper", func() {
+						cfgFile := path.Join("testdata", fmt.Sprintf("config_%sinvalid-7.yaml", affix))
+						cfg, err := apiserverconfig.LoadConfig(cfgFile)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(cfg).ToNot(BeNil())
+						err = apiserverconfig.Validate(cfg)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring("regionMapper.format"))
+						Expect(err.Error()).To(ContainSubstring("regionMapper.regions[atlantis]"))
+						Expect(err.Error()).To(ContainSubstring("regionMapper.directions[up]"))
+						Expect(err.Error()).To(ContainSubstring("invalid regular expression"))
+					})
+
+					It("should detect an invalid scheduling configuration", func() {
+						cfgFile := path.Join("testdata", fmt.Sprintf("config_%sinvalid-9.yaml", affix))
+						cfg, err := apiserverconfig.LoadConfig(cfgFile)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(cfg).ToNot(BeNil())
+						err = apiserverconfig.Validate(cfg)
+						Expect(err).To(HaveOccurred())
+						switch configMode {
+						case "single":
+							Expect(err.Error()).To(ContainSubstring("scheduling: Forbidden"))
+						case "multi":
+							Expect(err.Error()).To(ContainSubstring("scheduling.rules[0].selector"))
+							Expect(err.Error()).To(ContainSubstring("scheduling.rules[1].configs[1]: Not found"))
+							Expect(err.Error()).To(ContainSubstring("scheduling.rules[2].configs: Required value"))
+						}
+					})
+
+					It("should detect an invalid kubernetes version policy", func() {
+						cfgFile := path.Join("testdata", fmt.Sprintf("config_%sinvalid-10.yaml", affix))
+						cfg, err := apiserverconfig.LoadConfig(cfgFile)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(cfg).ToNot(BeNil())
+						err = apiserverconfig.Validate(cfg)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring("kubernetesVersionPolicy.version: Forbidden"))
+						if configMode == "multi" {
+							Expect(err.Error()).To(ContainSubstring("configs[1].kubernetesVersionPolicy.version: Invalid value"))
+							Expect(err.Error()).To(ContainSubstring("configs[0].kubernetesVersionPolicy.type: Unsupported value"))
+						}
+					})
+
+					It("should detect an invalid maintenance configuration", func() {
+						cfgFile := path.Join("testdata", fmt.Sprintf("config_%sinvalid-11.yaml", affix))
+						cfg, err := apiserverconfig.LoadConfig(cfgFile)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(cfg).ToNot(BeNil())
+						err = apiserverconfig.Validate(cfg)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring("maintenance.allowedTimeWindows[0].end"))
+						Expect(err.Error()).To(ContainSubstring("maintenance.minDuration"))
+						if configMode == "multi" {
+							Expect(err.Error()).To(ContainSubstring("configs[0].maintenance.allowedTimeWindows[0].begin"))
+							Expect(err.Error()).To(ContainSubstring("configs[0].maintenance.maxDuration"))
+						}
+					})
+
+					It("should detect invalid sizing profiles", func() {
+						cfgFile := path.Join("testdata", fmt.Sprintf("config_%sinvalid-12.yaml", affix))
+						cfg, err := apiserverconfig.LoadConfig(cfgFile)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(cfg).ToNot(BeNil())
+						err = apiserverconfig.Validate(cfg)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring("sizingProfiles[0].purpose: Unsupported value"))
+						Expect(err.Error()).To(ContainSubstring("sizingProfiles[0].minimum: Invalid value"))
+						Expect(err.Error()).To(ContainSubstring("sizingProfiles[1].name: Duplicate value"))
+						Expect(err.Error()).To(ContainSubstring("defaultSizingProfile: Not found"))
+						if configMode == "multi" {
+							Expect(err.Error()).To(ContainSubstring("configs[0].sizingProfiles[0].name: Required value"))
+							Expect(err.Error()).To(ContainSubstring("configs[0].sizingProfiles[0].maximum: Invalid value"))
+						}
+					})
+
+					It("should detect invalid drift ignore paths", func() {
+						cfgFile := path.Join("testdata", fmt.Sprintf("config_%sinvalid-13.yaml", affix))
+						cfg, err := apiserverconfig.LoadConfig(cfgFile)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(cfg).ToNot(BeNil())
+						err = apiserverconfig.Validate(cfg)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring("drift.ignorePaths[1]: Invalid value"))
+						Expect(err.Error()).ToNot(ContainSubstring("drift.ignorePaths[0]: Invalid value: \"spec"))
+						if configMode == "multi" {
+							Expect(err.Error()).To(ContainSubstring("configs[0].drift.ignorePaths[0]: Invalid value: \"\""))
+						} else {
+							Expect(err.Error()).To(ContainSubstring("drift.ignorePaths[2]: Invalid value"))
+						}
+					})
+
 				})
 			}
 
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/openmcp-project/mcp-operator/internal/components"
 	"github.com/openmcp-project/mcp-operator/internal/utils"
 	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/openmcp-project/controller-utils/pkg/logging"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -34,7 +36,12 @@ func (r *APIServerProvider) GetAPIServerHandlerForType(ctx context.Context, t op
 	switch t {
 	case openmcpv1alpha1.Gardener, openmcpv1alpha1.GardenerDedicated:
 		log.Debug(fmt.Sprintf("APIServer has type %s, loading corresponding connector", string(t)))
-		return gardener.NewGardenerConnector(cfg.CompletedCommonConfig, cfg.GardenerConfig, t)
+		gc, err := gardener.NewGardenerConnector(cfg.CompletedCommonConfig, cfg.GardenerConfig, t)
+		if err != nil {
+			return nil, err
+		}
+		gc.EventRecorder = r.EventRecorder
+		return gc, nil
 	case "Fake":
 		if r.FakeHandler != nil {
 			return r.FakeHandler, nil
@@ -73,6 +80,10 @@ type APIServerProvider struct {
 	// FakeHandler is a fake APIServerHandler for testing purposes.
 	// It should only be non-nil in tests.
 	FakeHandler apiserverhandler.APIServerHandler
+
+	// EventRecorder is used to emit events for APIServers.
+	// It is set when the controller is added to the manager.
+	EventRecorder record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=core.openmcp.cloud,resources=apiservers,verbs=get;list;watch;create;update;patch;delete
@@ -149,7 +160,11 @@ func (r *APIServerProvider) reconcile(ctx context.Context, req ctrl.Request) com
 	var cons []openmcpv1alpha1.ComponentCondition
 	var errr openmcperrors.ReasonableError
 
-	if mcpocfg.Config.Architecture.DecideVersion(as) == openmcpv1alpha1.ArchitectureV2 {
+	migrating, migRes, migCons, migErr := r.handleMigration(ctx, as, deleteAPIServer)
+	if migrating {
+		// migration from v1 to v2 (or its rollback) is in progress
+		res, cons, errr = migRes, migCons, migErr
+	} else if mcpocfg.Config.Architecture.DecideVersion(as) == openmcpv1alpha1.ArchitectureV2 {
 		// v2 logic
 		log.Info("Using v2 logic for APIServer")
 		if !deleteAPIServer {
@@ -172,6 +187,11 @@ func (r *APIServerProvider) reconcile(ctx context.Context, req ctrl.Request) com
 		}
 	}
 
+	if !migrating && !deleteAPIServer {
+		// report the migration status, unless this would block the deletion
+		cons = append(cons, migCons...)
+	}
+
 	errs := openmcperrors.NewReasonableErrorList(errr)
 
 	if usf != nil {
@@ -196,8 +216,29 @@ func (r *APIServerProvider) reconcile(ctx context.Context, req ctrl.Request) com
 	return componentutils.ReconcileResult[*openmcpv1alpha1.APIServer]{Component: as, OldComponent: old, Result: res, ReconcileError: errs.Aggregate(), Conditions: cons}
 }
 
+// PlanDownstream implements components.DownstreamPlanner.
+// It returns nil if the handler for the APIServer's type doesn't support planning.
+func (r *APIServerProvider) PlanDownstream(ctx context.Context, desired, _ components.Component) ([]components.DownstreamChange, error) {
+	as, ok := desired.(*openmcpv1alpha1.APIServer)
+	if !ok {
+		return nil, fmt.Errorf("expected component of type *APIServer, got %T", desired)
+	}
+	handler, err := r.GetAPIServerHandlerForType(ctx, as.Spec.Type, r.CompletedAPIServerProviderConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	planner, ok := handler.(apiserverhandler.APIServerPlanner)
+	if !ok {
+		return nil, nil
+	}
+	return planner.PlanCreateOrUpdate(ctx, as)
+}
+
 // SetupWithManager sets up the controller with the Manager.
+// It also registers the controller as DownstreamPlanner for APIServers.
 func (r *APIServerProvider) SetupWithManager(mgr ctrl.Manager) error {
+	r.EventRecorder = mgr.GetEventRecorderFor(ControllerName)
+	components.Planners.Register(openmcpv1alpha1.APIServerComponent, r)
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openmcpv1alpha1.APIServer{}).
 		WithEventFilter(componentutils.DefaultComponentControllerPredicates()).
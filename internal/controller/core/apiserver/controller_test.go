@@ -25,9 +25,11 @@ import (
 
 	. "github.com/openmcp-project/mcp-operator/test/matchers"
 
+	gcpv1alpha1 "github.com/openmcp-project/cluster-provider-gardener/api/core/v1alpha1"
 	"github.com/openmcp-project/controller-utils/pkg/testing"
 	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
 	commonapi "github.com/openmcp-project/openmcp-operator/api/common"
+	openmcpconst "github.com/openmcp-project/openmcp-operator/api/constants"
 	openmcpclusterutils "github.com/openmcp-project/openmcp-operator/lib/utils"
 
 	gardenv1beta1 "github.com/openmcp-project/mcp-operator/api/external/gardener/pkg/apis/core/v1beta1"
@@ -35,6 +37,7 @@ import (
 	cconst "github.com/openmcp-project/mcp-operator/api/constants"
 	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
 	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
+	"github.com/openmcp-project/mcp-operator/internal/utils/migration"
 	testutils "github.com/openmcp-project/mcp-operator/test/utils"
 )
 
@@ -560,4 +563,266 @@ var _ = Describe("CO-1153 APIServer Controller", func() {
 
 	})
 
+	Context("migration", func() {
+
+		BeforeEach(func() {
+			mcpocfg.Config.Architecture.APIServer.Version = openmcpv1alpha1.ArchitectureV1
+			mcpocfg.Config.Architecture.Migration.ClusterProfile = "gardener"
+		})
+
+		AfterEach(func() {
+			mcpocfg.Config.Architecture.Migration.ClusterProfile = ""
+		})
+
+		// setShootStatus mocks the shoot which has been created by the v1 logic
+		setShootStatus := func(env *testing.ComplexEnvironment, as *openmcpv1alpha1.APIServer) {
+			as.Status.GardenerStatus = &openmcpv1alpha1.GardenerStatus{
+				Shoot: &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"core.gardener.cloud/v1beta1","kind":"Shoot","metadata":{"name":"test-shoot","namespace":"garden-test"},"spec":{"kubernetes":{"version":"1.32.2"}}}`),
+				},
+			}
+			Expect(env.Client(testutils.CrateCluster).Status().Update(env.Ctx, as)).To(Succeed())
+		}
+
+		// createClusterProfile mocks the cluster profile configured for migrations, whose Gardener project belongs to the given namespace
+		createClusterProfile := func(env *testing.ComplexEnvironment, projectNamespace string) {
+			platformClient := env.Client(testutils.LaaSCoreCluster)
+			ls := &gcpv1alpha1.Landscape{}
+			ls.Name = "gardener"
+			ls.Status.Projects = []gcpv1alpha1.ProjectData{{Name: "test", Namespace: projectNamespace}}
+			Expect(platformClient.Create(env.Ctx, ls)).To(Succeed())
+			pc := &gcpv1alpha1.ProviderConfig{}
+			pc.Name = "gardener"
+			pc.Spec.LandscapeRef.Name = ls.Name
+			pc.Spec.Project = "test"
+			Expect(platformClient.Create(env.Ctx, pc)).To(Succeed())
+			profile := &clustersv1alpha1.ClusterProfile{}
+			profile.Name = "gardener"
+			profile.Spec.ProviderConfigRef.Name = pc.Name
+			Expect(platformClient.Create(env.Ctx, profile)).To(Succeed())
+		}
+
+		It("should adopt the existing shoot and switch to the v2 logic afterwards", func() {
+			env := testEnvSetup("testdata", "test-08")
+
+			as := &openmcpv1alpha1.APIServer{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, as)).To(Succeed())
+			setShootStatus(env, as)
+			createClusterProfile(env, "garden-test")
+
+			// the v1 handler must not be called during the migration
+			req := testing.RequestFromObject(as)
+			rr := env.ShouldReconcile(apiServerReconciler, req)
+			Expect(rr.RequeueAfter).To(BeNumerically(">", 0))
+
+			nsName, err := openmcpclusterutils.StableMCPNamespace(as.Name, as.Namespace)
+			Expect(err).NotTo(HaveOccurred())
+			cr := &clustersv1alpha1.ClusterRequest{}
+			cr.Name = as.Name
+			cr.Namespace = nsName
+			Expect(env.Client(testutils.LaaSCoreCluster).Get(env.Ctx, client.ObjectKeyFromObject(cr), cr)).To(Succeed())
+			Expect(cr.Spec.Purpose).To(Equal("mcp"))
+			Expect(cr.Annotations).ToNot(HaveKey(openmcpconst.OperationAnnotation))
+			Expect(cr.Status.Phase).To(Equal(clustersv1alpha1.REQUEST_GRANTED))
+			Expect(cr.Status.Cluster).ToNot(BeNil())
+			Expect(cr.Status.Cluster.Name).To(Equal(as.Name))
+			Expect(cr.Status.Cluster.Namespace).To(Equal(nsName))
+
+			cluster := &clustersv1alpha1.Cluster{}
+			cluster.Name = as.Name
+			cluster.Namespace = nsName
+			Expect(env.Client(testutils.LaaSCoreCluster).Get(env.Ctx, client.ObjectKeyFromObject(cluster), cluster)).To(Succeed())
+			Expect(cluster.Labels).To(HaveKeyWithValue(gcpv1alpha1.ShootNameLabel, "test-shoot"))
+			Expect(cluster.Labels).To(HaveKeyWithValue(clustersv1alpha1.DeleteWithoutRequestsLabel, "true"))
+			Expect(cluster.Finalizers).To(ContainElement(cr.FinalizerForCluster()))
+			Expect(cluster.Spec.Profile).To(Equal("gardener"))
+			Expect(cluster.Spec.Kubernetes.Version).To(Equal("1.32.2"))
+			Expect(cluster.Spec.Purposes).To(ConsistOf("mcp"))
+
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(as), as)).To(Succeed())
+			Expect(as.Labels).To(HaveKeyWithValue(openmcpv1alpha1.ArchitectureVersionLabel, openmcpv1alpha1.ArchitectureV1))
+			Expect(as.Status.Conditions).To(ContainElements(
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   openmcpv1alpha1.APIServerComponent.HealthyCondition(),
+					Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+					Reason: cconst.ReasonMigrationInProgress,
+				}),
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   openmcpv1alpha1.APIServerComponent.ArchitectureMigrationCondition(),
+					Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+					Reason: cconst.ReasonMigrationInProgress,
+				}),
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   migration.StepCondition(openmcpv1alpha1.APIServerComponent, "ClusterAdopted"),
+					Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+				}),
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   migration.StepCondition(openmcpv1alpha1.APIServerComponent, "ClusterReady"),
+					Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+					Reason: cconst.ReasonMigrationStepInProgress,
+				}),
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   migration.StepCondition(openmcpv1alpha1.APIServerComponent, migration.StepArchitectureSwitched),
+					Status: openmcpv1alpha1.ComponentConditionStatusUnknown,
+					Reason: cconst.ReasonMigrationStepPending,
+				}),
+			))
+
+			// mock the cluster provider having picked up the shoot
+			cluster.Status.Phase = clustersv1alpha1.CLUSTER_PHASE_READY
+			Expect(env.Client(testutils.LaaSCoreCluster).Status().Update(env.Ctx, cluster)).To(Succeed())
+
+			// the migration completes and the v2 logic takes over
+			rr = env.ShouldReconcile(apiServerReconciler, req)
+			Expect(rr.RequeueAfter).To(BeNumerically(">", 0))
+
+			ar := &clustersv1alpha1.AccessRequest{}
+			ar.Name = as.Name
+			ar.Namespace = nsName
+			Expect(env.Client(testutils.LaaSCoreCluster).Get(env.Ctx, client.ObjectKeyFromObject(ar), ar)).To(Succeed())
+
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(as), as)).To(Succeed())
+			Expect(as.Labels).To(HaveKeyWithValue(openmcpv1alpha1.ArchitectureVersionLabel, openmcpv1alpha1.ArchitectureV2))
+			Expect(as.Status.Conditions).To(ContainElements(
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   openmcpv1alpha1.APIServerComponent.ArchitectureMigrationCondition(),
+					Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+					Reason: cconst.ReasonMigrationCompleted,
+				}),
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   cconst.ConditionClusterRequestGranted,
+					Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+				}),
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   cconst.ConditionClusterReady,
+					Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+				}),
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   cconst.ConditionAccessRequestGranted,
+					Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+				}),
+			))
+
+			// a rollback is not possible anymore
+			as.Annotations[openmcpv1alpha1.ArchitectureMigrationAnnotation] = openmcpv1alpha1.ArchitectureMigrationValueRollback
+			Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, as)).To(Succeed())
+			env.ShouldReconcile(apiServerReconciler, req)
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(as), as)).To(Succeed())
+			Expect(as.Labels).To(HaveKeyWithValue(openmcpv1alpha1.ArchitectureVersionLabel, openmcpv1alpha1.ArchitectureV2))
+			Expect(as.Status.Conditions).To(ContainElement(
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   openmcpv1alpha1.APIServerComponent.ArchitectureMigrationCondition(),
+					Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+					Reason: cconst.ReasonMigrationRollbackNotPossible,
+				}),
+			))
+			Expect(env.Client(testutils.LaaSCoreCluster).Get(env.Ctx, client.ObjectKeyFromObject(cluster), cluster)).To(Succeed())
+		})
+
+		It("should roll back an unfinished migration without touching the shoot", func() {
+			env := testEnvSetup("testdata", "test-08")
+
+			as := &openmcpv1alpha1.APIServer{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, as)).To(Succeed())
+			setShootStatus(env, as)
+			createClusterProfile(env, "garden-test")
+
+			req := testing.RequestFromObject(as)
+			env.ShouldReconcile(apiServerReconciler, req)
+
+			nsName, err := openmcpclusterutils.StableMCPNamespace(as.Name, as.Namespace)
+			Expect(err).NotTo(HaveOccurred())
+			cr := &clustersv1alpha1.ClusterRequest{}
+			cr.Name = as.Name
+			cr.Namespace = nsName
+			Expect(env.Client(testutils.LaaSCoreCluster).Get(env.Ctx, client.ObjectKeyFromObject(cr), cr)).To(Succeed())
+			cluster := &clustersv1alpha1.Cluster{}
+			cluster.Name = as.Name
+			cluster.Namespace = nsName
+			Expect(env.Client(testutils.LaaSCoreCluster).Get(env.Ctx, client.ObjectKeyFromObject(cluster), cluster)).To(Succeed())
+
+			// request the rollback, the v1 logic continues afterwards
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(as), as)).To(Succeed())
+			as.Annotations[openmcpv1alpha1.ArchitectureMigrationAnnotation] = openmcpv1alpha1.ArchitectureMigrationValueRollback
+			Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, as)).To(Succeed())
+			// once the rollback is completed, the v1 logic takes over again, which fails because no Gardener handler is configured in this test
+			env.ShouldNotReconcile(apiServerReconciler, req)
+
+			Expect(env.Client(testutils.LaaSCoreCluster).Get(env.Ctx, client.ObjectKeyFromObject(cr), cr)).To(MatchError(apierrors.IsNotFound, "IsNotFound"))
+			Expect(env.Client(testutils.LaaSCoreCluster).Get(env.Ctx, client.ObjectKeyFromObject(cluster), cluster)).To(MatchError(apierrors.IsNotFound, "IsNotFound"))
+
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(as), as)).To(Succeed())
+			Expect(as.Labels).To(HaveKeyWithValue(openmcpv1alpha1.ArchitectureVersionLabel, openmcpv1alpha1.ArchitectureV1))
+			uShoot, err := as.Status.GardenerStatus.GetShoot()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(uShoot.GetName()).To(Equal("test-shoot"))
+		})
+
+		It("should roll back an unfinished migration if the migration annotation is removed", func() {
+			env := testEnvSetup("testdata", "test-08")
+
+			as := &openmcpv1alpha1.APIServer{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, as)).To(Succeed())
+			setShootStatus(env, as)
+			createClusterProfile(env, "garden-test")
+
+			req := testing.RequestFromObject(as)
+			env.ShouldReconcile(apiServerReconciler, req)
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(as), as)).To(Succeed())
+			Expect(as.Status.Conditions).To(ContainElement(MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+				Type:   openmcpv1alpha1.APIServerComponent.ArchitectureMigrationCondition(),
+				Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+				Reason: cconst.ReasonMigrationInProgress,
+			})))
+
+			nsName, err := openmcpclusterutils.StableMCPNamespace(as.Name, as.Namespace)
+			Expect(err).NotTo(HaveOccurred())
+			cr := &clustersv1alpha1.ClusterRequest{}
+			cr.Name = as.Name
+			cr.Namespace = nsName
+			Expect(env.Client(testutils.LaaSCoreCluster).Get(env.Ctx, client.ObjectKeyFromObject(cr), cr)).To(Succeed())
+			cluster := &clustersv1alpha1.Cluster{}
+			cluster.Name = as.Name
+			cluster.Namespace = nsName
+			Expect(env.Client(testutils.LaaSCoreCluster).Get(env.Ctx, client.ObjectKeyFromObject(cluster), cluster)).To(Succeed())
+
+			// remove the annotation while the migration is in progress, which rolls it back
+			delete(as.Annotations, openmcpv1alpha1.ArchitectureMigrationAnnotation)
+			Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, as)).To(Succeed())
+			// once the rollback is completed, the v1 logic takes over again, which fails because no Gardener handler is configured in this test
+			env.ShouldNotReconcile(apiServerReconciler, req)
+
+			Expect(env.Client(testutils.LaaSCoreCluster).Get(env.Ctx, client.ObjectKeyFromObject(cr), cr)).To(MatchError(apierrors.IsNotFound, "IsNotFound"))
+			Expect(env.Client(testutils.LaaSCoreCluster).Get(env.Ctx, client.ObjectKeyFromObject(cluster), cluster)).To(MatchError(apierrors.IsNotFound, "IsNotFound"))
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(as), as)).To(Succeed())
+			Expect(as.Labels).To(HaveKeyWithValue(openmcpv1alpha1.ArchitectureVersionLabel, openmcpv1alpha1.ArchitectureV1))
+		})
+
+		It("should not adopt the shoot if the cluster profile uses a different Gardener project", func() {
+			env := testEnvSetup("testdata", "test-08")
+
+			as := &openmcpv1alpha1.APIServer{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, as)).To(Succeed())
+			setShootStatus(env, as)
+			createClusterProfile(env, "garden-other")
+
+			req := testing.RequestFromObject(as)
+			env.ShouldNotReconcile(apiServerReconciler, req)
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(as), as)).To(Succeed())
+			Expect(as.Status.Conditions).To(ContainElement(MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+				Type:   migration.StepCondition(openmcpv1alpha1.APIServerComponent, "Prerequisites"),
+				Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+				Reason: cconst.ReasonConfigurationProblem,
+			})))
+
+			nsName, err := openmcpclusterutils.StableMCPNamespace(as.Name, as.Namespace)
+			Expect(err).NotTo(HaveOccurred())
+			cr := &clustersv1alpha1.ClusterRequest{}
+			cr.Name = as.Name
+			cr.Namespace = nsName
+			Expect(env.Client(testutils.LaaSCoreCluster).Get(env.Ctx, client.ObjectKeyFromObject(cr), cr)).To(MatchError(apierrors.IsNotFound, "IsNotFound"))
+		})
+
+	})
+
 })
@@ -0,0 +1,246 @@
+package gardener
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/csaupgrade"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v6/value"
+
+	apiserverhandler "github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/handler"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
+)
+
+const (
+	// FieldManager is the field manager which is used for server-side apply requests to the Garden cluster.
+	// It matches the name under which the operator previously wrote these resources via update requests,
+	// so that the ownership of these fields can be migrated.
+	FieldManager = "mcp-operator"
+
+	// EventReasonFieldOwnershipConflict is the reason of the event which is emitted when a resource cannot be applied due to field ownership conflicts.
+	EventReasonFieldOwnershipConflict = "FieldOwnershipConflict"
+
+	// fieldOwnershipConflictRequeueInterval is the interval after which an APIServer is reconciled again after a field ownership conflict.
+	// Retrying immediately would not resolve the conflict, it requires the other field manager to release the fields.
+	fieldOwnershipConflictRequeueInterval = 10 * time.Minute
+)
+
+// applyObject writes the desired object to the cluster via server-side apply, so that the operator only owns the fields it sets.
+// If the live object is given, fields which have been written by the operator via update requests are migrated to the apply field manager first.
+// The applied object then only contains the fields of the desired object which are already owned by the operator or differ from the live object,
+// and the resource version of the live object is used as precondition, so that a desired state computed from an outdated object is not applied.
+// The desired object is updated with the response from the cluster.
+func applyObject(ctx context.Context, c client.Client, live, desired client.Object) error {
+	gvk, err := c.GroupVersionKindFor(desired)
+	if err != nil {
+		return err
+	}
+	data, err := applyData(desired)
+	if err != nil {
+		return err
+	}
+	if live != nil {
+		patch, err := csaupgrade.UpgradeManagedFieldsPatch(live, sets.New(FieldManager), FieldManager)
+		if err != nil {
+			return fmt.Errorf("error computing managed fields upgrade patch: %w", err)
+		}
+		if patch != nil {
+			if err := c.Patch(ctx, live, client.RawPatch(types.JSONPatchType, patch)); err != nil {
+				return fmt.Errorf("error upgrading managed fields: %w", err)
+			}
+		}
+		owned, err := ownedFields(live)
+		if err != nil {
+			return err
+		}
+		liveData, err := applyData(live)
+		if err != nil {
+			return err
+		}
+		data = selectFields(data, liveData, owned)
+	}
+	u := &unstructured.Unstructured{Object: data}
+	u.SetGroupVersionKind(gvk)
+	u.SetName(desired.GetName())
+	u.SetNamespace(desired.GetNamespace())
+	if live != nil {
+		u.SetResourceVersion(live.GetResourceVersion())
+	}
+
+	if err := c.Apply(ctx, client.ApplyConfigurationFromUnstructured(u), client.FieldOwner(FieldManager)); err != nil {
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, desired)
+}
+
+// applyData converts the given object into its unstructured representation, without the fields which are never set by the operator.
+func applyData(obj client.Object) (map[string]any, error) {
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	unstructured.RemoveNestedField(data, "status")
+	for _, field := range []string{"uid", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp", "deletionGracePeriodSeconds", "finalizers", "ownerReferences", "managedFields"} {
+		unstructured.RemoveNestedField(data, "metadata", field)
+	}
+	return data, nil
+}
+
+// ownedFields returns the fields of the given object which are owned by the operator's apply field manager.
+func ownedFields(obj client.Object) (*fieldpath.Set, error) {
+	owned := &fieldpath.Set{}
+	for _, mf := range obj.GetManagedFields() {
+		if mf.Manager != FieldManager || mf.Operation != metav1.ManagedFieldsOperationApply || mf.Subresource != "" || mf.FieldsV1 == nil {
+			continue
+		}
+		fs := &fieldpath.Set{}
+		if err := fs.FromJSON(bytes.NewReader(mf.FieldsV1.Raw)); err != nil {
+			return nil, fmt.Errorf("error parsing managed fields of field manager '%s': %w", FieldManager, err)
+		}
+		owned = owned.Union(fs)
+	}
+	return owned, nil
+}
+
+// selectFields returns the part of the desired data which the operator has to apply:
+// fields which differ from the live data, as well as fields which are owned by the operator, because omitting them would remove them.
+// Lists are not merged item by item, a list which differs from the live one is selected as a whole.
+func selectFields(desired, live map[string]any, owned *fieldpath.Set) map[string]any {
+	res := map[string]any{}
+	for k, dv := range desired {
+		pe := fieldpath.PathElement{FieldName: ptr.To(k)}
+		lv := live[k]
+		dm, isMap := dv.(map[string]any)
+		lm, liveIsMap := lv.(map[string]any)
+		switch {
+		case isMap && liveIsMap:
+			if sub := selectFields(dm, lm, owned.WithPrefix(pe)); len(sub) > 0 || owned.Members.Has(pe) {
+				res[k] = sub
+			}
+		case !equality.Semantic.DeepEqual(dv, lv), owned.Members.Has(pe):
+			res[k] = dv
+		default:
+			if dl, ok := dv.([]any); ok {
+				if items := selectOwnedItems(dl, owned.WithPrefix(pe)); len(items) > 0 {
+					res[k] = items
+				}
+			}
+		}
+	}
+	return res
+}
+
+// selectOwnedItems returns the items of the given list which are owned by the operator, restricted to the owned fields.
+// The list is expected to be identical to the live one.
+func selectOwnedItems(list []any, owned *fieldpath.Set) []any {
+	if owned.Empty() {
+		return nil
+	}
+	res := []any{}
+	for i, item := range list {
+		pe, ok := listItemPathElement(owned, i, item)
+		if !ok {
+			continue
+		}
+		if m, isMap := item.(map[string]any); isMap {
+			if sub := selectFields(m, m, owned.WithPrefix(pe)); len(sub) > 0 {
+				res = append(res, sub)
+				continue
+			}
+		}
+		if owned.Members.Has(pe) {
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
+// listItemPathElement returns the path element of the given set which identifies the list item with the given index, if any.
+func listItemPathElement(set *fieldpath.Set, index int, item any) (fieldpath.PathElement, bool) {
+	var res fieldpath.PathElement
+	found := false
+	matches := func(pe fieldpath.PathElement) {
+		if found {
+			return
+		}
+		switch {
+		case pe.Index != nil:
+			found = *pe.Index == index
+		case pe.Value != nil:
+			found = value.Equals(value.NewValueInterface(item), *pe.Value)
+		case pe.Key != nil:
+			m, ok := item.(map[string]any)
+			if !ok {
+				return
+			}
+			found = true
+			for _, f := range *pe.Key {
+				if fv, ok := m[f.Name]; !ok || !value.Equals(value.NewValueInterface(fv), f.Value) {
+					found = false
+					break
+				}
+			}
+		}
+		if found {
+			res = pe
+		}
+	}
+	set.Members.Iterate(matches)
+	set.Children.Iterate(matches)
+	return res, found
+}
+
+// fieldOwnershipConflictError returns an error with reason FieldOwnershipConflict
+// if the given error has been caused by field ownership conflicts during a server-side apply request.
+// Otherwise, nil is returned.
+func fieldOwnershipConflictError(kind string, obj client.Object, err error) openmcperrors.ReasonableError {
+	if !apierrors.IsConflict(err) {
+		return nil
+	}
+	var status apierrors.APIStatus
+	if !errors.As(err, &status) || status.Status().Details == nil {
+		return nil
+	}
+	conflicts := []string{}
+	for _, cause := range status.Status().Details.Causes {
+		if cause.Type == metav1.CauseTypeFieldManagerConflict {
+			conflicts = append(conflicts, fmt.Sprintf("%s (%s)", cause.Field, cause.Message))
+		}
+	}
+	if len(conflicts) == 0 {
+		return nil
+	}
+	return openmcperrors.WithReason(fmt.Errorf("fields of %s '%s' are owned by another field manager: %s", kind, client.ObjectKeyFromObject(obj).String(), strings.Join(conflicts, "; ")), cconst.ReasonFieldOwnershipConflict)
+}
+
+// handleFieldOwnershipConflict reports a field ownership conflict in the APIServer conditions and as an event.
+// The conflict is not returned as an error, because retrying immediately would not resolve it.
+// Instead, the APIServer is reconciled again after a longer interval.
+func (gc *GardenerConnector) handleFieldOwnershipConflict(ctx context.Context, as *openmcpv1alpha1.APIServer, errr openmcperrors.ReasonableError, usf apiserverhandler.UpdateStatusFunc) (ctrl.Result, apiserverhandler.UpdateStatusFunc, []openmcpv1alpha1.ComponentCondition, openmcperrors.ReasonableError) {
+	log := logging.FromContextOrPanic(ctx)
+	log.Info("Field ownership conflict in Garden cluster", "error", errr.Error(), "requeueAfter", fieldOwnershipConflictRequeueInterval.String())
+	if gc.EventRecorder != nil {
+		gc.EventRecorder.Event(as, corev1.EventTypeWarning, EventReasonFieldOwnershipConflict, errr.Error())
+	}
+	return ctrl.Result{RequeueAfter: fieldOwnershipConflictRequeueInterval}, usf, gardenerConditions(false, errr.Reason(), errr.Error()), nil
+}
@@ -0,0 +1,98 @@
+package gardener_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/openmcp-project/mcp-operator/test/matchers"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	gardenv1beta1 "github.com/openmcp-project/mcp-operator/api/external/gardener/pkg/apis/core/v1beta1"
+	"github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/handler/gardener"
+	testutils "github.com/openmcp-project/mcp-operator/test/utils"
+)
+
+var _ = Describe("Server-Side Apply", func() {
+
+	managers := func(obj client.Object) map[string]metav1.ManagedFieldsOperationType {
+		res := map[string]metav1.ManagedFieldsOperationType{}
+		for _, mf := range obj.GetManagedFields() {
+			res[mf.Manager] = mf.Operation
+		}
+		return res
+	}
+
+	It("should apply shoots and audit log resources with the operator's field manager", func() {
+		gc, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "", "testdata", "connector", "apiserver-06.yaml")
+		_, _, _, err := gc.HandleCreateOrUpdate(env.Ctx, as, env.Client(testutils.CrateCluster))
+		Expect(err).ToNot(HaveOccurred())
+
+		cmGarden := &corev1.ConfigMap{}
+		Expect(env.Client(gardenCluster).Get(env.Ctx, types.NamespacedName{Name: "test--auditlog-policy", Namespace: "garden-test"}, cmGarden)).To(Succeed())
+		Expect(managers(cmGarden)).To(HaveKeyWithValue(gardener.FieldManager, metav1.ManagedFieldsOperationApply))
+		secretGarden := &corev1.Secret{}
+		Expect(env.Client(gardenCluster).Get(env.Ctx, types.NamespacedName{Name: "test--auditlog-credentials", Namespace: "garden-test"}, secretGarden)).To(Succeed())
+		Expect(managers(secretGarden)).To(HaveKeyWithValue(gardener.FieldManager, metav1.ManagedFieldsOperationApply))
+		sh := &gardenv1beta1.Shoot{}
+		Expect(env.Client(gardenCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "garden-test"}, sh)).To(Succeed())
+		Expect(managers(sh)).To(HaveKeyWithValue(gardener.FieldManager, metav1.ManagedFieldsOperationApply))
+	})
+
+	It("should report field ownership conflicts instead of overwriting fields owned by other field managers", func() {
+		gc, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "", "testdata", "connector", "apiserver-04.yaml")
+		_, _, _, err := gc.HandleCreateOrUpdate(env.Ctx, as, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// another controller takes over an enforced annotation
+		sh := &gardenv1beta1.Shoot{}
+		Expect(env.Client(gardenCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "garden-test"}, sh)).To(Succeed())
+		old := sh.DeepCopy()
+		sh.Annotations["shoot.gardener.cloud/cleanup-extended-apis-finalize-grace-period-seconds"] = "600"
+		Expect(env.Client(gardenCluster).Patch(env.Ctx, sh, client.MergeFrom(old), client.FieldOwner("other-controller"))).To(Succeed())
+
+		res, _, cons, err := gc.HandleCreateOrUpdate(env.Ctx, as, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(cons).To(ConsistOf(
+			MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+				Type:   openmcpv1alpha1.APIServerComponent.HealthyCondition(),
+				Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+				Reason: cconst.ReasonFieldOwnershipConflict,
+			}),
+		))
+		Expect(cons[0].Message).To(ContainSubstring("shoot.gardener.cloud/cleanup-extended-apis-finalize-grace-period-seconds"))
+		Expect(cons[0].Message).To(ContainSubstring("other-controller"))
+		Expect(env.Client(gardenCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "garden-test"}, sh)).To(Succeed())
+		Expect(sh.GetAnnotations()).To(HaveKeyWithValue("shoot.gardener.cloud/cleanup-extended-apis-finalize-grace-period-seconds", "600"))
+	})
+
+	It("should not take over fields of the shoot which are not set by the operator", func() {
+		gc, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "", "testdata", "connector", "apiserver-04.yaml")
+		_, _, _, err := gc.HandleCreateOrUpdate(env.Ctx, as, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// another controller adds an annotation and an enforced annotation is removed, so that the shoot is applied again
+		sh := &gardenv1beta1.Shoot{}
+		Expect(env.Client(gardenCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "garden-test"}, sh)).To(Succeed())
+		old := sh.DeepCopy()
+		sh.Annotations["other.example.com/annotation"] = "foo"
+		delete(sh.Annotations, "shoot.gardener.cloud/cleanup-extended-apis-finalize-grace-period-seconds")
+		Expect(env.Client(gardenCluster).Patch(env.Ctx, sh, client.MergeFrom(old), client.FieldOwner("other-controller"))).To(Succeed())
+
+		_, _, _, err = gc.HandleCreateOrUpdate(env.Ctx, as, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(env.Client(gardenCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "garden-test"}, sh)).To(Succeed())
+		Expect(sh.GetAnnotations()).To(HaveKey("shoot.gardener.cloud/cleanup-extended-apis-finalize-grace-period-seconds"))
+		for _, mf := range sh.GetManagedFields() {
+			if mf.Manager == gardener.FieldManager {
+				Expect(string(mf.FieldsV1.Raw)).ToNot(ContainSubstring("other.example.com/annotation"))
+			}
+		}
+	})
+
+})
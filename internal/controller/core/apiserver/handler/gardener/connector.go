@@ -2,6 +2,7 @@ package gardener
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -14,12 +15,15 @@ import (
 
 	"github.com/openmcp-project/controller-utils/pkg/logging"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -33,6 +37,9 @@ import (
 
 const (
 	GardenerDeletionConfirmationAnnotation = "confirmation.gardener.cloud/deletion"
+
+	// hibernatedRequeueInterval is the interval in which hibernated APIServers are requeued to check whether they have been woken up.
+	hibernatedRequeueInterval = 10 * time.Minute
 )
 
 var _ apiserverhandler.APIServerHandler = &GardenerConnector{}
@@ -41,17 +48,69 @@ type GardenerConnector struct {
 	apiserverconfig.CompletedMultiGardenerConfiguration
 	Common        *apiserverconfig.CompletedCommonConfig
 	APIServerType openmcpv1alpha1.APIServerType
+
+	// Scheduler chooses the landscape configuration for APIServers which don't specify one.
+	// If nil, these APIServers use the default landscape configuration.
+	Scheduler LandscapeScheduler
+
+	// EventRecorder is used to emit events for the APIServer, e.g. when its shoot has drifted.
+	// Might be nil, in which case no events are emitted.
+	EventRecorder record.EventRecorder
 }
 
 func NewGardenerConnector(cc *apiserverconfig.CompletedCommonConfig, cfg *apiserverconfig.CompletedMultiGardenerConfiguration, apiServerType openmcpv1alpha1.APIServerType) (*GardenerConnector, openmcperrors.ReasonableError) {
 	if cfg == nil {
 		return nil, openmcperrors.WithReason(fmt.Errorf("APIServer handler for type 'Gardener' is not configured"), cconst.ReasonConfigurationProblem)
 	}
-	return &GardenerConnector{
+	gc := &GardenerConnector{
 		CompletedMultiGardenerConfiguration: *cfg,
 		Common:                              cc,
 		APIServerType:                       apiServerType,
-	}, nil
+	}
+	if cfg.Scheduling != nil {
+		gc.Scheduler = NewDefaultLandscapeScheduler(cfg)
+	}
+	return gc, nil
+}
+
+// scheduleLandscapeConfiguration assigns a landscape configuration to the given APIServer, if it doesn't specify one and a scheduler is configured.
+// The choice is written into the APIServer's spec, so that it doesn't change afterwards.
+// APIServers which already have a shoot are assigned the default landscape configuration, which they have been using so far.
+func (gc *GardenerConnector) scheduleLandscapeConfiguration(ctx context.Context, as *openmcpv1alpha1.APIServer, crateClient client.Client) openmcperrors.ReasonableError {
+	log := logging.FromContextOrPanic(ctx)
+	if gc.Scheduler == nil || (as.Spec.Internal != nil && as.Spec.Internal.GardenerConfig != nil && as.Spec.Internal.GardenerConfig.LandscapeConfiguration != "") {
+		return nil
+	}
+
+	sh, errr := gc.GetShoot(ctx, as, false)
+	if errr != nil {
+		return openmcperrors.Errorf("error checking for existing shoot before scheduling: %w", errr, errr)
+	}
+	var lc string
+	if sh != nil {
+		lc = gc.DefaultLandscapeConfiguration()
+		log.Info("APIServer already has a shoot, assigning default landscape configuration", "landscapeConfiguration", lc)
+	} else {
+		var err error
+		lc, err = gc.Scheduler.Schedule(ctx, as)
+		if err != nil {
+			return openmcperrors.WithReason(fmt.Errorf("error scheduling APIServer to a landscape configuration: %w", err), cconst.ReasonConfigurationProblem)
+		}
+		log.Info("Scheduled APIServer to landscape configuration", "landscapeConfiguration", lc)
+	}
+
+	old := as.DeepCopy()
+	if as.Spec.Internal == nil {
+		as.Spec.Internal = &openmcpv1alpha1.APIServerInternalConfiguration{}
+	}
+	if as.Spec.Internal.GardenerConfig == nil {
+		as.Spec.Internal.GardenerConfig = &openmcpv1alpha1.GardenerInternalConfiguration{}
+	}
+	as.Spec.Internal.GardenerConfig.LandscapeConfiguration = lc
+	if err := crateClient.Patch(ctx, as, client.MergeFrom(old)); err != nil {
+		return openmcperrors.WithReason(fmt.Errorf("error writing scheduled landscape configuration into APIServer spec: %w", err), cconst.ReasonCrateClusterInteractionProblem)
+	}
+	return nil
 }
 
 // GetShoot tries to fetch the corresponding shoot cluster.
@@ -113,6 +172,10 @@ func (gc *GardenerConnector) HandleCreateOrUpdate(ctx context.Context, as *openm
 	log := logging.FromContextOrPanic(ctx).WithName("GardenerConnector")
 	ctx = logging.NewContext(ctx, log)
 
+	if errr := gc.scheduleLandscapeConfiguration(ctx, as, crateClient); errr != nil {
+		return ctrl.Result{}, nil, gardenerConditions(false, errr.Reason(), errr.Error()), errr
+	}
+
 	lc := ""
 	if as.Spec.Internal != nil && as.Spec.Internal.GardenerConfig != nil {
 		lc = as.Spec.Internal.GardenerConfig.LandscapeConfiguration
@@ -130,29 +193,37 @@ func (gc *GardenerConnector) HandleCreateOrUpdate(ctx context.Context, as *openm
 
 	auditLogShootAnnotations, auditLogErr := gc.reconcileAuditLogResources(ctx, as, gc.GetShootName(sh, as, gcfg), crateClient, gls, gcfg)
 	if auditLogErr != nil {
+		if auditLogErr.Reason() == cconst.ReasonFieldOwnershipConflict {
+			return gc.handleFieldOwnershipConflict(ctx, as, auditLogErr, nil)
+		}
 		return ctrl.Result{}, nil, gardenerConditions(false, cconst.ReasonAuditLogProblem, auditLogErr.Error()), auditLogErr
 	}
 
 	shootReady := false
 	shootNotReadyMessage := ""
 	var updateShootManifestInStatusFunc func(status *openmcpv1alpha1.APIServerStatus) error
+	var driftCondition *openmcpv1alpha1.ComponentCondition
 	if sh == nil {
 		log.Debug("No existing shoot found, creating a new one")
 		sh = &gardenv1beta1.Shoot{}
 		if err := gc.Shoot_v1beta1_from_APIServer_v1alpha1(ctx, as, sh); err != nil {
-			return ctrl.Result{}, nil, gardenerConditions(false, cconst.ReasonConfigurationProblem, err.Error()), openmcperrors.WithReason(err, cconst.ReasonConfigurationProblem)
+			rerr := conversionError(err)
+			return ctrl.Result{}, nil, gardenerConditions(false, rerr.Reason(), rerr.Error()), rerr
 		}
 		updateShootManifestInStatusFunc = func(status *openmcpv1alpha1.APIServerStatus) error {
 			status.GardenerStatus = &openmcpv1alpha1.GardenerStatus{}
 			return InjectShootManifestInGardenerStatus(status.GardenerStatus, sh)
 		}
-		if err := gls.Client.Create(ctx, sh); err != nil {
+		if err := gls.Client.Create(ctx, sh, client.FieldOwner(FieldManager)); err != nil {
 			return ctrl.Result{}, updateShootManifestInStatusFunc, gardenerConditions(false, cconst.ReasonGardenClusterInteractionProblem, err.Error()), openmcperrors.WithReason(err, cconst.ReasonGardenClusterInteractionProblem)
 		}
+		driftCondition = ptr.To(shootDriftCondition(nil))
 	} else {
 		log.Debug("Updating existing shoot", "shoot", client.ObjectKeyFromObject(sh).String())
+		live := sh.DeepCopy()
 		if err := gc.Shoot_v1beta1_from_APIServer_v1alpha1(ctx, as, sh); err != nil {
-			return ctrl.Result{}, nil, gardenerConditions(false, cconst.ReasonConfigurationProblem, err.Error()), openmcperrors.WithReason(err, cconst.ReasonConfigurationProblem)
+			rerr := conversionError(err)
+			return ctrl.Result{}, nil, gardenerConditions(false, rerr.Reason(), rerr.Error()), rerr
 		}
 
 		if sh.Annotations == nil {
@@ -163,26 +234,75 @@ func (gc *GardenerConnector) HandleCreateOrUpdate(ctx context.Context, as *openm
 			sh.Annotations[k] = v
 		}
 
+		// the shoot manifest in the status is used to detect manual changes of the shoot, so it is only replaced once the shoot has been applied
 		updateShootManifestInStatusFunc = func(status *openmcpv1alpha1.APIServerStatus) error {
+			if status.GardenerStatus != nil && status.GardenerStatus.Shoot != nil {
+				return nil
+			}
 			status.GardenerStatus = &openmcpv1alpha1.GardenerStatus{}
 			return InjectShootManifestInGardenerStatus(status.GardenerStatus, sh)
 		}
-		if err := gls.Client.Update(ctx, sh); err != nil {
-			if apierrors.IsConflict(err) {
-				log.Error(err, "Conflict updating shoot")
-				return ctrl.Result{Requeue: true}, updateShootManifestInStatusFunc, gardenerConditions(false, cconst.ReasonGardenClusterInteractionProblem, err.Error()), openmcperrors.WithReason(err, cconst.ReasonGardenClusterInteractionProblem)
+		drifted, err := ShootDrift(live, sh, gcfg.DriftIgnorePaths)
+		if err != nil {
+			// the drift cannot be determined, so update the shoot to be on the safe side
+			log.Error(err, "Error computing shoot drift")
+			drifted = []string{"<unknown>"}
+		}
+		// only differences to the last-applied manifest are drift, the other differences are caused by changes of the desired state
+		var manuallyDrifted []string
+		lastApplied, err := lastAppliedShoot(as)
+		if err == nil {
+			manuallyDrifted, err = manualShootDrift(live, lastApplied, drifted, gcfg.DriftIgnorePaths)
+		}
+		if err != nil {
+			log.Error(err, "Error comparing shoot with its last-applied manifest")
+		}
+		if len(drifted) == 0 {
+			log.Debug("Shoot matches its desired state, skipping update")
+		} else {
+			log.Info("Shoot differs from its desired state, updating it", "changedFields", drifted, "driftedFields", manuallyDrifted)
+			if len(manuallyDrifted) > 0 && gc.EventRecorder != nil {
+				gc.EventRecorder.Eventf(as, corev1.EventTypeNormal, EventReasonShootDrift, "Shoot '%s' has been modified outside of the operator and is reset to its desired state. Drifted fields: %s", client.ObjectKeyFromObject(sh).String(), formatDriftPaths(manuallyDrifted))
 			}
-
-			log.Error(err, "Error updating shoot")
-			return ctrl.Result{}, updateShootManifestInStatusFunc, gardenerConditions(false, cconst.ReasonGardenClusterInteractionProblem, err.Error()), openmcperrors.WithReason(err, cconst.ReasonGardenClusterInteractionProblem)
+			if err := applyObject(ctx, gls.Client, live, sh); err != nil {
+				if errr := fieldOwnershipConflictError("Shoot", sh, err); errr != nil {
+					return gc.handleFieldOwnershipConflict(ctx, as, errr, updateShootManifestInStatusFunc)
+				}
+				if apierrors.IsConflict(err) {
+					log.Error(err, "Conflict updating shoot")
+					return ctrl.Result{Requeue: true}, updateShootManifestInStatusFunc, gardenerConditions(false, cconst.ReasonGardenClusterInteractionProblem, err.Error()), openmcperrors.WithReason(err, cconst.ReasonGardenClusterInteractionProblem)
+				}
+
+				log.Error(err, "Error updating shoot")
+				return ctrl.Result{}, updateShootManifestInStatusFunc, gardenerConditions(false, cconst.ReasonGardenClusterInteractionProblem, err.Error()), openmcperrors.WithReason(err, cconst.ReasonGardenClusterInteractionProblem)
+			}
+		}
+		updateShootManifestInStatusFunc = func(status *openmcpv1alpha1.APIServerStatus) error {
+			status.GardenerStatus = &openmcpv1alpha1.GardenerStatus{}
+			return InjectShootManifestInGardenerStatus(status.GardenerStatus, sh)
 		}
+		driftCondition = ptr.To(shootDriftCondition(manuallyDrifted))
 		shootReady, shootNotReadyMessage = isShootReady(sh)
 	}
 	log = log.WithValues("shoot", client.ObjectKeyFromObject(sh).String())
 
+	if op := hibernationOperation(as); op != "" {
+		// the operation has been applied to the shoot, remove the annotation so that it is not applied again
+		log.Debug("Removing hibernation operation annotation from APIServer", "operation", op)
+		if err := componentutils.PatchAnnotation(ctx, crateClient, as, openmcpv1alpha1.OperationAnnotation, "", componentutils.ANNOTATION_DELETE); err != nil {
+			errr := openmcperrors.WithReason(fmt.Errorf("error removing operation annotation: %w", err), cconst.ReasonCrateClusterInteractionProblem)
+			return ctrl.Result{}, updateShootManifestInStatusFunc, gardenerConditions(false, errr.Reason(), errr.Error()), errr
+		}
+	}
+	hibernationStatus := HibernationStatusFromShoot(sh, apiServerHibernationConfig(as))
+
 	var adminAccess *openmcpv1alpha1.APIServerAccess
 	res := ctrl.Result{}
-	if shootReady {
+	if hibernationStatus.IsHibernated() {
+		// the shoot's API server is not reachable, so the access cannot be refreshed
+		log.Debug("Shoot is hibernated, requeueing APIServer", "hibernationState", string(hibernationStatus.State))
+		res.RequeueAfter = hibernatedRequeueInterval
+	} else if shootReady {
 		log.Debug("Shoot is ready")
 		adminAccess, res.RequeueAfter, err = apiserverhandler.GetClusterAccess(ctx, gc.Common.ServiceAccountNamespace, gc.Common.AdminServiceAccountName, as.Status.AdminAccess, &gardenerClusterAccessEnabler{
 			gardenClient: gls.Client,
@@ -196,6 +316,14 @@ func (gc *GardenerConnector) HandleCreateOrUpdate(ctx context.Context, as *openm
 		log.Debug("Shoot is not ready yet, requeueing APIServer")
 		res.RequeueAfter = 60 * time.Second
 	}
+	if target := sh.GetAnnotations()[targetK8sVersionAnnotation]; target != "" && target != sh.Spec.Kubernetes.Version {
+		// requeue at the beginning of the next maintenance time window to perform the pending k8s version upgrade
+		wait, err := DurationUntilMaintenanceTimeWindow(shootMaintenanceTimeWindow(sh), time.Now())
+		if err == nil && wait > 0 && (res.RequeueAfter == 0 || wait < res.RequeueAfter) {
+			log.Debug("K8s version upgrade is pending, requeueing APIServer for the shoot's next maintenance time window", "currentVersion", sh.Spec.Kubernetes.Version, "targetVersion", target, "requeueAfter", wait.String())
+			res.RequeueAfter = wait
+		}
+	}
 
 	usf := func(status *openmcpv1alpha1.APIServerStatus) error {
 		if updateShootManifestInStatusFunc != nil {
@@ -216,6 +344,10 @@ func (gc *GardenerConnector) HandleCreateOrUpdate(ctx context.Context, as *openm
 			}
 		}
 
+		status.Placement = PlacementFromShoot(sh)
+		status.KubernetesVersion = KubernetesVersionStatusFromShoot(sh)
+		status.Hibernation = hibernationStatus
+
 		if adminAccess != nil {
 			status.AdminAccess = adminAccess
 		}
@@ -228,7 +360,14 @@ func (gc *GardenerConnector) HandleCreateOrUpdate(ctx context.Context, as *openm
 	if sh.Status.LastOperation != nil {
 		fmt.Fprintf(&conMsg, "[%s: %s] %s", sh.Status.LastOperation.Type, sh.Status.LastOperation.State, sh.Status.LastOperation.Description)
 	}
-	if !shootReady {
+	if hibernationStatus.IsHibernated() {
+		shootReady = false
+		conRsn = cconst.ReasonAPIServerHibernated
+		if conMsg.Len() > 0 {
+			conMsg.WriteString("\n")
+		}
+		fmt.Fprintf(&conMsg, "Shoot hibernation state: %s", string(hibernationStatus.State))
+	} else if !shootReady {
 		conRsn = cconst.ReasonWaitingForGardenerShoot
 		if conMsg.Len() > 0 {
 			conMsg.WriteString("\n")
@@ -236,7 +375,7 @@ func (gc *GardenerConnector) HandleCreateOrUpdate(ctx context.Context, as *openm
 		conMsg.WriteString(shootNotReadyMessage)
 	}
 
-	return res, usf, gardenerConditions(shootReady, conRsn, conMsg.String()), nil
+	return res, usf, append(gardenerConditions(shootReady, conRsn, conMsg.String()), *driftCondition), nil
 }
 
 func (gc *GardenerConnector) HandleDelete(ctx context.Context, as *openmcpv1alpha1.APIServer, crateClient client.Client) (ctrl.Result, apiserverhandler.UpdateStatusFunc, []openmcpv1alpha1.ComponentCondition, openmcperrors.ReasonableError) {
@@ -254,6 +393,9 @@ func (gc *GardenerConnector) HandleDelete(ctx context.Context, as *openmcpv1alph
 		log.Debug("Shoot has been deleted")
 		return ctrl.Result{}, func(status *openmcpv1alpha1.APIServerStatus) error {
 			status.AdminAccess = nil
+			status.Placement = nil
+			status.KubernetesVersion = nil
+			status.Hibernation = nil
 			if status.GardenerStatus != nil {
 				status.GardenerStatus.Shoot = nil
 			}
@@ -330,13 +472,13 @@ type AuditLogAnnotations map[string]string
 // Otherwise, the annotations will be nil.
 func (gc *GardenerConnector) reconcileAuditLogResources(ctx context.Context, as *openmcpv1alpha1.APIServer, shootName string, crateClient client.Client, gls *apiserverconfig.CompletedGardenerLandscape, gcfg *apiserverconfig.CompletedGardenerConfiguration) (AuditLogAnnotations, openmcperrors.ReasonableError) {
 	if isAuditLogEnabled(as) {
-		resultPolicy, err := gc.createOrUpdateAuditLogPolicy(ctx, as, shootName, crateClient, gls, gcfg)
-		if err != nil {
-			return nil, openmcperrors.WithReason(err, cconst.ReasonGardenClusterInteractionProblem)
+		resultPolicy, errr := gc.createOrUpdateAuditLogPolicy(ctx, as, shootName, crateClient, gls, gcfg)
+		if errr != nil {
+			return nil, errr
 		}
-		resultCreds, err := gc.createOrUpdateAuditLogCredentials(ctx, as, shootName, crateClient, gls, gcfg)
-		if err != nil {
-			return nil, openmcperrors.WithReason(err, cconst.ReasonGardenClusterInteractionProblem)
+		resultCreds, errr := gc.createOrUpdateAuditLogCredentials(ctx, as, shootName, crateClient, gls, gcfg)
+		if errr != nil {
+			return nil, errr
 		}
 		if resultPolicy != controllerutil.OperationResultNone || resultCreds != controllerutil.OperationResultNone {
 			return AuditLogAnnotations{
@@ -355,43 +497,61 @@ func (gc *GardenerConnector) reconcileAuditLogResources(ctx context.Context, as
 }
 
 // createOrUpdateAuditLogPolicy creates or updates the audit log policy ConfigMap for the given shoot.
-func (gc *GardenerConnector) createOrUpdateAuditLogPolicy(ctx context.Context, as *openmcpv1alpha1.APIServer, shootName string, crateClient client.Client, gls *apiserverconfig.CompletedGardenerLandscape, gcfg *apiserverconfig.CompletedGardenerConfiguration) (controllerutil.OperationResult, error) {
+func (gc *GardenerConnector) createOrUpdateAuditLogPolicy(ctx context.Context, as *openmcpv1alpha1.APIServer, shootName string, crateClient client.Client, gls *apiserverconfig.CompletedGardenerLandscape, gcfg *apiserverconfig.CompletedGardenerConfiguration) (controllerutil.OperationResult, openmcperrors.ReasonableError) {
 	cmCrate := &corev1.ConfigMap{}
 	err := crateClient.Get(ctx, types.NamespacedName{Name: as.Spec.GardenerConfig.AuditLog.PolicyRef.Name, Namespace: as.Namespace}, cmCrate)
 	if err != nil {
-		return "", err
+		return "", openmcperrors.WithReason(err, cconst.ReasonGardenClusterInteractionProblem)
 	}
 
 	cmGarden := &corev1.ConfigMap{}
 	cmGarden.SetName(utils.PrefixWithNamespace(shootName, "auditlog-policy"))
 	cmGarden.SetNamespace(gcfg.ProjectNamespace)
-	result, err := controllerutil.CreateOrUpdate(ctx, gls.Client, cmGarden, func() error {
-		cmGarden.Data = cmCrate.Data
-		return nil
-
+	cmGarden.Data = cmCrate.Data
+	return applyAuditLogResource(ctx, gls.Client, "ConfigMap", cmGarden, &corev1.ConfigMap{}, func(existing *corev1.ConfigMap) bool {
+		return equality.Semantic.DeepEqual(existing.Data, cmGarden.Data)
 	})
-	return result, err
-
 }
 
 // createOrUpdateAuditLogCredentials creates or updates the audit log credentials Secret for the given shoot.
-func (gc *GardenerConnector) createOrUpdateAuditLogCredentials(ctx context.Context, as *openmcpv1alpha1.APIServer, shootName string, crateClient client.Client, gls *apiserverconfig.CompletedGardenerLandscape, gcfg *apiserverconfig.CompletedGardenerConfiguration) (controllerutil.OperationResult, error) {
+func (gc *GardenerConnector) createOrUpdateAuditLogCredentials(ctx context.Context, as *openmcpv1alpha1.APIServer, shootName string, crateClient client.Client, gls *apiserverconfig.CompletedGardenerLandscape, gcfg *apiserverconfig.CompletedGardenerConfiguration) (controllerutil.OperationResult, openmcperrors.ReasonableError) {
 	secretCrate := &corev1.Secret{}
 	err := crateClient.Get(ctx, types.NamespacedName{Name: as.Spec.GardenerConfig.AuditLog.SecretRef.Name, Namespace: as.Namespace}, secretCrate)
 	if err != nil {
-		return "", err
+		return "", openmcperrors.WithReason(err, cconst.ReasonGardenClusterInteractionProblem)
 	}
 
 	secretGarden := &corev1.Secret{}
 	secretGarden.SetName(utils.PrefixWithNamespace(shootName, "auditlog-credentials"))
 	secretGarden.SetNamespace(gcfg.ProjectNamespace)
-	result, err := controllerutil.CreateOrUpdate(ctx, gls.Client, secretGarden, func() error {
-		secretGarden.Data = secretCrate.Data
-		secretGarden.Type = secretCrate.Type
-		return nil
-
+	secretGarden.Data = secretCrate.Data
+	secretGarden.Type = secretCrate.Type
+	return applyAuditLogResource(ctx, gls.Client, "Secret", secretGarden, &corev1.Secret{}, func(existing *corev1.Secret) bool {
+		return existing.Type == secretGarden.Type && equality.Semantic.DeepEqual(existing.Data, secretGarden.Data)
 	})
-	return result, err
+}
+
+// applyAuditLogResource creates or updates the given audit log resource in the Garden cluster via server-side apply.
+// The existing object is fetched into 'existing' and 'unchanged' is called to determine whether it already matches the desired state.
+func applyAuditLogResource[T client.Object](ctx context.Context, gardenClient client.Client, kind string, desired, existing T, unchanged func(existing T) bool) (controllerutil.OperationResult, openmcperrors.ReasonableError) {
+	result := controllerutil.OperationResultUpdated
+	var live client.Object = existing
+	if err := gardenClient.Get(ctx, client.ObjectKeyFromObject(desired), existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", openmcperrors.WithReason(err, cconst.ReasonGardenClusterInteractionProblem)
+		}
+		result = controllerutil.OperationResultCreated
+		live = nil
+	} else if unchanged(existing) {
+		return controllerutil.OperationResultNone, nil
+	}
+	if err := applyObject(ctx, gardenClient, live, desired); err != nil {
+		if errr := fieldOwnershipConflictError(kind, desired, err); errr != nil {
+			return "", errr
+		}
+		return "", openmcperrors.WithReason(fmt.Errorf("error applying %s '%s': %w", kind, client.ObjectKeyFromObject(desired).String(), err), cconst.ReasonGardenClusterInteractionProblem)
+	}
+	return result, nil
 }
 
 // deleteAuditLogPolicy deletes the audit log policy ConfigMap for the given shoot.
@@ -446,6 +606,16 @@ func (g *gardenerClusterAccessEnabler) RESTConfig() *rest.Config {
 	return g.restCfg
 }
 
+// conversionError returns the given error from the shoot conversion as ReasonableError.
+// If the error does not wrap an error with a more specific reason, ReasonConfigurationProblem is used.
+func conversionError(err error) openmcperrors.ReasonableError {
+	var rerr openmcperrors.ReasonableError
+	if errors.As(err, &rerr) {
+		return openmcperrors.WithReason(err, rerr.Reason())
+	}
+	return openmcperrors.WithReason(err, cconst.ReasonConfigurationProblem)
+}
+
 func gardenerConditions(shootReady bool, reason, message string) []openmcpv1alpha1.ComponentCondition {
 	conditions := []openmcpv1alpha1.ComponentCondition{
 		componentutils.NewCondition(openmcpv1alpha1.APIServerComponent.HealthyCondition(), openmcpv1alpha1.ComponentConditionStatusFromBool(shootReady), reason, message),
@@ -16,6 +16,7 @@ import (
 
 	. "github.com/openmcp-project/mcp-operator/test/matchers"
 
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
 	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
 	gardenv1beta1 "github.com/openmcp-project/mcp-operator/api/external/gardener/pkg/apis/core/v1beta1"
 	"github.com/openmcp-project/mcp-operator/api/external/gardener/pkg/apis/core/v1beta1/constants"
@@ -101,6 +102,11 @@ var _ = Describe("APIServer Gardener Conversion", func() {
 							Type:   openmcpv1alpha1.APIServerComponent.HealthyCondition(),
 							Status: openmcpv1alpha1.ComponentConditionStatusTrue,
 						}),
+						MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+							Type:   cconst.ConditionShootDrift,
+							Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+							Reason: cconst.ReasonNoShootDrift,
+						}),
 					))
 					Expect(res.RequeueAfter).To(BeNumerically(">=", apiserverutils.DefaultAdminAccessValidityTime/2))
 					Expect(res.RequeueAfter).To(BeNumerically("<=", apiserverutils.DefaultAdminAccessValidityTime))
@@ -108,6 +114,8 @@ var _ = Describe("APIServer Gardener Conversion", func() {
 					Expect(as.Status.AdminAccess).To(BeNil())
 					Expect(usf(&as.Status)).To(Succeed())
 					Expect(as.Status.AdminAccess).ToNot(BeNil())
+					Expect(as.Status.Placement).ToNot(BeNil())
+					Expect(as.Status.Placement.Region).ToNot(BeEmpty())
 				})
 
 				It("should not add admin access if the shoot is not ready", func() {
@@ -124,6 +132,11 @@ var _ = Describe("APIServer Gardener Conversion", func() {
 							Type:   openmcpv1alpha1.APIServerComponent.HealthyCondition(),
 							Status: openmcpv1alpha1.ComponentConditionStatusFalse,
 						}),
+						MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+							Type:   cconst.ConditionShootDrift,
+							Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+							Reason: cconst.ReasonNoShootDrift,
+						}),
 					))
 					Expect(res.RequeueAfter).To(BeNumerically(">", 0))
 					Expect(res.RequeueAfter).To(BeNumerically("<=", 5*time.Minute))
@@ -170,6 +183,10 @@ var _ = Describe("APIServer Gardener Conversion", func() {
 							Type:   openmcpv1alpha1.APIServerComponent.HealthyCondition(),
 							Status: openmcpv1alpha1.ComponentConditionStatusFalse,
 						}),
+						MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+							Type:   cconst.ConditionShootDrift,
+							Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+						}),
 					))
 					Expect(res.RequeueAfter).To(BeNumerically(">", 0))
 					Expect(res.RequeueAfter).To(BeNumerically("<=", 5*time.Minute))
@@ -206,6 +223,11 @@ var _ = Describe("APIServer Gardener Conversion", func() {
 							Type:   openmcpv1alpha1.APIServerComponent.HealthyCondition(),
 							Status: openmcpv1alpha1.ComponentConditionStatusTrue,
 						}),
+						MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+							Type:   cconst.ConditionShootDrift,
+							Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+							Reason: cconst.ReasonNoShootDrift,
+						}),
 					))
 
 					cmGarden := &corev1.ConfigMap{}
@@ -225,6 +247,11 @@ var _ = Describe("APIServer Gardener Conversion", func() {
 							Type:   openmcpv1alpha1.APIServerComponent.HealthyCondition(),
 							Status: openmcpv1alpha1.ComponentConditionStatusTrue,
 						}),
+						MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+							Type:   cconst.ConditionShootDrift,
+							Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+							Reason: cconst.ReasonNoShootDrift,
+						}),
 					))
 
 					cmGarden := &corev1.ConfigMap{}
@@ -299,6 +326,11 @@ var _ = Describe("APIServer Gardener Conversion", func() {
 							Type:   openmcpv1alpha1.APIServerComponent.HealthyCondition(),
 							Status: openmcpv1alpha1.ComponentConditionStatusTrue,
 						}),
+						MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+							Type:   cconst.ConditionShootDrift,
+							Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+							Reason: cconst.ReasonNoShootDrift,
+						}),
 					))
 
 					_, _, cons, errr = gc.HandleDelete(env.Ctx, as, env.Client(testutils.CrateCluster))
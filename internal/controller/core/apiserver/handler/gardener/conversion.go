@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"hash/fnv"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -23,6 +25,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/yaml"
 
 	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
 	authenticationv1alpha1 "github.com/openmcp-project/mcp-operator/api/external/gardener/pkg/apis/authentication/v1alpha1"
@@ -36,6 +39,14 @@ import (
 const (
 	auditlogExtensionServiceName = "shoot-auditlog-service"
 	auditlogCredentialName       = "auditlog-credentials"
+
+	// regionSelectionAnnotation records on the shoot how its region has been chosen.
+	// Since the region is immutable and only resolved during shoot creation, the annotation is required to report the decision in the APIServer status later on.
+	regionSelectionAnnotation = openmcpv1alpha1.APIServerDomain + "/region-selection"
+	// regionSelectionDesiredRegionAnnotation records the desired region the shoot's region has been resolved from.
+	regionSelectionDesiredRegionAnnotation = openmcpv1alpha1.APIServerDomain + "/region-selection-desired-region"
+	// regionSelectionDistanceAnnotation records the proximity distance between the desired region and the shoot's region.
+	regionSelectionDistanceAnnotation = openmcpv1alpha1.APIServerDomain + "/region-selection-distance"
 )
 
 // Shoot_v1beta1_from_APIServer_v1alpha1 updates a v1beta1.Shoot based on a v1alpha1.APIServer.
@@ -48,7 +59,7 @@ func (gc *GardenerConnector) Shoot_v1beta1_from_APIServer_v1alpha1(ctx context.C
 	if as.Spec.Internal != nil && as.Spec.Internal.GardenerConfig != nil {
 		lc = as.Spec.Internal.GardenerConfig.LandscapeConfiguration
 	}
-	_, gcfg, err := gc.LandscapeConfiguration(lc)
+	gls, gcfg, err := gc.LandscapeConfiguration(lc)
 	if err != nil {
 		return fmt.Errorf("error resolving landscape and configuration: %w", err)
 	}
@@ -104,10 +115,15 @@ func (gc *GardenerConnector) Shoot_v1beta1_from_APIServer_v1alpha1(ctx context.C
 		}
 	}
 
-	if sh.Spec.Purpose == nil {
-		log.Debug("Setting shoot.Spec.Purpose", "value", string(gardenv1beta1.ShootPurposeProduction))
-		sh.Spec.Purpose = ptr.To(gardenv1beta1.ShootPurposeProduction)
+	sizingProfileName := ""
+	if as.Spec.GardenerConfig != nil {
+		sizingProfileName = as.Spec.GardenerConfig.SizingProfile
+	}
+	sizingProfile, err := gcfg.SizingProfile(sizingProfileName)
+	if err != nil {
+		return err
 	}
+	setShootPurpose(log, sh, sizingProfile)
 	if sh.Spec.CloudProfile == nil {
 		log.Debug("Setting shoot.Spec.CloudProfile", "value_kind", gardenconstants.CloudProfileReferenceKindCloudProfile, "value_name", gcfg.CloudProfile)
 		sh.Spec.CloudProfile = &gardenv1beta1.CloudProfileReference{
@@ -119,18 +135,14 @@ func (gc *GardenerConnector) Shoot_v1beta1_from_APIServer_v1alpha1(ctx context.C
 		log.Debug("Setting shoot.Spec.Provider.Type", "value", gcfg.ProviderType)
 		sh.Spec.Provider.Type = gcfg.ProviderType
 	}
-	if sh.Spec.Hibernation == nil {
-		sh.Spec.Hibernation = &gardenv1beta1.Hibernation{}
-	}
-	if sh.Spec.Hibernation.Enabled == nil || *sh.Spec.Hibernation.Enabled {
-		log.Debug("Setting shoot.Spec.Hibernation.Enabled", "value", false)
-		sh.Spec.Hibernation.Enabled = ptr.To(false)
-	}
+	setShootHibernation(log, sh, apiServerHibernationConfig(as), hibernationOperation(as))
 	h := HashAsNumber(as.Name, as.Namespace)
 	if sh.Spec.Region == "" {
 		log.Debug("Setting shoot.Spec.Region")
+		selectionAnnotations := map[string]string{}
 		if as.Spec.GardenerConfig != nil && as.Spec.GardenerConfig.Region != "" {
 			sh.Spec.Region = as.Spec.GardenerConfig.Region
+			selectionAnnotations[regionSelectionAnnotation] = string(openmcpv1alpha1.RegionSelectionMethodExplicit)
 			log.Debug("Using shoot region specified in APIServer's Gardener config", "region", sh.Spec.Region)
 		} else {
 			// try to derive region from the common configuration
@@ -139,35 +151,73 @@ func (gc *GardenerConnector) Shoot_v1beta1_from_APIServer_v1alpha1(ctx context.C
 				if dr.Direction == "" {
 					dr.Direction = openmcpv1alpha1.CENTRAL
 				}
-				mapper := region.GetPredefinedMapperByCloudprovider(gcfg.ProviderType)
-				if mapper != nil {
-					regions, err := region.GetClosestRegions(*dr, mapper, sets.KeySet(gcfg.ValidRegions).UnsortedList(), true)
+				if gcfg.RegionMapper == nil {
+					log.Debug("Unable to resolve APIServer's desiredRegion field, because there is neither a predefined nor a configured region mapper for the provider type", "providerType", gcfg.ProviderType)
+				} else {
+					// only consider regions which are not drained and did not reach their shoot limit
+					shootCount, err := countShootsPerRegion(ctx, gls.Client, gcfg.ProjectNamespace)
+					if err != nil {
+						return fmt.Errorf("error counting existing shoots per region: %w", err)
+					}
+					availableRegions := regionsWithCapacity(gcfg, shootCount)
+					regions, distance, err := region.GetClosestRegionsWithDistance(*dr, gcfg.RegionMapper, availableRegions, true)
 					if err != nil {
 						// log, but don't break
 						log.Error(err, "error finding closest regions", "region", dr.Name, "direction", dr.Direction)
 					} else if len(regions) > 0 {
-						if len(regions) == 1 {
-							sh.Spec.Region = regions[0]
-						} else {
-							sh.Spec.Region = regions[h%len(regions)]
-						}
-						log.Debug("Resolved shoot region from APIServer's desiredRegion field", "region", sh.Spec.Region)
+						sh.Spec.Region = selectRegion(regions, gcfg, shootCount, h)
+						selectionAnnotations[regionSelectionAnnotation] = string(openmcpv1alpha1.RegionSelectionMethodDesiredRegion)
+						selectionAnnotations[regionSelectionDesiredRegionAnnotation] = dr.String()
+						selectionAnnotations[regionSelectionDistanceAnnotation] = strconv.Itoa(distance)
+						log.Debug("Resolved shoot region from APIServer's desiredRegion field", "region", sh.Spec.Region, "candidates", regions, "distance", distance)
 					}
 				}
 			}
 			// fallback to specified default region
 			if sh.Spec.Region == "" {
 				sh.Spec.Region = gcfg.DefaultRegion
+				selectionAnnotations[regionSelectionAnnotation] = string(openmcpv1alpha1.RegionSelectionMethodDefault)
 				log.Debug("Neither desired region nor explicit Gardener region specified in APIServer, using fallback from global configuration", "region", sh.Spec.Region)
 			}
 		}
+		sh.SetAnnotations(maps.Merge(sh.GetAnnotations(), selectionAnnotations))
+	}
+	if as.Spec.GardenerConfig != nil {
+		// the maintenance configuration has to be set before the k8s version is computed, as upgrades depend on the maintenance time window
+		if err := setShootMaintenance(log, sh, as.Spec.GardenerConfig.Maintenance, gcfg.Maintenance, time.Now()); err != nil {
+			return err
+		}
 	}
 	configuredVersion := ""
 	if as.Spec.Internal != nil && as.Spec.Internal.GardenerConfig != nil && as.Spec.Internal.GardenerConfig.K8SVersionOverwrite != "" {
 		log.Debug("Found internal k8s version overwrite", "version", as.Spec.Internal.GardenerConfig.K8SVersionOverwrite)
 		configuredVersion = as.Spec.Internal.GardenerConfig.K8SVersionOverwrite
 	}
-	newK8sVersion := computeK8sVersion(configuredVersion, sh.Spec.Kubernetes.Version)
+	currentK8sVersion := sh.Spec.Kubernetes.Version
+	policyVersion, err := policyK8sVersion(ctx, gls.Client, gcfg, currentK8sVersion)
+	if err != nil {
+		return fmt.Errorf("error computing k8s version from version policy: %w", err)
+	}
+	targetK8sVersion := computeK8sVersion(configuredVersion, policyVersion)
+	newK8sVersion := computeK8sVersion(configuredVersion, currentK8sVersion)
+	if policyVersion != currentK8sVersion {
+		// new shoots get the target version immediately, existing ones are only upgraded during their maintenance time window
+		upgrade := currentK8sVersion == ""
+		if !upgrade {
+			upgrade, err = InMaintenanceTimeWindow(shootMaintenanceTimeWindow(sh), time.Now())
+			if err != nil {
+				log.Info("Unable to evaluate shoot's maintenance time window, deferring k8s version upgrade", "error", err.Error(), "targetVersion", targetK8sVersion)
+			} else if !upgrade {
+				log.Debug("Deferring k8s version upgrade to the shoot's maintenance time window", "currentVersion", currentK8sVersion, "targetVersion", targetK8sVersion)
+			}
+		}
+		if upgrade {
+			newK8sVersion = targetK8sVersion
+		}
+	}
+	if targetK8sVersion != "" {
+		sh.SetAnnotations(maps.Merge(sh.GetAnnotations(), map[string]string{targetK8sVersionAnnotation: targetK8sVersion}))
+	}
 	if sh.Spec.Kubernetes.Version != newK8sVersion {
 		log.Debug("Setting shoot.Spec.Kubernetes.Version", "value", newK8sVersion)
 		sh.Spec.Kubernetes.Version = newK8sVersion
@@ -221,7 +271,7 @@ func (gc *GardenerConnector) Shoot_v1beta1_from_APIServer_v1alpha1(ctx context.C
 		if as.Spec.GardenerConfig != nil {
 			highAvailabilityConfig = as.Spec.GardenerConfig.HighAvailabilityConfig
 		}
-		builder, err := getShootBuilderByCloudProvider(log, sh, h, gcfg.ProviderType, gcfg.ShootTemplate, &region, highAvailabilityConfig)
+		builder, err := getShootBuilderByCloudProvider(log, sh, h, gcfg.ProviderType, gcfg.ShootTemplate, &region, highAvailabilityConfig, sizingProfile)
 		if err != nil {
 			return fmt.Errorf("error constructing shoot builder: %w", err)
 		}
@@ -301,6 +351,79 @@ func (gc *GardenerConnector) Shoot_v1beta1_from_APIServer_v1alpha1(ctx context.C
 	return nil
 }
 
+// setShootPurpose sets the purpose of the shoot according to the given sizing profile, which may be nil.
+// Without a sizing profile, the purpose of existing shoots is not modified and new shoots get the 'production' purpose.
+// Gardener does not allow changing the purpose from or to 'testing', so such changes are skipped.
+func setShootPurpose(log logging.Logger, sh *gardenv1beta1.Shoot, sizingProfile *config.SizingProfile) {
+	purpose := gardenv1beta1.ShootPurposeProduction
+	if sizingProfile != nil && sizingProfile.Purpose != nil {
+		purpose = *sizingProfile.Purpose
+	}
+	if sh.Spec.Purpose == nil {
+		log.Debug("Setting shoot.Spec.Purpose", "value", string(purpose))
+		sh.Spec.Purpose = ptr.To(purpose)
+		return
+	}
+	if sizingProfile == nil || *sh.Spec.Purpose == purpose {
+		return
+	}
+	if *sh.Spec.Purpose == gardenv1beta1.ShootPurposeTesting || purpose == gardenv1beta1.ShootPurposeTesting {
+		log.Info("Unable to change shoot purpose from or to 'testing', keeping the current purpose", "currentPurpose", string(*sh.Spec.Purpose), "desiredPurpose", string(purpose), "sizingProfile", sizingProfile.Name)
+		return
+	}
+	log.Debug("Setting shoot.Spec.Purpose", "value", string(purpose), "sizingProfile", sizingProfile.Name)
+	sh.Spec.Purpose = ptr.To(purpose)
+}
+
+// PlacementFromShoot extracts the region and zone placement of the API server from the given shoot.
+// Returns nil if the shoot does not have a region yet.
+func PlacementFromShoot(sh *gardenv1beta1.Shoot) *openmcpv1alpha1.APIServerPlacement {
+	if sh == nil || sh.Spec.Region == "" {
+		return nil
+	}
+	res := &openmcpv1alpha1.APIServerPlacement{
+		Region: sh.Spec.Region,
+	}
+
+	// the region selection is only known for shoots which have been created after the annotations were introduced
+	annotations := sh.GetAnnotations()
+	if method := annotations[regionSelectionAnnotation]; method != "" {
+		res.RegionSelection = &openmcpv1alpha1.RegionSelection{
+			Method: openmcpv1alpha1.RegionSelectionMethod(method),
+		}
+		if dr := annotations[regionSelectionDesiredRegionAnnotation]; dr != "" {
+			name, direction, _ := strings.Cut(dr, "-")
+			res.RegionSelection.DesiredRegion = &openmcpv1alpha1.RegionSpecification{
+				Name:      openmcpv1alpha1.Region(name),
+				Direction: openmcpv1alpha1.Direction(direction),
+			}
+		}
+		if distance, err := strconv.ParseInt(annotations[regionSelectionDistanceAnnotation], 10, 32); err == nil {
+			res.RegionSelection.Distance = ptr.To(int32(distance))
+		}
+	}
+
+	// only some providers (e.g. GCP) pin the control plane to a zone
+	if sh.Spec.Provider.ControlPlaneConfig != nil && len(sh.Spec.Provider.ControlPlaneConfig.Raw) > 0 {
+		controlPlaneConfig := map[string]any{}
+		if err := yaml.Unmarshal(sh.Spec.Provider.ControlPlaneConfig.Raw, &controlPlaneConfig); err == nil {
+			if zone, ok := controlPlaneConfig["zone"].(string); ok {
+				res.ControlPlaneZone = zone
+			}
+		}
+	}
+
+	workerZones := sets.New[string]()
+	for _, worker := range sh.Spec.Provider.Workers {
+		workerZones.Insert(worker.Zones...)
+	}
+	if workerZones.Len() > 0 {
+		res.WorkerZones = sets.List(workerZones)
+	}
+
+	return res
+}
+
 // addOIDCExtension adds the OIDC extension to the shoot spec if it is not already present.
 func addOIDCExtension(log logging.Logger, sh *gardenv1beta1.Shoot) {
 	// Update configuration
@@ -11,6 +11,7 @@ import (
 	"github.com/openmcp-project/mcp-operator/internal/utils"
 	"github.com/openmcp-project/mcp-operator/internal/utils/region"
 
+	apiserverconfig "github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/config"
 	"github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/handler/gardener"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -18,6 +19,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
 	"github.com/openmcp-project/controller-utils/pkg/testing"
 
@@ -39,7 +41,7 @@ var _ = Describe("APIServer Gardener Conversion", func() {
 			if cfgType == "single" {
 				flavors = []string{"default/default"}
 			} else {
-				flavors = []string{"default/gcp", "default/aws"}
+				flavors = []string{"default/gcp", "default/aws", "default/azure", "default/openstack"}
 			}
 
 			for _, flavor := range flavors {
@@ -60,13 +62,23 @@ var _ = Describe("APIServer Gardener Conversion", func() {
 								Expect(shoot.Namespace).To(Equal(gcfg.ProjectNamespace))
 
 								// spec.region
-								mapper := region.GetPredefinedMapperByCloudprovider(gcfg.ProviderType)
-								Expect(mapper).ToNot(BeNil())
-								regions, err := region.GetClosestRegions(*apiServer.Spec.DesiredRegion, mapper, sets.KeySet(gcfg.ValidRegions).UnsortedList(), true)
+								Expect(gcfg.RegionMapper).ToNot(BeNil())
+								regions, err := region.GetClosestRegions(*apiServer.Spec.DesiredRegion, gcfg.RegionMapper, sets.KeySet(gcfg.ValidRegions).UnsortedList(), true)
 								Expect(err).ToNot(HaveOccurred())
 								Expect(regions).ToNot(BeEmpty())
 								Expect(regions).To(ContainElement(shoot.Spec.Region), "shoot region is not in the list of closest regions")
 
+								// placement
+								placement := gardener.PlacementFromShoot(shoot)
+								Expect(placement).ToNot(BeNil())
+								Expect(placement.Region).To(Equal(shoot.Spec.Region))
+								Expect(placement.RegionSelection).ToNot(BeNil())
+								Expect(placement.RegionSelection.Method).To(Equal(openmcpv1alpha1.RegionSelectionMethodDesiredRegion))
+								Expect(placement.RegionSelection.DesiredRegion).ToNot(BeNil())
+								Expect(placement.RegionSelection.DesiredRegion.Name).To(Equal(apiServer.Spec.DesiredRegion.Name))
+								Expect(placement.RegionSelection.Distance).ToNot(BeNil())
+								Expect(*placement.RegionSelection.Distance).To(BeNumerically(">=", 0))
+
 								commonShootValidation(gc, apiServer, shoot, flavor)
 							})
 
@@ -82,6 +94,15 @@ var _ = Describe("APIServer Gardener Conversion", func() {
 								// spec.region
 								Expect(shoot.Spec.Region).To(Equal(apiServer.Spec.GardenerConfig.Region))
 
+								// placement
+								placement := gardener.PlacementFromShoot(shoot)
+								Expect(placement).ToNot(BeNil())
+								Expect(placement.Region).To(Equal(shoot.Spec.Region))
+								Expect(placement.RegionSelection).ToNot(BeNil())
+								Expect(placement.RegionSelection.Method).To(Equal(openmcpv1alpha1.RegionSelectionMethodExplicit))
+								Expect(placement.RegionSelection.DesiredRegion).To(BeNil())
+								Expect(placement.RegionSelection.Distance).To(BeNil())
+
 								commonShootValidation(gc, apiServer, shoot, flavor)
 							})
 
@@ -97,6 +118,13 @@ var _ = Describe("APIServer Gardener Conversion", func() {
 								// spec.region
 								Expect(shoot.Spec.Region).To(Equal(gcfg.DefaultRegion))
 
+								// placement
+								placement := gardener.PlacementFromShoot(shoot)
+								Expect(placement).ToNot(BeNil())
+								Expect(placement.Region).To(Equal(shoot.Spec.Region))
+								Expect(placement.RegionSelection).ToNot(BeNil())
+								Expect(placement.RegionSelection.Method).To(Equal(openmcpv1alpha1.RegionSelectionMethodDefault))
+
 								commonShootValidation(gc, apiServer, shoot, flavor)
 							})
 
@@ -159,6 +187,14 @@ var _ = Describe("APIServer Gardener Conversion", func() {
 									// aws doesn't have the 'europe-west3' region that is hardcoded in the apiserver config
 									apiServer.Spec.GardenerConfig.Region = "eu-west-1"
 								}
+								if strings.HasSuffix(flavor, "azure") {
+									// azure doesn't have the 'europe-west3' region that is hardcoded in the apiserver config
+									apiServer.Spec.GardenerConfig.Region = "westeurope"
+								}
+								if strings.HasSuffix(flavor, "openstack") {
+									// openstack doesn't have the 'europe-west3' region that is hardcoded in the apiserver config
+									apiServer.Spec.GardenerConfig.Region = "eu-de-1"
+								}
 								_, gcfg, err := gc.LandscapeConfiguration(flavor)
 								Expect(err).ToNot(HaveOccurred())
 								shoot := &gardenv1beta1.Shoot{}
@@ -187,6 +223,14 @@ var _ = Describe("APIServer Gardener Conversion", func() {
 									// aws doesn't have the 'europe-west3' region that is hardcoded in the apiserver config
 									apiServer.Spec.GardenerConfig.Region = "eu-west-1"
 								}
+								if strings.HasSuffix(flavor, "azure") {
+									// azure doesn't have the 'europe-west3' region that is hardcoded in the apiserver config
+									apiServer.Spec.GardenerConfig.Region = "westeurope"
+								}
+								if strings.HasSuffix(flavor, "openstack") {
+									// openstack doesn't have the 'europe-west3' region that is hardcoded in the apiserver config
+									apiServer.Spec.GardenerConfig.Region = "eu-de-1"
+								}
 								_, gcfg, err := gc.LandscapeConfiguration(flavor)
 								Expect(err).ToNot(HaveOccurred())
 								shoot := &gardenv1beta1.Shoot{}
@@ -264,6 +308,11 @@ var _ = Describe("APIServer Gardener Conversion", func() {
 								Expect(shoot.Spec.Provider.Workers).ToNot(BeEmpty())
 								Expect(shoot.Spec.Provider.Workers[0].Zones).ToNot(BeEmpty())
 								Expect(shoot.Spec.Provider.Workers[0].Zones).To(ContainElement(existingZone), "controlplane zone must be part of the worker's zones")
+
+								placement := gardener.PlacementFromShoot(shoot)
+								Expect(placement).ToNot(BeNil())
+								Expect(placement.ControlPlaneZone).To(Equal(existingZone))
+								Expect(placement.WorkerZones).To(ContainElement(existingZone))
 							}
 							// nothing to do if the controlplane zone is not set
 						})
@@ -278,6 +327,153 @@ var _ = Describe("APIServer Gardener Conversion", func() {
 
 	}
 
+	Context("Azure-Specific Tests", func() {
+
+		It("should not pin workers to zones in a non-zoned region", func() {
+			flavor := "default/azure"
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.GardenerDedicated, flavor, "testdata", "conversion", "apiserver-07.yaml")
+			apiServer.Spec.GardenerConfig.Region = "westcentralus"
+			shoot := &gardenv1beta1.Shoot{}
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+
+			Expect(shoot.Spec.Region).To(Equal("westcentralus"))
+			Expect(shoot.Spec.Provider.Workers).To(HaveLen(1))
+			Expect(shoot.Spec.Provider.Workers[0].Zones).To(BeEmpty())
+			Expect(shoot.Spec.Provider.Workers[0].Minimum).To(Equal(int32(3)))
+			Expect(shoot.Spec.Provider.Workers[0].Maximum).To(Equal(int32(3)))
+
+			commonShootValidation(gc, apiServer, shoot, flavor)
+		})
+
+		It("should spread workers across zones in a zoned region", func() {
+			flavor := "default/azure"
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.GardenerDedicated, flavor, "testdata", "conversion", "apiserver-08.yaml")
+			apiServer.Spec.GardenerConfig.Region = "germanywestcentral"
+			shoot := &gardenv1beta1.Shoot{}
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+
+			Expect(shoot.Spec.Provider.Workers).To(HaveLen(1))
+			Expect(shoot.Spec.Provider.Workers[0].Zones).To(ConsistOf("1", "2", "3"))
+			Expect(gardener.PlacementFromShoot(shoot).WorkerZones).To(Equal([]string{"1", "2", "3"}))
+
+			commonShootValidation(gc, apiServer, shoot, flavor)
+		})
+
+	})
+
+	Context("Region Selection", func() {
+
+		// The garden cluster contains three shoots in europe-west1, belonging to ManagedControlPlanes.
+		// With the GCP mapper, europe-west1 and europe-west3 are equally close to (europe, central).
+		flavor := "default/gcp"
+
+		var setRegionConfig = func(cfg apiserverconfig.RegionConfiguration) {
+			completedDefaultConfigMulti.GardenerConfig.Landscapes["default"].Configurations["gcp"].RegionConfigurations[cfg.Name] = cfg
+		}
+
+		It("should prefer the least loaded region", func() {
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			shoot := &gardenv1beta1.Shoot{}
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Region).To(Equal("europe-west3"))
+		})
+
+		It("should distribute shoots according to the region weights", func() {
+			setRegionConfig(apiserverconfig.RegionConfiguration{Name: "europe-west1", Weight: ptr.To[int32](10)})
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			shoot := &gardenv1beta1.Shoot{}
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Region).To(Equal("europe-west1"))
+		})
+
+		It("should not choose a region which reached its shoot limit", func() {
+			setRegionConfig(apiserverconfig.RegionConfiguration{Name: "europe-west1", Weight: ptr.To[int32](10), MaxShoots: ptr.To[int32](3)})
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			shoot := &gardenv1beta1.Shoot{}
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Region).To(Equal("europe-west3"))
+		})
+
+		It("should not choose a drained region and fall back to the next closest regions", func() {
+			setRegionConfig(apiserverconfig.RegionConfiguration{Name: "europe-west1", Weight: ptr.To[int32](0)})
+			setRegionConfig(apiserverconfig.RegionConfiguration{Name: "europe-west3", Weight: ptr.To[int32](0)})
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			shoot := &gardenv1beta1.Shoot{}
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Region).To(BeElementOf("us-central1", "asia-south1"))
+			placement := gardener.PlacementFromShoot(shoot)
+			Expect(placement.RegionSelection.Method).To(Equal(openmcpv1alpha1.RegionSelectionMethodDesiredRegion))
+		})
+
+		It("should use the default region if all regions are drained", func() {
+			for _, name := range []string{"europe-west1", "europe-west3", "us-central1", "asia-south1"} {
+				setRegionConfig(apiserverconfig.RegionConfiguration{Name: name, Weight: ptr.To[int32](0)})
+			}
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			_, gcfg, err := gc.LandscapeConfiguration(flavor)
+			Expect(err).ToNot(HaveOccurred())
+			shoot := &gardenv1beta1.Shoot{}
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Region).To(Equal(gcfg.DefaultRegion))
+			placement := gardener.PlacementFromShoot(shoot)
+			Expect(placement.RegionSelection.Method).To(Equal(openmcpv1alpha1.RegionSelectionMethodDefault))
+		})
+
+	})
+
+	Context("Sizing Profiles", func() {
+
+		flavor := "default/gcp"
+
+		It("should apply the chosen sizing profile and replace the workers if it is changed", func() {
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.GardenerDedicated, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			apiServer.Spec.GardenerConfig = &openmcpv1alpha1.GardenerConfiguration{
+				SizingProfile: "large",
+			}
+			shoot := &gardenv1beta1.Shoot{}
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Purpose).To(Equal(ptr.To(gardenv1beta1.ShootPurposeProduction)))
+			Expect(shoot.Spec.Provider.Workers).To(HaveLen(1))
+			oldWorker := shoot.Spec.Provider.Workers[0]
+			Expect(oldWorker.Machine.Type).To(Equal("n1-standard-8"))
+			Expect(oldWorker.Minimum).To(Equal(int32(2)))
+			Expect(oldWorker.Maximum).To(Equal(int32(5)))
+
+			apiServer.Spec.GardenerConfig.SizingProfile = "small"
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Purpose).To(Equal(ptr.To(gardenv1beta1.ShootPurposeEvaluation)))
+			Expect(shoot.Spec.Provider.Workers).To(HaveLen(1))
+			newWorker := shoot.Spec.Provider.Workers[0]
+			Expect(newWorker.Name).ToNot(Equal(oldWorker.Name))
+			Expect(newWorker.Machine.Type).To(Equal("n1-standard-1"))
+			Expect(newWorker.Minimum).To(Equal(int32(1)))
+			Expect(newWorker.Maximum).To(Equal(int32(1)))
+		})
+
+		It("should not change the purpose from or to 'testing'", func() {
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			apiServer.Spec.GardenerConfig = &openmcpv1alpha1.GardenerConfiguration{
+				SizingProfile: "small",
+			}
+			shoot := &gardenv1beta1.Shoot{}
+			shoot.Spec.Purpose = ptr.To(gardenv1beta1.ShootPurposeTesting)
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Purpose).To(Equal(ptr.To(gardenv1beta1.ShootPurposeTesting)))
+		})
+
+		It("should reject an unknown sizing profile", func() {
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.GardenerDedicated, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			apiServer.Spec.GardenerConfig = &openmcpv1alpha1.GardenerConfiguration{
+				SizingProfile: "medium",
+			}
+			shoot := &gardenv1beta1.Shoot{}
+			err := gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown sizing profile 'medium', supported sizing profiles are [small, large]"))
+		})
+
+	})
+
 	Context("Multi-Config-Specific Tests", func() {
 
 		for _, apiServerType := range []openmcpv1alpha1.APIServerType{openmcpv1alpha1.Gardener, openmcpv1alpha1.GardenerDedicated} {
@@ -413,6 +609,38 @@ func commonShootValidation(gc *gardener.GardenerConnector, as *openmcpv1alpha1.A
 				cidrs = append(cidrs, workers, public, internal)
 			}
 			Expect(cidr.VerifyNoOverlap(cidrs, vpc)).To(Succeed())
+		case "azure":
+			cpc := map[string]interface{}{}
+			Expect(yaml.Unmarshal(shoot.Spec.Provider.ControlPlaneConfig.Raw, &cpc)).To(Succeed())
+			Expect(cpc).To(HaveKeyWithValue("apiVersion", "azure.provider.extensions.gardener.cloud/v1alpha1"))
+			Expect(cpc).ToNot(HaveKey("zone"))
+			infraCfg := map[string]interface{}{}
+			Expect(yaml.Unmarshal(shoot.Spec.Provider.InfrastructureConfig.Raw, &infraCfg)).To(Succeed())
+			zoned := len(gcfg.ValidRegions[shoot.Spec.Region].Zones) > 0
+			Expect(infraCfg).To(HaveKeyWithValue("zoned", zoned))
+			Expect(infraCfg).To(HaveKey("networks"))
+			networks, ok := infraCfg["networks"].(map[string]interface{})
+			Expect(ok).To(BeTrue(), "spec.provider.infrastructureConfig.networks is not an object")
+			Expect(networks).To(HaveKeyWithValue("workers", "10.180.0.0/19"))
+			for _, w := range shoot.Spec.Provider.Workers {
+				if zoned {
+					Expect(w.Zones).ToNot(BeEmpty())
+				} else {
+					Expect(w.Zones).To(BeEmpty())
+				}
+			}
+		case "openstack":
+			cpc := map[string]interface{}{}
+			Expect(yaml.Unmarshal(shoot.Spec.Provider.ControlPlaneConfig.Raw, &cpc)).To(Succeed())
+			Expect(cpc).To(HaveKeyWithValue("apiVersion", "openstack.provider.extensions.gardener.cloud/v1alpha1"))
+			Expect(cpc).To(HaveKeyWithValue("loadBalancerProvider", "f5"))
+			infraCfg := map[string]interface{}{}
+			Expect(yaml.Unmarshal(shoot.Spec.Provider.InfrastructureConfig.Raw, &infraCfg)).To(Succeed())
+			Expect(infraCfg).To(HaveKeyWithValue("floatingPoolName", "FloatingIP-external"))
+			Expect(infraCfg).To(HaveKey("networks"))
+			networks, ok := infraCfg["networks"].(map[string]interface{})
+			Expect(ok).To(BeTrue(), "spec.provider.infrastructureConfig.networks is not an object")
+			Expect(networks).To(HaveKeyWithValue("workers", *gcfg.ShootTemplate.Spec.Networking.Nodes))
 		}
 	}
 
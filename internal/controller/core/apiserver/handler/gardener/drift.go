@@ -0,0 +1,193 @@
+package gardener
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	gardenv1beta1 "github.com/openmcp-project/mcp-operator/api/external/gardener/pkg/apis/core/v1beta1"
+	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
+	"github.com/openmcp-project/mcp-operator/internal/utils/drift"
+)
+
+const (
+	// EventReasonShootDrift is the reason of the event which is emitted when a shoot has been modified outside of the operator.
+	EventReasonShootDrift = "ShootDrift"
+
+	// maxReportedDriftPaths is the maximum number of drifted fields which are listed in conditions and events.
+	maxReportedDriftPaths = 10
+)
+
+// gardenerDefaultedPaths are shoot fields which are defaulted by Gardener if they are not set.
+// Differences in these fields are not considered drift if the desired shoot doesn't set them.
+var gardenerDefaultedPaths = mustParseDriftPaths(
+	"spec.kubernetes.enableStaticTokenKubeconfig",
+	"spec.kubernetes.kubeAPIServer.defaultNotReadyTolerationSeconds",
+	"spec.kubernetes.kubeAPIServer.defaultUnreachableTolerationSeconds",
+	"spec.kubernetes.kubeAPIServer.enableAnonymousAuthentication",
+	"spec.kubernetes.kubeAPIServer.eventTTL",
+	"spec.kubernetes.kubeAPIServer.logging",
+	"spec.kubernetes.kubeAPIServer.requests",
+	"spec.kubernetes.kubeControllerManager",
+	"spec.kubernetes.kubeProxy",
+	"spec.kubernetes.kubeScheduler",
+	"spec.kubernetes.kubelet",
+	"spec.networking.ipFamilies",
+	"spec.provider.workers[*].cri",
+	"spec.provider.workers[*].machine.architecture",
+	"spec.provider.workers[*].machine.image.version",
+	"spec.provider.workers[*].maxSurge",
+	"spec.provider.workers[*].maxUnavailable",
+	"spec.provider.workers[*].systemComponents",
+	"spec.schedulerName",
+	"spec.systemComponents",
+)
+
+func mustParseDriftPaths(paths ...string) []drift.Path {
+	res, err := drift.ParsePaths(paths...)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// ShootDrift returns the paths of all fields in which the live shoot differs from the desired shoot.
+// Only the labels, the annotations, and the spec are compared, fields covered by the ignore paths are skipped.
+// Fields which are defaulted by Gardener are skipped as well, unless they are set in the desired shoot.
+func ShootDrift(live, desired *gardenv1beta1.Shoot, ignore []drift.Path) ([]string, error) {
+	liveData, err := comparableShootData(live)
+	if err != nil {
+		return nil, fmt.Errorf("error converting live shoot: %w", err)
+	}
+	desiredData, err := comparableShootData(desired)
+	if err != nil {
+		return nil, fmt.Errorf("error converting desired shoot: %w", err)
+	}
+	drifted := drift.Diff(liveData, desiredData, ignore...)
+	res := make([]string, 0, len(drifted))
+	for _, path := range drifted {
+		if isGardenerDefaulted(path, desiredData) {
+			continue
+		}
+		res = append(res, path)
+	}
+	return res, nil
+}
+
+// isGardenerDefaulted returns true if the given drifted field is defaulted by Gardener and not set in the given desired shoot data.
+func isGardenerDefaulted(path string, desiredData map[string]any) bool {
+	p, err := drift.ParsePath(path)
+	if err != nil {
+		return false
+	}
+	for _, dp := range gardenerDefaultedPaths {
+		if !dp.Covers(p) {
+			continue
+		}
+		// only the part of the path which is matched by the defaulted path is relevant, fields below it might be set by Gardener
+		if _, set := drift.Get(desiredData, p[:len(dp)]); !set {
+			return true
+		}
+	}
+	return false
+}
+
+// comparableShootData returns the parts of the shoot which are relevant for the drift detection in unstructured form.
+func comparableShootData(sh *gardenv1beta1.Shoot) (map[string]any, error) {
+	spec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&sh.Spec)
+	if err != nil {
+		return nil, err
+	}
+	metadata := map[string]any{}
+	if len(sh.Labels) > 0 {
+		metadata["labels"] = stringMapToUnstructured(sh.Labels)
+	}
+	if len(sh.Annotations) > 0 {
+		metadata["annotations"] = stringMapToUnstructured(sh.Annotations)
+	}
+	return map[string]any{
+		"metadata": metadata,
+		"spec":     spec,
+	}, nil
+}
+
+func stringMapToUnstructured(m map[string]string) map[string]any {
+	res := make(map[string]any, len(m))
+	for k, v := range m {
+		res[k] = v
+	}
+	return res
+}
+
+// formatDriftPaths returns a human-readable list of the given drifted fields.
+// At most maxReportedDriftPaths fields are listed.
+func formatDriftPaths(paths []string) string {
+	if len(paths) <= maxReportedDriftPaths {
+		return strings.Join(paths, ", ")
+	}
+	return fmt.Sprintf("%s, and %d more", strings.Join(paths[:maxReportedDriftPaths], ", "), len(paths)-maxReportedDriftPaths)
+}
+
+// manualShootDrift returns those of the given drifted fields in which the live shoot also differs from the last-applied shoot manifest.
+// These fields have been changed by someone else since the operator wrote the shoot, while the remaining drifted fields are caused by changes of the desired state.
+// If there is no last-applied shoot manifest, manual changes cannot be told apart and nil is returned.
+func manualShootDrift(live, lastApplied *gardenv1beta1.Shoot, drifted []string, ignore []drift.Path) ([]string, error) {
+	if lastApplied == nil || len(drifted) == 0 {
+		return nil, nil
+	}
+	changed, err := ShootDrift(live, lastApplied, ignore)
+	if err != nil {
+		return nil, err
+	}
+	changedPaths := make([]drift.Path, 0, len(changed))
+	for _, path := range changed {
+		p, err := drift.ParsePath(path)
+		if err != nil {
+			continue
+		}
+		changedPaths = append(changedPaths, p)
+	}
+	res := []string{}
+	for _, path := range drifted {
+		p, err := drift.ParsePath(path)
+		if err != nil {
+			continue
+		}
+		for _, cp := range changedPaths {
+			if cp.Covers(p) || p.Covers(cp) {
+				res = append(res, path)
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+// lastAppliedShoot returns the shoot manifest which has been written to the status of the given APIServer when the shoot was last applied.
+// It returns nil if the status doesn't contain a shoot manifest, or only a reference to the shoot.
+func lastAppliedShoot(as *openmcpv1alpha1.APIServer) (*gardenv1beta1.Shoot, error) {
+	uShoot, err := as.Status.GardenerStatus.GetShoot()
+	if err != nil || uShoot == nil {
+		return nil, err
+	}
+	if _, ok := uShoot.Object["spec"]; !ok {
+		return nil, nil
+	}
+	sh := &gardenv1beta1.Shoot{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(uShoot.Object, sh); err != nil {
+		return nil, fmt.Errorf("unable to convert shoot manifest from status: %w", err)
+	}
+	return sh, nil
+}
+
+// shootDriftCondition returns the ShootDrift condition for the given manually changed fields.
+// The condition is always "True", because the drift has been corrected when the condition is reported.
+func shootDriftCondition(paths []string) openmcpv1alpha1.ComponentCondition {
+	if len(paths) == 0 {
+		return componentutils.NewCondition(cconst.ConditionShootDrift, openmcpv1alpha1.ComponentConditionStatusTrue, cconst.ReasonNoShootDrift, "Shoot has not been modified outside of the operator.")
+	}
+	return componentutils.NewCondition(cconst.ConditionShootDrift, openmcpv1alpha1.ComponentConditionStatusTrue, cconst.ReasonShootDriftCorrected, fmt.Sprintf("Shoot has been modified outside of the operator and has been reset to its desired state. Drifted fields: %s", formatDriftPaths(paths)))
+}
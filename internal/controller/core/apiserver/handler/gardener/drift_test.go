@@ -0,0 +1,170 @@
+package gardener_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/openmcp-project/mcp-operator/test/matchers"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	gardenv1beta1 "github.com/openmcp-project/mcp-operator/api/external/gardener/pkg/apis/core/v1beta1"
+	"github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/handler/gardener"
+	"github.com/openmcp-project/mcp-operator/internal/utils/drift"
+)
+
+var _ = Describe("Drift Detection", func() {
+
+	Context("ShootDrift", func() {
+
+		It("should only report drifted fields which are not ignored", func() {
+			live := &gardenv1beta1.Shoot{}
+			live.SetLabels(map[string]string{"foo": "bar"})
+			live.SetAnnotations(map[string]string{"gardener.cloud/timestamp": "1"})
+			live.SetResourceVersion("1")
+			live.Spec.Region = "europe-west1"
+			live.Spec.Purpose = ptr.To(gardenv1beta1.ShootPurposeEvaluation)
+			live.Status.IsHibernated = true
+
+			desired := live.DeepCopy()
+			desired.SetResourceVersion("2")
+			desired.Status.IsHibernated = false
+			paths, err := gardener.ShootDrift(live, desired, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(paths).To(BeEmpty())
+
+			desired.SetLabels(map[string]string{"foo": "baz"})
+			desired.SetAnnotations(map[string]string{"gardener.cloud/timestamp": "2"})
+			desired.Spec.Region = "europe-west3"
+			paths, err = gardener.ShootDrift(live, desired, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(paths).To(ConsistOf("metadata.labels.foo", "metadata.annotations[gardener.cloud/timestamp]", "spec.region"))
+
+			ignore, err := drift.ParsePaths("metadata.annotations[gardener.cloud/timestamp]", "spec.region")
+			Expect(err).ToNot(HaveOccurred())
+			paths, err = gardener.ShootDrift(live, desired, ignore)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(paths).To(ConsistOf("metadata.labels.foo"))
+		})
+
+		It("should ignore fields defaulted by Gardener only if they are not set in the desired shoot", func() {
+			live := &gardenv1beta1.Shoot{}
+			live.Spec.SchedulerName = ptr.To("default-scheduler")
+			live.Spec.Provider.Workers = []gardenv1beta1.Worker{
+				{
+					Name: "worker",
+					Machine: gardenv1beta1.Machine{
+						Type:         "n1-standard-4",
+						Image:        &gardenv1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1592.1.0")},
+						Architecture: ptr.To("amd64"),
+					},
+					CRI: &gardenv1beta1.CRI{Name: gardenv1beta1.CRINameContainerD},
+				},
+			}
+
+			desired := live.DeepCopy()
+			desired.Spec.SchedulerName = nil
+			desired.Spec.Provider.Workers[0].Machine.Image.Version = nil
+			desired.Spec.Provider.Workers[0].Machine.Architecture = nil
+			desired.Spec.Provider.Workers[0].CRI = nil
+			paths, err := gardener.ShootDrift(live, desired, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(paths).To(BeEmpty())
+
+			desired.Spec.SchedulerName = ptr.To("bin-packing-scheduler")
+			desired.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To("1443.3.0")
+			paths, err = gardener.ShootDrift(live, desired, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(paths).To(ConsistOf("spec.schedulerName", "spec.provider.workers[0].machine.image.version"))
+		})
+
+	})
+
+	Context("HandleCreateOrUpdate", func() {
+
+		It("should skip the update if the shoot matches its desired state and correct it otherwise", func() {
+			gc, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "", "testdata", "connector", "apiserver-04.yaml")
+			_, usf, _, err := gc.HandleCreateOrUpdate(env.Ctx, as, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usf(&as.Status)).To(Succeed())
+
+			sh := &gardenv1beta1.Shoot{}
+			Expect(env.Client(gardenCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "garden-test"}, sh)).To(Succeed())
+			rv := sh.GetResourceVersion()
+
+			_, usf, cons, err := gc.HandleCreateOrUpdate(env.Ctx, as, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usf(&as.Status)).To(Succeed())
+			Expect(cons).To(ContainElement(MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+				Type:   cconst.ConditionShootDrift,
+				Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+				Reason: cconst.ReasonNoShootDrift,
+			})))
+			Expect(env.Client(gardenCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "garden-test"}, sh)).To(Succeed())
+			Expect(sh.GetResourceVersion()).To(Equal(rv))
+
+			// remove an enforced annotation by hand
+			old := sh.DeepCopy()
+			delete(sh.Annotations, "shoot.gardener.cloud/cleanup-extended-apis-finalize-grace-period-seconds")
+			Expect(env.Client(gardenCluster).Patch(env.Ctx, sh, client.MergeFrom(old))).To(Succeed())
+
+			_, usf, cons, err = gc.HandleCreateOrUpdate(env.Ctx, as, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usf(&as.Status)).To(Succeed())
+			Expect(cons).To(ContainElement(MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+				Type:    cconst.ConditionShootDrift,
+				Status:  openmcpv1alpha1.ComponentConditionStatusTrue,
+				Reason:  cconst.ReasonShootDriftCorrected,
+				Message: "Shoot has been modified outside of the operator and has been reset to its desired state. Drifted fields: metadata.annotations[shoot.gardener.cloud/cleanup-extended-apis-finalize-grace-period-seconds]",
+			})))
+			Expect(env.Client(gardenCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "garden-test"}, sh)).To(Succeed())
+			Expect(sh.GetAnnotations()).To(HaveKeyWithValue("shoot.gardener.cloud/cleanup-extended-apis-finalize-grace-period-seconds", "30"))
+
+			// the drift has been corrected, so it is not reported anymore
+			_, _, cons, err = gc.HandleCreateOrUpdate(env.Ctx, as, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cons).To(ContainElement(MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+				Type:   cconst.ConditionShootDrift,
+				Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+				Reason: cconst.ReasonNoShootDrift,
+			})))
+		})
+
+		It("should not report changes of the desired state as drift", func() {
+			gc, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "", "testdata", "connector", "apiserver-04.yaml")
+			_, usf, _, err := gc.HandleCreateOrUpdate(env.Ctx, as, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usf(&as.Status)).To(Succeed())
+
+			sh := &gardenv1beta1.Shoot{}
+			Expect(env.Client(gardenCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "garden-test"}, sh)).To(Succeed())
+			rv := sh.GetResourceVersion()
+
+			// change the desired state by modifying the last-applied manifest, as if the operator had applied a different shoot before
+			uShoot, err2 := as.Status.GardenerStatus.GetShoot()
+			Expect(err2).ToNot(HaveOccurred())
+			anns := uShoot.GetAnnotations()
+			anns["shoot.gardener.cloud/cleanup-extended-apis-finalize-grace-period-seconds"] = "60"
+			uShoot.SetAnnotations(anns)
+			old := sh.DeepCopy()
+			sh.Annotations["shoot.gardener.cloud/cleanup-extended-apis-finalize-grace-period-seconds"] = "60"
+			Expect(env.Client(gardenCluster).Patch(env.Ctx, sh, client.MergeFrom(old), client.FieldOwner(gardener.FieldManager))).To(Succeed())
+
+			_, _, cons, err := gc.HandleCreateOrUpdate(env.Ctx, as, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cons).To(ContainElement(MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+				Type:   cconst.ConditionShootDrift,
+				Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+				Reason: cconst.ReasonNoShootDrift,
+			})))
+			Expect(env.Client(gardenCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "garden-test"}, sh)).To(Succeed())
+			Expect(sh.GetResourceVersion()).ToNot(Equal(rv))
+			Expect(sh.GetAnnotations()).To(HaveKeyWithValue("shoot.gardener.cloud/cleanup-extended-apis-finalize-grace-period-seconds", "30"))
+		})
+
+	})
+
+})
@@ -0,0 +1,111 @@
+package gardener
+
+import (
+	"reflect"
+
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	"k8s.io/utils/ptr"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	gardenv1beta1 "github.com/openmcp-project/mcp-operator/api/external/gardener/pkg/apis/core/v1beta1"
+)
+
+// hibernationOperation returns the value of the APIServer's operation annotation, if it requests the APIServer to be hibernated or woken up.
+// Otherwise, an empty string is returned.
+func hibernationOperation(as *openmcpv1alpha1.APIServer) string {
+	op := as.GetAnnotations()[openmcpv1alpha1.OperationAnnotation]
+	if op == openmcpv1alpha1.OperationAnnotationValueHibernate || op == openmcpv1alpha1.OperationAnnotationValueWakeUp {
+		return op
+	}
+	return ""
+}
+
+// setShootHibernation renders the hibernation configuration from the APIServer spec into the shoot.
+// The given operation is used to hibernate or wake up the shoot on demand, it is expected to be the return value of hibernationOperation.
+// If no hibernation is configured, the shoot is kept awake and the operation is ignored.
+// Otherwise, the shoot's hibernation state is only modified by the operation, as it is usually controlled by Gardener based on the schedules.
+func setShootHibernation(log logging.Logger, sh *gardenv1beta1.Shoot, hc *openmcpv1alpha1.HibernationConfig, op string) {
+	if sh.Spec.Hibernation == nil {
+		sh.Spec.Hibernation = &gardenv1beta1.Hibernation{}
+	}
+
+	if hc == nil {
+		if op != "" {
+			log.Info("Ignoring hibernation operation annotation, because hibernation is not configured for the APIServer", "operation", op)
+		}
+		if len(sh.Spec.Hibernation.Schedules) > 0 {
+			log.Debug("Removing shoot.Spec.Hibernation.Schedules")
+			sh.Spec.Hibernation.Schedules = nil
+		}
+		if sh.Spec.Hibernation.Enabled == nil || *sh.Spec.Hibernation.Enabled {
+			log.Debug("Setting shoot.Spec.Hibernation.Enabled", "value", false)
+			sh.Spec.Hibernation.Enabled = ptr.To(false)
+		}
+		return
+	}
+
+	var schedules []gardenv1beta1.HibernationSchedule
+	for _, s := range hc.Schedules {
+		gs := gardenv1beta1.HibernationSchedule{}
+		if s.Start != "" {
+			gs.Start = ptr.To(s.Start)
+		}
+		if s.End != "" {
+			gs.End = ptr.To(s.End)
+		}
+		if hc.Location != "" {
+			gs.Location = ptr.To(hc.Location)
+		}
+		schedules = append(schedules, gs)
+	}
+	if !reflect.DeepEqual(sh.Spec.Hibernation.Schedules, schedules) {
+		log.Debug("Setting shoot.Spec.Hibernation.Schedules", "count", len(schedules))
+		sh.Spec.Hibernation.Schedules = schedules
+	}
+
+	switch op {
+	case openmcpv1alpha1.OperationAnnotationValueHibernate:
+		log.Info("Hibernating shoot on demand")
+		sh.Spec.Hibernation.Enabled = ptr.To(true)
+	case openmcpv1alpha1.OperationAnnotationValueWakeUp:
+		log.Info("Waking up shoot on demand")
+		sh.Spec.Hibernation.Enabled = ptr.To(false)
+	default:
+		if sh.Spec.Hibernation.Enabled == nil {
+			log.Debug("Setting shoot.Spec.Hibernation.Enabled", "value", false)
+			sh.Spec.Hibernation.Enabled = ptr.To(false)
+		}
+	}
+}
+
+// HibernationStatusFromShoot derives the hibernation state of the APIServer from the shoot.
+// Returns nil if hibernation is not configured and the shoot is not hibernated.
+func HibernationStatusFromShoot(sh *gardenv1beta1.Shoot, hc *openmcpv1alpha1.HibernationConfig) *openmcpv1alpha1.HibernationStatus {
+	if sh == nil {
+		return nil
+	}
+	enabled := sh.Spec.Hibernation != nil && ptr.Deref(sh.Spec.Hibernation.Enabled, false)
+	if hc == nil && !enabled && !sh.Status.IsHibernated {
+		return nil
+	}
+	state := openmcpv1alpha1.HibernationStateAwake
+	switch {
+	case enabled && sh.Status.IsHibernated:
+		state = openmcpv1alpha1.HibernationStateHibernated
+	case enabled:
+		state = openmcpv1alpha1.HibernationStateHibernating
+	case sh.Status.IsHibernated:
+		state = openmcpv1alpha1.HibernationStateWakingUp
+	}
+	return &openmcpv1alpha1.HibernationStatus{
+		State: state,
+	}
+}
+
+// apiServerHibernationConfig returns the hibernation configuration of the given APIServer, if any.
+func apiServerHibernationConfig(as *openmcpv1alpha1.APIServer) *openmcpv1alpha1.HibernationConfig {
+	if as.Spec.GardenerConfig == nil {
+		return nil
+	}
+	return as.Spec.GardenerConfig.Hibernation
+}
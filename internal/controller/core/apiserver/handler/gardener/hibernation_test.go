@@ -0,0 +1,171 @@
+package gardener_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/openmcp-project/mcp-operator/test/matchers"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	gardenv1beta1 "github.com/openmcp-project/mcp-operator/api/external/gardener/pkg/apis/core/v1beta1"
+	"github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/handler/gardener"
+	testutils "github.com/openmcp-project/mcp-operator/test/utils"
+)
+
+var _ = Describe("Hibernation", func() {
+
+	flavor := "default/gcp"
+
+	Context("HibernationStatusFromShoot", func() {
+
+		hc := &openmcpv1alpha1.HibernationConfig{}
+
+		shootWithHibernation := func(enabled, hibernated bool) *gardenv1beta1.Shoot {
+			sh := &gardenv1beta1.Shoot{}
+			sh.Spec.Hibernation = &gardenv1beta1.Hibernation{Enabled: ptr.To(enabled)}
+			sh.Status.IsHibernated = hibernated
+			return sh
+		}
+
+		It("should not return a status if hibernation is neither configured nor active", func() {
+			Expect(gardener.HibernationStatusFromShoot(shootWithHibernation(false, false), nil)).To(BeNil())
+		})
+
+		It("should derive the hibernation state from the shoot", func() {
+			Expect(gardener.HibernationStatusFromShoot(shootWithHibernation(false, false), hc).State).To(Equal(openmcpv1alpha1.HibernationStateAwake))
+			Expect(gardener.HibernationStatusFromShoot(shootWithHibernation(true, false), hc).State).To(Equal(openmcpv1alpha1.HibernationStateHibernating))
+			Expect(gardener.HibernationStatusFromShoot(shootWithHibernation(true, true), hc).State).To(Equal(openmcpv1alpha1.HibernationStateHibernated))
+			Expect(gardener.HibernationStatusFromShoot(shootWithHibernation(false, true), hc).State).To(Equal(openmcpv1alpha1.HibernationStateWakingUp))
+			Expect(gardener.HibernationStatusFromShoot(shootWithHibernation(false, false), hc).IsHibernated()).To(BeFalse())
+			Expect(gardener.HibernationStatusFromShoot(shootWithHibernation(false, true), hc).IsHibernated()).To(BeTrue())
+		})
+
+	})
+
+	Context("Shoot Conversion", func() {
+
+		It("should keep the shoot awake if no hibernation is configured", func() {
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			apiServer.SetAnnotations(map[string]string{openmcpv1alpha1.OperationAnnotation: openmcpv1alpha1.OperationAnnotationValueHibernate})
+			shoot := &gardenv1beta1.Shoot{}
+			shoot.Spec.Hibernation = &gardenv1beta1.Hibernation{
+				Enabled:   ptr.To(true),
+				Schedules: []gardenv1beta1.HibernationSchedule{{Start: ptr.To("0 20 * * *")}},
+			}
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Hibernation).To(Equal(&gardenv1beta1.Hibernation{Enabled: ptr.To(false)}))
+		})
+
+		It("should render the hibernation schedules into the shoot", func() {
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			apiServer.Spec.GardenerConfig = &openmcpv1alpha1.GardenerConfiguration{
+				Hibernation: &openmcpv1alpha1.HibernationConfig{
+					Schedules: []openmcpv1alpha1.HibernationSchedule{
+						{Start: "0 20 * * 1-5", End: "0 7 * * 1-5"},
+						{Start: "0 12 * * 6"},
+					},
+					Location: "Europe/Berlin",
+				},
+			}
+			shoot := &gardenv1beta1.Shoot{}
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Hibernation).To(Equal(&gardenv1beta1.Hibernation{
+				Enabled: ptr.To(false),
+				Schedules: []gardenv1beta1.HibernationSchedule{
+					{Start: ptr.To("0 20 * * 1-5"), End: ptr.To("0 7 * * 1-5"), Location: ptr.To("Europe/Berlin")},
+					{Start: ptr.To("0 12 * * 6"), Location: ptr.To("Europe/Berlin")},
+				},
+			}))
+
+			// the hibernation state set by Gardener based on the schedules must not be reverted
+			shoot.Spec.Hibernation.Enabled = ptr.To(true)
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Hibernation.Enabled).To(Equal(ptr.To(true)))
+		})
+
+		It("should hibernate and wake up the shoot on demand", func() {
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			apiServer.Spec.GardenerConfig = &openmcpv1alpha1.GardenerConfiguration{
+				Hibernation: &openmcpv1alpha1.HibernationConfig{},
+			}
+			apiServer.SetAnnotations(map[string]string{openmcpv1alpha1.OperationAnnotation: openmcpv1alpha1.OperationAnnotationValueHibernate})
+			shoot := &gardenv1beta1.Shoot{}
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Hibernation.Enabled).To(Equal(ptr.To(true)))
+			Expect(shoot.Spec.Hibernation.Schedules).To(BeEmpty())
+
+			apiServer.SetAnnotations(map[string]string{openmcpv1alpha1.OperationAnnotation: openmcpv1alpha1.OperationAnnotationValueWakeUp})
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Hibernation.Enabled).To(Equal(ptr.To(false)))
+		})
+
+	})
+
+	Context("GardenerConnector", func() {
+
+		It("should apply the hibernation operation to the shoot and remove the annotation afterwards", func() {
+			gc, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "", "testdata", "connector", "apiserver-04.yaml")
+			as.Spec.GardenerConfig = &openmcpv1alpha1.GardenerConfiguration{
+				Hibernation: &openmcpv1alpha1.HibernationConfig{},
+			}
+			as.SetAnnotations(map[string]string{openmcpv1alpha1.OperationAnnotation: openmcpv1alpha1.OperationAnnotationValueHibernate})
+			crateClient := env.Client(testutils.CrateCluster)
+			Expect(crateClient.Create(env.Ctx, as)).To(Succeed())
+
+			_, usf, _, err := gc.HandleCreateOrUpdate(env.Ctx, as, crateClient)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usf(&as.Status)).To(Succeed())
+			Expect(as.Status.Hibernation).To(Equal(&openmcpv1alpha1.HibernationStatus{State: openmcpv1alpha1.HibernationStateHibernating}))
+
+			sh := &gardenv1beta1.Shoot{}
+			Expect(env.Client(gardenCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "garden-test"}, sh)).To(Succeed())
+			Expect(sh.Spec.Hibernation.Enabled).To(Equal(ptr.To(true)))
+			Expect(crateClient.Get(env.Ctx, client.ObjectKeyFromObject(as), as)).To(Succeed())
+			Expect(as.GetAnnotations()).ToNot(HaveKey(openmcpv1alpha1.OperationAnnotation))
+		})
+
+		It("should report a hibernated shoot and not refresh the admin access", func() {
+			sh := &gardenv1beta1.Shoot{}
+			Expect(env.Client(gardenCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "garden-test"}, sh)).To(Succeed())
+			old := sh.DeepCopy()
+			sh.Spec.Hibernation = &gardenv1beta1.Hibernation{Enabled: ptr.To(true)}
+			Expect(env.Client(gardenCluster).Patch(env.Ctx, sh, client.MergeFrom(old))).To(Succeed())
+			old = sh.DeepCopy()
+			sh.Status.IsHibernated = true
+			Expect(env.Client(gardenCluster).Status().Patch(env.Ctx, sh, client.MergeFrom(old))).To(Succeed())
+
+			gc, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "", "testdata", "connector", "apiserver-04.yaml")
+			as.Spec.GardenerConfig = &openmcpv1alpha1.GardenerConfiguration{
+				Hibernation: &openmcpv1alpha1.HibernationConfig{
+					Schedules: []openmcpv1alpha1.HibernationSchedule{{Start: "0 20 * * *"}},
+				},
+			}
+			res, usf, cons, err := gc.HandleCreateOrUpdate(env.Ctx, as, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cons).To(ConsistOf(
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   openmcpv1alpha1.APIServerComponent.HealthyCondition(),
+					Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+					Reason: cconst.ReasonAPIServerHibernated,
+				}),
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   cconst.ConditionShootDrift,
+					Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+					Reason: cconst.ReasonNoShootDrift,
+				}),
+			))
+			Expect(res.RequeueAfter).To(Equal(10 * time.Minute))
+			Expect(usf(&as.Status)).To(Succeed())
+			Expect(as.Status.AdminAccess).To(BeNil())
+			Expect(as.Status.Hibernation).To(Equal(&openmcpv1alpha1.HibernationStatus{State: openmcpv1alpha1.HibernationStateHibernated}))
+		})
+
+	})
+
+})
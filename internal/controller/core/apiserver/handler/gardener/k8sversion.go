@@ -0,0 +1,252 @@
+package gardener
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
+	gardenv1beta1 "github.com/openmcp-project/mcp-operator/api/external/gardener/pkg/apis/core/v1beta1"
+	"github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/config"
+)
+
+// targetK8sVersionAnnotation records on the shoot the k8s version it should be running according to the configured version policy.
+// Since upgrades are deferred to the shoot's maintenance time window, the annotation is required to report the target version in the APIServer status.
+const targetK8sVersionAnnotation = openmcpv1alpha1.APIServerDomain + "/target-kubernetes-version"
+
+// TargetK8sVersion computes the k8s version a shoot should be running according to the given policy.
+// versions are the k8s versions from the CloudProfile and current is the shoot's current version, which is empty for new shoots.
+// Preview versions and versions which are expired at the given point in time are never chosen.
+// The result is never lower than the current version. If no suitable version exists, the current version is returned.
+func TargetK8sVersion(policy *config.KubernetesVersionPolicy, versions []gardenv1beta1.ExpirableVersion, current string, now time.Time) (string, error) {
+	if policy == nil || policy.Type == config.KubernetesVersionPolicyPin {
+		pinned := ""
+		if policy != nil {
+			pinned = policy.Version
+		}
+		return computeK8sVersion(pinned, current), nil
+	}
+
+	var currentVersion *semver.Version
+	if current != "" {
+		var err error
+		currentVersion, err = semver.NewVersion(current)
+		if err != nil {
+			return "", fmt.Errorf("unable to parse current k8s version '%s': %w", current, err)
+		}
+	}
+
+	usable := usableK8sVersions(versions, now)
+	var target *semver.Version
+	switch policy.Type {
+	case config.KubernetesVersionPolicyLatestPatch:
+		if currentVersion == nil {
+			target = latestVersion(usable, nil)
+			break
+		}
+		target = latestVersion(usable, func(v *semver.Version) bool {
+			return v.Major() == currentVersion.Major() && v.Minor() == currentVersion.Minor()
+		})
+		if target == nil {
+			// the current minor version is not supported anymore, upgrade to the latest patch of the next supported minor version
+			var nextMinor *semver.Version
+			for _, v := range usable {
+				if v.GreaterThan(currentVersion) && (nextMinor == nil || v.LessThan(nextMinor)) {
+					nextMinor = v
+				}
+			}
+			if nextMinor != nil {
+				target = latestVersion(usable, func(v *semver.Version) bool {
+					return v.Major() == nextMinor.Major() && v.Minor() == nextMinor.Minor()
+				})
+			}
+		}
+	case config.KubernetesVersionPolicyPreviousMinor:
+		latest := latestVersion(usable, nil)
+		if latest == nil {
+			break
+		}
+		previousMinor := latestVersion(usable, func(v *semver.Version) bool {
+			return v.Major() < latest.Major() || (v.Major() == latest.Major() && v.Minor() < latest.Minor())
+		})
+		if previousMinor == nil {
+			// there is only one supported minor version
+			previousMinor = latest
+		}
+		target = previousMinor
+	default:
+		return "", fmt.Errorf("unknown k8s version policy type '%s'", policy.Type)
+	}
+
+	if target == nil {
+		return current, nil
+	}
+	return computeK8sVersion(target.Original(), current), nil
+}
+
+// usableK8sVersions parses the given CloudProfile versions and returns the ones which are neither preview versions nor expired at the given point in time.
+// Versions which cannot be parsed are ignored.
+func usableK8sVersions(versions []gardenv1beta1.ExpirableVersion, now time.Time) []*semver.Version {
+	res := make([]*semver.Version, 0, len(versions))
+	for _, ev := range versions {
+		if ev.Classification != nil && *ev.Classification == gardenv1beta1.ClassificationPreview {
+			continue
+		}
+		if ev.ExpirationDate != nil && !now.Before(ev.ExpirationDate.Time) {
+			continue
+		}
+		v, err := semver.NewVersion(ev.Version)
+		if err != nil {
+			continue
+		}
+		res = append(res, v)
+	}
+	return res
+}
+
+// latestVersion returns the highest of the given versions which matches the filter.
+// A nil filter matches all versions. Returns nil if no version matches.
+func latestVersion(versions []*semver.Version, filter func(*semver.Version) bool) *semver.Version {
+	var res *semver.Version
+	for _, v := range versions {
+		if filter != nil && !filter(v) {
+			continue
+		}
+		if res == nil || v.GreaterThan(res) {
+			res = v
+		}
+	}
+	return res
+}
+
+// ValidatePinnedK8sVersion returns an error if the given pinned k8s version is not contained in the given CloudProfile versions or if it is expired at the given point in time.
+// Preview versions are accepted, as pinning them is an explicit decision.
+func ValidatePinnedK8sVersion(pinned string, versions []gardenv1beta1.ExpirableVersion, now time.Time) error {
+	pinnedVersion, err := semver.NewVersion(pinned)
+	if err != nil {
+		return fmt.Errorf("unable to parse pinned k8s version '%s': %w", pinned, err)
+	}
+	for _, ev := range versions {
+		v, err := semver.NewVersion(ev.Version)
+		if err != nil || !v.Equal(pinnedVersion) {
+			continue
+		}
+		if ev.ExpirationDate != nil && !now.Before(ev.ExpirationDate.Time) {
+			return fmt.Errorf("pinned k8s version '%s' expired at %s", pinned, ev.ExpirationDate.UTC().Format(time.RFC3339))
+		}
+		return nil
+	}
+	return fmt.Errorf("pinned k8s version '%s' is not offered by the CloudProfile", pinned)
+}
+
+// policyK8sVersion computes the k8s version the shoot should be running according to the version policy of the given configuration.
+// The supported versions are fetched from the CloudProfile on the garden cluster, unless the policy doesn't require them.
+// If the shoot would be upgraded to a pinned version which is not offered by the CloudProfile, an error with reason ReasonKubernetesVersionNotSupported is returned.
+func policyK8sVersion(ctx context.Context, gardenClient client.Client, gcfg *config.CompletedGardenerConfiguration, current string) (string, error) {
+	policy := gcfg.KubernetesVersionPolicy
+	pinned := ""
+	if policy != nil && policy.Type == config.KubernetesVersionPolicyPin {
+		pinned = policy.Version
+	}
+	// the pinned version only needs to be validated if the shoot would actually be upgraded to it
+	validatePin := pinned != "" && computeK8sVersion(pinned, current) != current
+	var versions []gardenv1beta1.ExpirableVersion
+	if (policy != nil && policy.Type != config.KubernetesVersionPolicyPin) || validatePin {
+		cp := &gardenv1beta1.CloudProfile{}
+		cp.SetName(gcfg.CloudProfile)
+		if err := gardenClient.Get(ctx, client.ObjectKeyFromObject(cp), cp); err != nil {
+			return "", fmt.Errorf("error fetching CloudProfile '%s': %w", gcfg.CloudProfile, err)
+		}
+		versions = cp.Spec.Kubernetes.Versions
+	}
+	now := time.Now()
+	if validatePin {
+		if err := ValidatePinnedK8sVersion(pinned, versions, now); err != nil {
+			return "", openmcperrors.WithReason(err, cconst.ReasonKubernetesVersionNotSupported)
+		}
+	}
+	return TargetK8sVersion(policy, versions, current, now)
+}
+
+// KubernetesVersionStatusFromShoot returns the current and target k8s version of the given shoot.
+// Returns nil if the shoot doesn't have a k8s version yet.
+func KubernetesVersionStatusFromShoot(sh *gardenv1beta1.Shoot) *openmcpv1alpha1.KubernetesVersionStatus {
+	if sh == nil || sh.Spec.Kubernetes.Version == "" {
+		return nil
+	}
+	res := &openmcpv1alpha1.KubernetesVersionStatus{
+		Current: sh.Spec.Kubernetes.Version,
+		Target:  sh.GetAnnotations()[targetK8sVersionAnnotation],
+	}
+	if res.Target == "" {
+		res.Target = res.Current
+	}
+	return res
+}
+
+// shootMaintenanceTimeWindow returns the maintenance time window of the given shoot or nil, if it is not set.
+func shootMaintenanceTimeWindow(sh *gardenv1beta1.Shoot) *gardenv1beta1.MaintenanceTimeWindow {
+	if sh.Spec.Maintenance == nil {
+		return nil
+	}
+	return sh.Spec.Maintenance.TimeWindow
+}
+
+// InMaintenanceTimeWindow returns whether the given point in time lies within the given maintenance time window.
+func InMaintenanceTimeWindow(tw *gardenv1beta1.MaintenanceTimeWindow, t time.Time) (bool, error) {
+	begin, end, err := parseMaintenanceTimeWindow(tw)
+	if err != nil {
+		return false, err
+	}
+	now := timeOfDay(t)
+	switch {
+	case begin < end:
+		return begin <= now && now < end, nil
+	case begin > end:
+		// the time window spans midnight
+		return now >= begin || now < end, nil
+	}
+	// begin and end are identical, the time window covers the whole day
+	return true, nil
+}
+
+// DurationUntilMaintenanceTimeWindow returns the duration from the given point in time until the next beginning of the given maintenance time window.
+// Returns 0 if the point in time lies within the maintenance time window.
+func DurationUntilMaintenanceTimeWindow(tw *gardenv1beta1.MaintenanceTimeWindow, t time.Time) (time.Duration, error) {
+	inWindow, err := InMaintenanceTimeWindow(tw, t)
+	if err != nil || inWindow {
+		return 0, err
+	}
+	begin, _, err := parseMaintenanceTimeWindow(tw)
+	if err != nil {
+		return 0, err
+	}
+	return (begin - timeOfDay(t) + 24*time.Hour) % (24 * time.Hour), nil
+}
+
+// parseMaintenanceTimeWindow returns begin and end of the given maintenance time window as offsets from midnight UTC.
+func parseMaintenanceTimeWindow(tw *gardenv1beta1.MaintenanceTimeWindow) (time.Duration, time.Duration, error) {
+	if tw == nil {
+		return 0, 0, fmt.Errorf("maintenance time window is not set")
+	}
+	begin, err := time.Parse("150405-0700", tw.Begin)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid begin of maintenance time window '%s': %w", tw.Begin, err)
+	}
+	end, err := time.Parse("150405-0700", tw.End)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid end of maintenance time window '%s': %w", tw.End, err)
+	}
+	return timeOfDay(begin), timeOfDay(end), nil
+}
+
+// timeOfDay returns the duration since midnight UTC for the given point in time.
+func timeOfDay(t time.Time) time.Duration {
+	t = t.UTC()
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+}
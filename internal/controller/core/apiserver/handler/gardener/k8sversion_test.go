@@ -0,0 +1,217 @@
+package gardener_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
+	gardenv1beta1 "github.com/openmcp-project/mcp-operator/api/external/gardener/pkg/apis/core/v1beta1"
+	apiserverconfig "github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/config"
+	"github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/handler/gardener"
+)
+
+var _ = Describe("Kubernetes Version Policy", func() {
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	versions := []gardenv1beta1.ExpirableVersion{
+		{Version: "1.32.0", Classification: ptr.To(gardenv1beta1.ClassificationPreview)},
+		{Version: "1.31.3", Classification: ptr.To(gardenv1beta1.ClassificationSupported)},
+		{Version: "1.31.2", Classification: ptr.To(gardenv1beta1.ClassificationDeprecated), ExpirationDate: &metav1.Time{Time: now.Add(30 * 24 * time.Hour)}},
+		{Version: "1.30.5", Classification: ptr.To(gardenv1beta1.ClassificationSupported)},
+		{Version: "1.30.4", Classification: ptr.To(gardenv1beta1.ClassificationDeprecated)},
+		{Version: "1.29.8", Classification: ptr.To(gardenv1beta1.ClassificationSupported), ExpirationDate: &metav1.Time{Time: now.Add(-time.Hour)}},
+	}
+
+	Context("TargetK8sVersion", func() {
+
+		It("should keep the current version for the 'Pin' policy", func() {
+			for _, policy := range []*apiserverconfig.KubernetesVersionPolicy{nil, {Type: apiserverconfig.KubernetesVersionPolicyPin}} {
+				Expect(gardener.TargetK8sVersion(policy, versions, "1.29.8", now)).To(Equal("1.29.8"))
+				Expect(gardener.TargetK8sVersion(policy, versions, "", now)).To(BeEmpty())
+			}
+		})
+
+		It("should upgrade to the pinned version, but never downgrade", func() {
+			policy := &apiserverconfig.KubernetesVersionPolicy{Type: apiserverconfig.KubernetesVersionPolicyPin, Version: "1.30.4"}
+			Expect(gardener.TargetK8sVersion(policy, versions, "1.29.8", now)).To(Equal("1.30.4"))
+			Expect(gardener.TargetK8sVersion(policy, versions, "1.31.3", now)).To(Equal("1.31.3"))
+			Expect(gardener.TargetK8sVersion(policy, versions, "", now)).To(Equal("1.30.4"))
+		})
+
+		It("should follow the latest patch version for the 'LatestPatch' policy", func() {
+			policy := &apiserverconfig.KubernetesVersionPolicy{Type: apiserverconfig.KubernetesVersionPolicyLatestPatch}
+			Expect(gardener.TargetK8sVersion(policy, versions, "1.30.1", now)).To(Equal("1.30.5"))
+			Expect(gardener.TargetK8sVersion(policy, versions, "1.31.2", now)).To(Equal("1.31.3"))
+			// preview versions are not chosen
+			Expect(gardener.TargetK8sVersion(policy, versions, "", now)).To(Equal("1.31.3"))
+			// expired minor versions are upgraded to the next minor version
+			Expect(gardener.TargetK8sVersion(policy, versions, "1.29.8", now)).To(Equal("1.30.5"))
+			// no downgrade
+			Expect(gardener.TargetK8sVersion(policy, versions, "1.32.0", now)).To(Equal("1.32.0"))
+		})
+
+		It("should follow the previous minor version for the 'PreviousMinor' policy", func() {
+			policy := &apiserverconfig.KubernetesVersionPolicy{Type: apiserverconfig.KubernetesVersionPolicyPreviousMinor}
+			Expect(gardener.TargetK8sVersion(policy, versions, "", now)).To(Equal("1.30.5"))
+			Expect(gardener.TargetK8sVersion(policy, versions, "1.29.8", now)).To(Equal("1.30.5"))
+			Expect(gardener.TargetK8sVersion(policy, versions, "1.31.2", now)).To(Equal("1.31.2"))
+			// if only one minor version is supported, it is chosen
+			Expect(gardener.TargetK8sVersion(policy, versions[:3], "", now)).To(Equal("1.31.3"))
+		})
+
+		It("should only accept pinned versions which are offered by the CloudProfile and not expired", func() {
+			Expect(gardener.ValidatePinnedK8sVersion("1.31.3", versions, now)).To(Succeed())
+			Expect(gardener.ValidatePinnedK8sVersion("1.32.0", versions, now)).To(Succeed())
+			Expect(gardener.ValidatePinnedK8sVersion("1.31.4", versions, now)).To(MatchError(ContainSubstring("not offered")))
+			Expect(gardener.ValidatePinnedK8sVersion("1.29.8", versions, now)).To(MatchError(ContainSubstring("expired")))
+			Expect(gardener.ValidatePinnedK8sVersion("latest", versions, now)).ToNot(Succeed())
+		})
+
+		It("should ignore versions which expired in the meantime", func() {
+			policy := &apiserverconfig.KubernetesVersionPolicy{Type: apiserverconfig.KubernetesVersionPolicyLatestPatch}
+			later := now.Add(60 * 24 * time.Hour)
+			Expect(gardener.TargetK8sVersion(policy, versions[1:3], "1.31.1", later)).To(Equal("1.31.3"))
+			Expect(gardener.TargetK8sVersion(policy, versions[2:3], "1.31.1", later)).To(Equal("1.31.1"))
+		})
+
+	})
+
+	Context("Maintenance Time Window", func() {
+
+		It("should detect whether a point in time lies within the maintenance time window", func() {
+			tw := &gardenv1beta1.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"}
+			Expect(gardener.InMaintenanceTimeWindow(tw, time.Date(2025, 6, 1, 21, 30, 0, 0, time.UTC))).To(BeTrue())
+			Expect(gardener.InMaintenanceTimeWindow(tw, time.Date(2025, 6, 1, 22, 30, 0, 0, time.UTC))).To(BeFalse())
+			Expect(gardener.DurationUntilMaintenanceTimeWindow(tw, time.Date(2025, 6, 1, 20, 0, 0, 0, time.UTC))).To(Equal(time.Hour))
+			Expect(gardener.DurationUntilMaintenanceTimeWindow(tw, time.Date(2025, 6, 1, 21, 30, 0, 0, time.UTC))).To(BeZero())
+		})
+
+		It("should handle maintenance time windows which span midnight", func() {
+			tw := &gardenv1beta1.MaintenanceTimeWindow{Begin: "230000+0000", End: "010000+0000"}
+			Expect(gardener.InMaintenanceTimeWindow(tw, time.Date(2025, 6, 1, 0, 30, 0, 0, time.UTC))).To(BeTrue())
+			Expect(gardener.InMaintenanceTimeWindow(tw, time.Date(2025, 6, 1, 23, 30, 0, 0, time.UTC))).To(BeTrue())
+			Expect(gardener.InMaintenanceTimeWindow(tw, time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))).To(BeFalse())
+			Expect(gardener.DurationUntilMaintenanceTimeWindow(tw, time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))).To(Equal(11 * time.Hour))
+		})
+
+		It("should fail for invalid maintenance time windows", func() {
+			_, err := gardener.InMaintenanceTimeWindow(nil, time.Now())
+			Expect(err).To(HaveOccurred())
+			_, err = gardener.InMaintenanceTimeWindow(&gardenv1beta1.MaintenanceTimeWindow{Begin: "22:00", End: "230000+0100"}, time.Now())
+			Expect(err).To(HaveOccurred())
+		})
+
+	})
+
+	Context("Shoot Conversion", func() {
+
+		flavor := "default/gcp"
+
+		// timeWindow returns a maintenance time window which begins at the given offset from now and lasts two hours.
+		timeWindow := func(offset time.Duration) *gardenv1beta1.Maintenance {
+			begin := time.Now().UTC().Add(offset)
+			return &gardenv1beta1.Maintenance{
+				TimeWindow: &gardenv1beta1.MaintenanceTimeWindow{
+					Begin: begin.Format("150405+0000"),
+					End:   begin.Add(2 * time.Hour).Format("150405+0000"),
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			// replace the versions of the CloudProfile with ones that don't expire during the test
+			cp := &gardenv1beta1.CloudProfile{}
+			cp.SetName("gcp")
+			Expect(env.Client(gardenCluster).Get(env.Ctx, client.ObjectKeyFromObject(cp), cp)).To(Succeed())
+			cp.Spec.Kubernetes.Versions = versions[:5]
+			Expect(env.Client(gardenCluster).Update(env.Ctx, cp)).To(Succeed())
+
+			lscfg := completedDefaultConfigMulti.GardenerConfig.Landscapes["default"].Configurations["gcp"]
+			lscfg.KubernetesVersionPolicy = &apiserverconfig.KubernetesVersionPolicy{Type: apiserverconfig.KubernetesVersionPolicyLatestPatch}
+			completedDefaultConfigMulti.GardenerConfig.Landscapes["default"].Configurations["gcp"] = lscfg
+		})
+
+		It("should use the target version for new shoots", func() {
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			shoot := &gardenv1beta1.Shoot{}
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.31.3"))
+			Expect(gardener.KubernetesVersionStatusFromShoot(shoot)).To(Equal(&openmcpv1alpha1.KubernetesVersionStatus{Current: "1.31.3", Target: "1.31.3"}))
+		})
+
+		It("should upgrade existing shoots during their maintenance time window", func() {
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			shoot := &gardenv1beta1.Shoot{}
+			shoot.Spec.Kubernetes.Version = "1.30.1"
+			shoot.Spec.Maintenance = timeWindow(-time.Hour)
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.30.5"))
+			Expect(gardener.KubernetesVersionStatusFromShoot(shoot)).To(Equal(&openmcpv1alpha1.KubernetesVersionStatus{Current: "1.30.5", Target: "1.30.5"}))
+		})
+
+		It("should defer upgrades of existing shoots outside of their maintenance time window", func() {
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			shoot := &gardenv1beta1.Shoot{}
+			shoot.Spec.Kubernetes.Version = "1.30.1"
+			shoot.Spec.Maintenance = timeWindow(3 * time.Hour)
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.30.1"))
+			Expect(gardener.KubernetesVersionStatusFromShoot(shoot)).To(Equal(&openmcpv1alpha1.KubernetesVersionStatus{Current: "1.30.1", Target: "1.30.5"}))
+		})
+
+		It("should fail with a clear reason if the pinned version is not offered by the CloudProfile", func() {
+			lscfg := completedDefaultConfigMulti.GardenerConfig.Landscapes["default"].Configurations["gcp"]
+			lscfg.KubernetesVersionPolicy = &apiserverconfig.KubernetesVersionPolicy{Type: apiserverconfig.KubernetesVersionPolicyPin, Version: "1.31.9"}
+			completedDefaultConfigMulti.GardenerConfig.Landscapes["default"].Configurations["gcp"] = lscfg
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			shoot := &gardenv1beta1.Shoot{}
+			err := gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)
+			Expect(err).To(MatchError(ContainSubstring("not offered")))
+			var rerr openmcperrors.ReasonableError
+			Expect(errors.As(err, &rerr)).To(BeTrue())
+			Expect(rerr.Reason()).To(Equal(cconst.ReasonKubernetesVersionNotSupported))
+
+			// shoots which already run the pinned version or a higher one are not affected
+			shoot.Spec.Kubernetes.Version = "1.31.9"
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.31.9"))
+		})
+
+		It("should use a pinned version which is offered by the CloudProfile", func() {
+			lscfg := completedDefaultConfigMulti.GardenerConfig.Landscapes["default"].Configurations["gcp"]
+			lscfg.KubernetesVersionPolicy = &apiserverconfig.KubernetesVersionPolicy{Type: apiserverconfig.KubernetesVersionPolicyPin, Version: "1.30.4"}
+			completedDefaultConfigMulti.GardenerConfig.Landscapes["default"].Configurations["gcp"] = lscfg
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			shoot := &gardenv1beta1.Shoot{}
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.30.4"))
+		})
+
+		It("should still apply a higher k8s version overwrite immediately", func() {
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			if apiServer.Spec.Internal == nil {
+				apiServer.Spec.Internal = &openmcpv1alpha1.APIServerInternalConfiguration{}
+			}
+			if apiServer.Spec.Internal.GardenerConfig == nil {
+				apiServer.Spec.Internal.GardenerConfig = &openmcpv1alpha1.GardenerInternalConfiguration{}
+			}
+			apiServer.Spec.Internal.GardenerConfig.K8SVersionOverwrite = "1.31.2"
+			shoot := &gardenv1beta1.Shoot{}
+			shoot.Spec.Kubernetes.Version = "1.30.1"
+			shoot.Spec.Maintenance = timeWindow(3 * time.Hour)
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.31.2"))
+			Expect(gardener.KubernetesVersionStatusFromShoot(shoot)).To(Equal(&openmcpv1alpha1.KubernetesVersionStatus{Current: "1.31.2", Target: "1.31.2"}))
+		})
+
+	})
+
+})
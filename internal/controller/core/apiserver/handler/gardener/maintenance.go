@@ -0,0 +1,232 @@
+package gardener
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	gardenv1beta1 "github.com/openmcp-project/mcp-operator/api/external/gardener/pkg/apis/core/v1beta1"
+	"github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/config"
+)
+
+// setShootMaintenance renders the maintenance configuration from the APIServer spec into the shoot.
+// The time window is checked against the bounds from the given configuration, which may be nil.
+// now is used to determine the current UTC offset of time windows which refer to a time zone.
+// Fields which are not specified in the maintenance configuration are left untouched.
+func setShootMaintenance(log logging.Logger, sh *gardenv1beta1.Shoot, m *openmcpv1alpha1.MaintenanceConfig, bounds *config.MaintenanceConfiguration, now time.Time) error {
+	if m == nil {
+		return nil
+	}
+
+	if m.TimeWindow != nil {
+		tw, err := ConvertMaintenanceTimeWindow(m.TimeWindow, now)
+		if err != nil {
+			return fmt.Errorf("invalid maintenance time window: %w", err)
+		}
+		if err := CheckMaintenanceTimeWindowBounds(tw, bounds); err != nil {
+			return fmt.Errorf("maintenance time window %s is not supported: %w", formatMaintenanceTimeWindow(m.TimeWindow), err)
+		}
+		if sh.Spec.Maintenance == nil {
+			sh.Spec.Maintenance = &gardenv1beta1.Maintenance{}
+		}
+		if sh.Spec.Maintenance.TimeWindow == nil || *sh.Spec.Maintenance.TimeWindow != *tw {
+			log.Debug("Setting shoot.Spec.Maintenance.TimeWindow", "begin", tw.Begin, "end", tw.End)
+			sh.Spec.Maintenance.TimeWindow = tw
+		}
+	}
+
+	if m.AutoUpdate != nil {
+		if sh.Spec.Maintenance == nil {
+			sh.Spec.Maintenance = &gardenv1beta1.Maintenance{}
+		}
+		if sh.Spec.Maintenance.AutoUpdate == nil {
+			sh.Spec.Maintenance.AutoUpdate = &gardenv1beta1.MaintenanceAutoUpdate{
+				KubernetesVersion:   true,
+				MachineImageVersion: ptr.To(true),
+			}
+		}
+		if m.AutoUpdate.KubernetesVersion != nil {
+			log.Debug("Setting shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion", "value", *m.AutoUpdate.KubernetesVersion)
+			sh.Spec.Maintenance.AutoUpdate.KubernetesVersion = *m.AutoUpdate.KubernetesVersion
+		}
+		if m.AutoUpdate.MachineImageVersion != nil {
+			log.Debug("Setting shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion", "value", *m.AutoUpdate.MachineImageVersion)
+			sh.Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(*m.AutoUpdate.MachineImageVersion)
+		}
+	}
+
+	return nil
+}
+
+// MaintenanceValidator validates the maintenance time windows configured in ManagedControlPlanes against the bounds from the APIServer provider configuration.
+// This way, time windows which are not supported are rejected right away, instead of only failing the reconciliation of the APIServer.
+type MaintenanceValidator struct {
+	crateClient client.Reader
+	cfg         *config.MultiGardenerConfiguration
+}
+
+func NewMaintenanceValidator(crateClient client.Reader, cfg *config.MultiGardenerConfiguration) *MaintenanceValidator {
+	return &MaintenanceValidator{
+		crateClient: crateClient,
+		cfg:         cfg,
+	}
+}
+
+// Validate validates the maintenance time window of the given ManagedControlPlane against the bounds of the landscape configuration its APIServer is assigned to.
+// If the APIServer has not been assigned to a landscape configuration yet and scheduling is configured, the bounds of all landscape configurations have to be satisfied.
+// Time windows which didn't change compared to oldMcp are not validated, so that stricter bounds don't block other updates.
+// It can be registered as validator for the ManagedControlPlane webhook.
+func (v *MaintenanceValidator) Validate(ctx context.Context, mcp, oldMcp *openmcpv1alpha1.ManagedControlPlane) field.ErrorList {
+	allErrs := field.ErrorList{}
+	fldPath := field.NewPath("spec", "components", "apiServer", "gardener", "maintenance", "timeWindow")
+	tw := maintenanceTimeWindowFromManagedControlPlane(mcp)
+	if v.cfg == nil || tw == nil {
+		return allErrs
+	}
+	if oldMcp != nil {
+		if oldTw := maintenanceTimeWindowFromManagedControlPlane(oldMcp); oldTw != nil && *oldTw == *tw {
+			return allErrs
+		}
+	}
+	// the UTC offset of a time zone might change during the year, so the time window has to satisfy the bounds in summer and in winter
+	now := time.Now()
+	gtws := make([]*gardenv1beta1.MaintenanceTimeWindow, 0, 2)
+	for _, t := range []time.Time{now, now.AddDate(0, 6, 0)} {
+		gtw, err := ConvertMaintenanceTimeWindow(tw, t)
+		if err != nil {
+			// the format is validated by the APIServer converter
+			return allErrs
+		}
+		if len(gtws) == 0 || *gtws[0] != *gtw {
+			gtws = append(gtws, gtw)
+		}
+	}
+
+	allBounds, defaultConfig := v.cfg.MaintenanceBounds()
+	lcs := []string{defaultConfig}
+	as := &openmcpv1alpha1.APIServer{}
+	if err := v.crateClient.Get(ctx, client.ObjectKeyFromObject(mcp), as); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return append(allErrs, field.InternalError(fldPath, fmt.Errorf("error fetching APIServer: %w", err)))
+		}
+	}
+	if as.Spec.Internal != nil && as.Spec.Internal.GardenerConfig != nil && as.Spec.Internal.GardenerConfig.LandscapeConfiguration != "" {
+		lcs = []string{as.Spec.Internal.GardenerConfig.LandscapeConfiguration}
+	} else if v.cfg.Scheduling != nil {
+		lcs = sets.List(sets.KeySet(allBounds))
+	}
+
+	for _, lc := range lcs {
+		for _, gtw := range gtws {
+			if err := CheckMaintenanceTimeWindowBounds(gtw, allBounds[lc]); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath, formatMaintenanceTimeWindow(tw), fmt.Sprintf("not supported by landscape configuration '%s': %s", lc, err.Error())))
+				break
+			}
+		}
+	}
+	return allErrs
+}
+
+// maintenanceTimeWindowFromManagedControlPlane returns the maintenance time window configured in the given ManagedControlPlane or nil, if it is not set.
+func maintenanceTimeWindowFromManagedControlPlane(mcp *openmcpv1alpha1.ManagedControlPlane) *openmcpv1alpha1.MaintenanceTimeWindow {
+	apiCfg := mcp.Spec.Components.APIServer
+	if apiCfg == nil || apiCfg.GardenerConfig == nil || apiCfg.GardenerConfig.Maintenance == nil {
+		return nil
+	}
+	return apiCfg.GardenerConfig.Maintenance.TimeWindow
+}
+
+// ConvertMaintenanceTimeWindow converts a time window from the APIServer spec into Gardener's format.
+// The time window is converted to UTC. If it refers to a time zone, the offset of the time zone at the given point in time is used,
+// so time windows in time zones with daylight saving time are shifted when the offset changes. Fixed UTC offsets don't depend on the point in time.
+func ConvertMaintenanceTimeWindow(tw *openmcpv1alpha1.MaintenanceTimeWindow, now time.Time) (*gardenv1beta1.MaintenanceTimeWindow, error) {
+	offset, err := tw.Offset(now)
+	if err != nil {
+		return nil, err
+	}
+	begin, err := time.Parse("15:04", tw.Begin)
+	if err != nil {
+		return nil, fmt.Errorf("invalid begin '%s': %w", tw.Begin, err)
+	}
+	end, err := time.Parse("15:04", tw.End)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end '%s': %w", tw.End, err)
+	}
+	toUTC := func(t time.Time) string {
+		return t.Add(-offset).Format("150405+0000")
+	}
+	return &gardenv1beta1.MaintenanceTimeWindow{
+		Begin: toUTC(begin),
+		End:   toUTC(end),
+	}, nil
+}
+
+// CheckMaintenanceTimeWindowBounds returns an error if the given time window violates the given bounds.
+// The bounds may be nil, in which case any time window is accepted.
+func CheckMaintenanceTimeWindowBounds(tw *gardenv1beta1.MaintenanceTimeWindow, bounds *config.MaintenanceConfiguration) error {
+	if bounds == nil {
+		return nil
+	}
+	begin, end, err := parseMaintenanceTimeWindow(tw)
+	if err != nil {
+		return err
+	}
+	if end <= begin {
+		end += 24 * time.Hour
+	}
+	duration := end - begin
+	if bounds.MinDuration != nil && duration < bounds.MinDuration.Duration {
+		return fmt.Errorf("time window must last at least %s", bounds.MinDuration.Duration)
+	}
+	if bounds.MaxDuration != nil && duration > bounds.MaxDuration.Duration {
+		return fmt.Errorf("time window must not last longer than %s", bounds.MaxDuration.Duration)
+	}
+	if len(bounds.AllowedTimeWindows) == 0 {
+		return nil
+	}
+	for _, allowed := range bounds.AllowedTimeWindows {
+		aBegin, err := time.Parse("15:04", allowed.Begin)
+		if err != nil {
+			return fmt.Errorf("invalid begin '%s' of allowed time window: %w", allowed.Begin, err)
+		}
+		aEnd, err := time.Parse("15:04", allowed.End)
+		if err != nil {
+			return fmt.Errorf("invalid end '%s' of allowed time window: %w", allowed.End, err)
+		}
+		ab, ae := timeOfDay(aBegin), timeOfDay(aEnd)
+		if ae <= ab {
+			ae += 24 * time.Hour
+		}
+		// both time windows may span midnight, so the allowed one has to be checked on the previous, the same, and the next day
+		for _, shift := range []time.Duration{-24 * time.Hour, 0, 24 * time.Hour} {
+			if ab+shift <= begin && end <= ae+shift {
+				return nil
+			}
+		}
+	}
+	allowed := make([]string, len(bounds.AllowedTimeWindows))
+	for i, a := range bounds.AllowedTimeWindows {
+		allowed[i] = fmt.Sprintf("%s-%s", a.Begin, a.End)
+	}
+	return fmt.Errorf("time window must lie within one of the supported time windows %v (UTC)", allowed)
+}
+
+// formatMaintenanceTimeWindow returns a human-readable representation of the given time window from the APIServer spec.
+func formatMaintenanceTimeWindow(tw *openmcpv1alpha1.MaintenanceTimeWindow) string {
+	if tw.Location != "" {
+		return fmt.Sprintf("%s-%s (%s)", tw.Begin, tw.End, tw.Location)
+	}
+	offset := tw.UTCOffset
+	if offset == "" {
+		offset = "+00:00"
+	}
+	return fmt.Sprintf("%s-%s (UTC%s)", tw.Begin, tw.End, offset)
+}
@@ -0,0 +1,238 @@
+package gardener_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	gardenv1beta1 "github.com/openmcp-project/mcp-operator/api/external/gardener/pkg/apis/core/v1beta1"
+	apiserverconfig "github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/config"
+	"github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/handler/gardener"
+	testutils "github.com/openmcp-project/mcp-operator/test/utils"
+)
+
+var _ = Describe("Maintenance", func() {
+
+	Context("ConvertMaintenanceTimeWindow", func() {
+
+		winter := time.Date(2026, time.January, 15, 12, 0, 0, 0, time.UTC)
+		summer := time.Date(2026, time.July, 15, 12, 0, 0, 0, time.UTC)
+
+		It("should convert a time window without UTC offset", func() {
+			tw, err := gardener.ConvertMaintenanceTimeWindow(&openmcpv1alpha1.MaintenanceTimeWindow{Begin: "22:00", End: "23:30"}, winter)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tw).To(Equal(&gardenv1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "233000+0000"}))
+		})
+
+		It("should convert a time window into UTC based on its UTC offset", func() {
+			tw, err := gardener.ConvertMaintenanceTimeWindow(&openmcpv1alpha1.MaintenanceTimeWindow{Begin: "01:00", End: "03:00", UTCOffset: "+02:00"}, winter)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tw).To(Equal(&gardenv1beta1.MaintenanceTimeWindow{Begin: "230000+0000", End: "010000+0000"}))
+			tw, err = gardener.ConvertMaintenanceTimeWindow(&openmcpv1alpha1.MaintenanceTimeWindow{Begin: "22:30", End: "23:30", UTCOffset: "-05:30"}, winter)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tw).To(Equal(&gardenv1beta1.MaintenanceTimeWindow{Begin: "040000+0000", End: "050000+0000"}))
+		})
+
+		It("should fail for an invalid UTC offset", func() {
+			_, err := gardener.ConvertMaintenanceTimeWindow(&openmcpv1alpha1.MaintenanceTimeWindow{Begin: "01:00", End: "03:00", UTCOffset: "Europe/Berlin"}, winter)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should convert a time window into UTC based on the current offset of its time zone", func() {
+			tw, err := gardener.ConvertMaintenanceTimeWindow(&openmcpv1alpha1.MaintenanceTimeWindow{Begin: "01:00", End: "03:00", Location: "Europe/Berlin"}, winter)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tw).To(Equal(&gardenv1beta1.MaintenanceTimeWindow{Begin: "000000+0000", End: "020000+0000"}))
+			tw, err = gardener.ConvertMaintenanceTimeWindow(&openmcpv1alpha1.MaintenanceTimeWindow{Begin: "01:00", End: "03:00", Location: "Europe/Berlin"}, summer)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tw).To(Equal(&gardenv1beta1.MaintenanceTimeWindow{Begin: "230000+0000", End: "010000+0000"}))
+		})
+
+		It("should fail for an unknown time zone or if both UTC offset and time zone are set", func() {
+			_, err := gardener.ConvertMaintenanceTimeWindow(&openmcpv1alpha1.MaintenanceTimeWindow{Begin: "01:00", End: "03:00", Location: "Europe/Nowhere"}, winter)
+			Expect(err).To(HaveOccurred())
+			_, err = gardener.ConvertMaintenanceTimeWindow(&openmcpv1alpha1.MaintenanceTimeWindow{Begin: "01:00", End: "03:00", UTCOffset: "+01:00", Location: "Europe/Berlin"}, winter)
+			Expect(err).To(HaveOccurred())
+		})
+
+	})
+
+	Context("CheckMaintenanceTimeWindowBounds", func() {
+
+		bounds := &apiserverconfig.MaintenanceConfiguration{
+			AllowedTimeWindows: []apiserverconfig.MaintenanceTimeWindowConfiguration{
+				{Begin: "20:00", End: "04:00"},
+				{Begin: "12:00", End: "13:00"},
+			},
+			MinDuration: &metav1.Duration{Duration: time.Hour},
+			MaxDuration: &metav1.Duration{Duration: 4 * time.Hour},
+		}
+
+		It("should accept any time window if no bounds are configured", func() {
+			Expect(gardener.CheckMaintenanceTimeWindowBounds(&gardenv1beta1.MaintenanceTimeWindow{Begin: "080000+0000", End: "083000+0000"}, nil)).To(Succeed())
+		})
+
+		It("should accept time windows within the allowed time windows", func() {
+			Expect(gardener.CheckMaintenanceTimeWindowBounds(&gardenv1beta1.MaintenanceTimeWindow{Begin: "200000+0000", End: "220000+0000"}, bounds)).To(Succeed())
+			Expect(gardener.CheckMaintenanceTimeWindowBounds(&gardenv1beta1.MaintenanceTimeWindow{Begin: "230000+0000", End: "010000+0000"}, bounds)).To(Succeed())
+			Expect(gardener.CheckMaintenanceTimeWindowBounds(&gardenv1beta1.MaintenanceTimeWindow{Begin: "010000+0000", End: "040000+0000"}, bounds)).To(Succeed())
+			Expect(gardener.CheckMaintenanceTimeWindowBounds(&gardenv1beta1.MaintenanceTimeWindow{Begin: "120000+0000", End: "130000+0000"}, bounds)).To(Succeed())
+		})
+
+		It("should reject time windows outside of the allowed time windows", func() {
+			Expect(gardener.CheckMaintenanceTimeWindowBounds(&gardenv1beta1.MaintenanceTimeWindow{Begin: "030000+0000", End: "050000+0000"}, bounds)).To(MatchError(ContainSubstring("supported time windows")))
+			Expect(gardener.CheckMaintenanceTimeWindowBounds(&gardenv1beta1.MaintenanceTimeWindow{Begin: "123000+0000", End: "133000+0000"}, bounds)).To(MatchError(ContainSubstring("supported time windows")))
+		})
+
+		It("should reject time windows which are too short or too long", func() {
+			Expect(gardener.CheckMaintenanceTimeWindowBounds(&gardenv1beta1.MaintenanceTimeWindow{Begin: "200000+0000", End: "203000+0000"}, bounds)).To(MatchError(ContainSubstring("at least")))
+			Expect(gardener.CheckMaintenanceTimeWindowBounds(&gardenv1beta1.MaintenanceTimeWindow{Begin: "200000+0000", End: "010000+0000"}, bounds)).To(MatchError(ContainSubstring("longer than")))
+		})
+
+	})
+
+	Context("MaintenanceValidator", func() {
+
+		var cfg *apiserverconfig.MultiGardenerConfiguration
+
+		mcpWithTimeWindow := func(begin, end string) *openmcpv1alpha1.ManagedControlPlane {
+			return &openmcpv1alpha1.ManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+				Spec: openmcpv1alpha1.ManagedControlPlaneSpec{
+					Components: openmcpv1alpha1.ManagedControlPlaneComponents{
+						APIServer: &openmcpv1alpha1.APIServerConfiguration{
+							Type: openmcpv1alpha1.Gardener,
+							GardenerConfig: &openmcpv1alpha1.GardenerConfiguration{
+								Maintenance: &openmcpv1alpha1.MaintenanceConfig{
+									TimeWindow: &openmcpv1alpha1.MaintenanceTimeWindow{Begin: begin, End: end},
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			cfg = &apiserverconfig.MultiGardenerConfiguration{
+				DefaultLandscapeAndConfiguration: "default/gcp",
+				Landscapes: []apiserverconfig.GardenerLandscape{
+					{
+						Name: "default",
+						Configurations: []apiserverconfig.GardenerConfiguration{
+							{Name: "gcp", Maintenance: &apiserverconfig.MaintenanceConfiguration{AllowedTimeWindows: []apiserverconfig.MaintenanceTimeWindowConfiguration{{Begin: "20:00", End: "04:00"}}}},
+							{Name: "aws", Maintenance: &apiserverconfig.MaintenanceConfiguration{AllowedTimeWindows: []apiserverconfig.MaintenanceTimeWindowConfiguration{{Begin: "00:00", End: "06:00"}}}},
+						},
+					},
+				},
+			}
+		})
+
+		It("should validate the time window against the default landscape configuration if no scheduling is configured", func() {
+			v := gardener.NewMaintenanceValidator(fake.NewClientBuilder().WithScheme(testutils.Scheme).Build(), cfg)
+			Expect(v.Validate(env.Ctx, mcpWithTimeWindow("21:00", "22:00"), nil)).To(BeEmpty())
+			errs := v.Validate(env.Ctx, mcpWithTimeWindow("05:00", "06:00"), nil)
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.components.apiServer.gardener.maintenance.timeWindow"))
+			Expect(errs[0].Detail).To(ContainSubstring("default/gcp"))
+		})
+
+		It("should validate time windows in a time zone with daylight saving time for both UTC offsets", func() {
+			v := gardener.NewMaintenanceValidator(fake.NewClientBuilder().WithScheme(testutils.Scheme).Build(), cfg)
+			mcp := mcpWithTimeWindow("22:00", "23:00")
+			mcp.Spec.Components.APIServer.GardenerConfig.Maintenance.TimeWindow.Location = "Europe/Berlin"
+			Expect(v.Validate(env.Ctx, mcp, nil)).To(BeEmpty())
+			// 20:30-21:00 UTC in winter, but 19:30-20:00 UTC in summer
+			mcp = mcpWithTimeWindow("21:30", "22:00")
+			mcp.Spec.Components.APIServer.GardenerConfig.Maintenance.TimeWindow.Location = "Europe/Berlin"
+			errs := v.Validate(env.Ctx, mcp, nil)
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].BadValue).To(Equal("21:30-22:00 (Europe/Berlin)"))
+		})
+
+		It("should validate the time window against all landscape configurations if the APIServer has not been scheduled yet", func() {
+			cfg.Scheduling = &apiserverconfig.SchedulingConfiguration{}
+			v := gardener.NewMaintenanceValidator(fake.NewClientBuilder().WithScheme(testutils.Scheme).Build(), cfg)
+			Expect(v.Validate(env.Ctx, mcpWithTimeWindow("01:00", "02:00"), nil)).To(BeEmpty())
+			errs := v.Validate(env.Ctx, mcpWithTimeWindow("21:00", "22:00"), nil)
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Detail).To(ContainSubstring("default/aws"))
+		})
+
+		It("should validate the time window against the landscape configuration the APIServer is assigned to", func() {
+			cfg.Scheduling = &apiserverconfig.SchedulingConfiguration{}
+			as := &openmcpv1alpha1.APIServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+				Spec: openmcpv1alpha1.APIServerSpec{
+					APIServerConfiguration: openmcpv1alpha1.APIServerConfiguration{Type: openmcpv1alpha1.Gardener},
+					Internal: &openmcpv1alpha1.APIServerInternalConfiguration{
+						GardenerConfig: &openmcpv1alpha1.GardenerInternalConfiguration{LandscapeConfiguration: "default/aws"},
+					},
+				},
+			}
+			v := gardener.NewMaintenanceValidator(fake.NewClientBuilder().WithScheme(testutils.Scheme).WithObjects(as).Build(), cfg)
+			Expect(v.Validate(env.Ctx, mcpWithTimeWindow("05:00", "06:00"), nil)).To(BeEmpty())
+			Expect(v.Validate(env.Ctx, mcpWithTimeWindow("21:00", "22:00"), nil)).To(HaveLen(1))
+		})
+
+		It("should not validate unchanged time windows", func() {
+			v := gardener.NewMaintenanceValidator(fake.NewClientBuilder().WithScheme(testutils.Scheme).Build(), cfg)
+			mcp := mcpWithTimeWindow("05:00", "06:00")
+			Expect(v.Validate(env.Ctx, mcp, mcp.DeepCopy())).To(BeEmpty())
+			Expect(v.Validate(env.Ctx, mcp, mcpWithTimeWindow("21:00", "22:00"))).To(HaveLen(1))
+		})
+
+	})
+
+	Context("Shoot Conversion", func() {
+
+		flavor := "default/gcp"
+
+		It("should render the maintenance configuration into the shoot", func() {
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			apiServer.Spec.GardenerConfig = &openmcpv1alpha1.GardenerConfiguration{
+				Maintenance: &openmcpv1alpha1.MaintenanceConfig{
+					TimeWindow: &openmcpv1alpha1.MaintenanceTimeWindow{Begin: "22:00", End: "23:00"},
+					AutoUpdate: &openmcpv1alpha1.MaintenanceAutoUpdate{KubernetesVersion: ptr.To(false)},
+				},
+			}
+			shoot := &gardenv1beta1.Shoot{}
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Maintenance).ToNot(BeNil())
+			Expect(shoot.Spec.Maintenance.TimeWindow).To(Equal(&gardenv1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"}))
+			Expect(shoot.Spec.Maintenance.AutoUpdate).To(Equal(&gardenv1beta1.MaintenanceAutoUpdate{KubernetesVersion: false, MachineImageVersion: ptr.To(true)}))
+		})
+
+		It("should not touch the shoot's maintenance configuration if none is specified", func() {
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			shoot := &gardenv1beta1.Shoot{}
+			existing := &gardenv1beta1.Maintenance{TimeWindow: &gardenv1beta1.MaintenanceTimeWindow{Begin: "030000+0100", End: "040000+0100"}}
+			shoot.Spec.Maintenance = existing.DeepCopy()
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(Succeed())
+			Expect(shoot.Spec.Maintenance).To(Equal(existing))
+		})
+
+		It("should reject a time window which violates the configured bounds", func() {
+			lscfg := completedDefaultConfigMulti.GardenerConfig.Landscapes["default"].Configurations["gcp"]
+			lscfg.Maintenance = &apiserverconfig.MaintenanceConfiguration{
+				AllowedTimeWindows: []apiserverconfig.MaintenanceTimeWindowConfiguration{{Begin: "00:00", End: "04:00"}},
+			}
+			completedDefaultConfigMulti.GardenerConfig.Landscapes["default"].Configurations["gcp"] = lscfg
+
+			gc, apiServer := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, flavor, "testdata", "conversion", "apiserver-01.yaml")
+			apiServer.Spec.GardenerConfig = &openmcpv1alpha1.GardenerConfiguration{
+				Maintenance: &openmcpv1alpha1.MaintenanceConfig{
+					TimeWindow: &openmcpv1alpha1.MaintenanceTimeWindow{Begin: "22:00", End: "23:00"},
+				},
+			}
+			shoot := &gardenv1beta1.Shoot{}
+			Expect(gc.Shoot_v1beta1_from_APIServer_v1alpha1(env.Ctx, apiServer, shoot)).To(MatchError(ContainSubstring("is not supported")))
+		})
+
+	})
+
+})
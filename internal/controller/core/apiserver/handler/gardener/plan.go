@@ -0,0 +1,92 @@
+package gardener
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openmcp-project/mcp-operator/internal/components"
+	apiserverhandler "github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/handler"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	gardenv1beta1 "github.com/openmcp-project/mcp-operator/api/external/gardener/pkg/apis/core/v1beta1"
+)
+
+var _ apiserverhandler.APIServerPlanner = &GardenerConnector{}
+
+// PlanCreateOrUpdate implements apiserverhandler.APIServerPlanner.
+// It computes the shoot which HandleCreateOrUpdate would write and compares it to the existing one, if any.
+// The audit log resources are not part of the plan.
+func (gc *GardenerConnector) PlanCreateOrUpdate(ctx context.Context, as *openmcpv1alpha1.APIServer) ([]components.DownstreamChange, error) {
+	as = as.DeepCopy()
+
+	// determine the landscape configuration the same way scheduleLandscapeConfiguration would, but without writing it into the APIServer
+	if gc.Scheduler != nil && (as.Spec.Internal == nil || as.Spec.Internal.GardenerConfig == nil || as.Spec.Internal.GardenerConfig.LandscapeConfiguration == "") {
+		sh, errr := gc.GetShoot(ctx, as, false)
+		if errr != nil {
+			return nil, fmt.Errorf("error checking for existing shoot before scheduling: %w", errr)
+		}
+		lc := gc.DefaultLandscapeConfiguration()
+		if sh == nil {
+			var err error
+			lc, err = gc.Scheduler.Schedule(ctx, as)
+			if err != nil {
+				return nil, fmt.Errorf("error scheduling APIServer to a landscape configuration: %w", err)
+			}
+		}
+		if as.Spec.Internal == nil {
+			as.Spec.Internal = &openmcpv1alpha1.APIServerInternalConfiguration{}
+		}
+		if as.Spec.Internal.GardenerConfig == nil {
+			as.Spec.Internal.GardenerConfig = &openmcpv1alpha1.GardenerInternalConfiguration{}
+		}
+		as.Spec.Internal.GardenerConfig.LandscapeConfiguration = lc
+	}
+
+	lc := ""
+	if as.Spec.Internal != nil && as.Spec.Internal.GardenerConfig != nil {
+		lc = as.Spec.Internal.GardenerConfig.LandscapeConfiguration
+	}
+	_, gcfg, err := gc.LandscapeConfiguration(lc)
+	if err != nil {
+		return nil, err
+	}
+
+	live, errr := gc.GetShoot(ctx, as, false)
+	if errr != nil {
+		return nil, fmt.Errorf("error checking for corresponding shoot: %w", errr)
+	}
+	desired := &gardenv1beta1.Shoot{}
+	if live != nil {
+		desired = live.DeepCopy()
+	}
+	if err := gc.Shoot_v1beta1_from_APIServer_v1alpha1(ctx, as, desired); err != nil {
+		return nil, fmt.Errorf("error computing desired shoot: %w", err)
+	}
+	desiredData, err := comparableShootData(desired)
+	if err != nil {
+		return nil, fmt.Errorf("error converting desired shoot: %w", err)
+	}
+
+	change := components.DownstreamChange{
+		Kind:    "Shoot",
+		Name:    client.ObjectKeyFromObject(desired).String(),
+		Desired: desiredData,
+	}
+	if live == nil {
+		change.Action = components.PlanActionCreate
+		change.Message = fmt.Sprintf("Shoot would be created using landscape configuration '%s'.", lc)
+		return []components.DownstreamChange{change}, nil
+	}
+	drifted, err := ShootDrift(live, desired, gcfg.DriftIgnorePaths)
+	if err != nil {
+		return nil, fmt.Errorf("error comparing shoot with its desired state: %w", err)
+	}
+	change.ChangedFields = drifted
+	change.Action = components.PlanActionNone
+	if len(drifted) > 0 {
+		change.Action = components.PlanActionUpdate
+	}
+	return []components.DownstreamChange{change}, nil
+}
@@ -0,0 +1,68 @@
+package gardener_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	gardenv1beta1 "github.com/openmcp-project/mcp-operator/api/external/gardener/pkg/apis/core/v1beta1"
+	"github.com/openmcp-project/mcp-operator/internal/components"
+)
+
+var _ = Describe("Plan", func() {
+
+	It("should plan the creation of a shoot without creating it", func() {
+		gc, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "", "testdata", "connector", "apiserver-03.yaml")
+		changes, err := gc.PlanCreateOrUpdate(env.Ctx, as)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Kind":    Equal("Shoot"),
+			"Action":  Equal(components.PlanActionCreate),
+			"Desired": HaveKey("spec"),
+		})))
+
+		shoots := &gardenv1beta1.ShootList{}
+		Expect(env.Client(gardenCluster).List(env.Ctx, shoots, client.MatchingLabels{
+			openmcpv1alpha1.ManagedControlPlaneBackReferenceLabelName:      as.Name,
+			openmcpv1alpha1.ManagedControlPlaneBackReferenceLabelNamespace: as.Namespace,
+		})).To(Succeed())
+		Expect(shoots.Items).To(BeEmpty())
+	})
+
+	It("should report the fields which would be changed on an existing shoot without changing them", func() {
+		gc, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "", "testdata", "connector", "apiserver-04.yaml")
+		_, _, _, err := gc.HandleCreateOrUpdate(env.Ctx, as, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		changes, perr := gc.PlanCreateOrUpdate(env.Ctx, as)
+		Expect(perr).ToNot(HaveOccurred())
+		Expect(changes).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Kind":          Equal("Shoot"),
+			"Name":          Equal("garden-test/test"),
+			"Action":        Equal(components.PlanActionNone),
+			"ChangedFields": BeEmpty(),
+		})))
+
+		// remove an enforced annotation by hand
+		sh := &gardenv1beta1.Shoot{}
+		Expect(env.Client(gardenCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "garden-test"}, sh)).To(Succeed())
+		old := sh.DeepCopy()
+		delete(sh.Annotations, "shoot.gardener.cloud/cleanup-extended-apis-finalize-grace-period-seconds")
+		Expect(env.Client(gardenCluster).Patch(env.Ctx, sh, client.MergeFrom(old))).To(Succeed())
+		rv := sh.GetResourceVersion()
+
+		changes, perr = gc.PlanCreateOrUpdate(env.Ctx, as)
+		Expect(perr).ToNot(HaveOccurred())
+		Expect(changes).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Action":        Equal(components.PlanActionUpdate),
+			"ChangedFields": ConsistOf("metadata.annotations[shoot.gardener.cloud/cleanup-extended-apis-finalize-grace-period-seconds]"),
+		})))
+		Expect(env.Client(gardenCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "garden-test"}, sh)).To(Succeed())
+		Expect(sh.GetResourceVersion()).To(Equal(rv))
+		Expect(sh.Annotations).ToNot(HaveKey("shoot.gardener.cloud/cleanup-extended-apis-finalize-grace-period-seconds"))
+	})
+
+})
@@ -0,0 +1,76 @@
+package gardener
+
+import (
+	"context"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	gardenv1beta1 "github.com/openmcp-project/mcp-operator/api/external/gardener/pkg/apis/core/v1beta1"
+	"github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/config"
+)
+
+// countShootsPerRegion counts the shoots belonging to ManagedControlPlanes in the given namespace, grouped by region.
+// Shoots are identified by the ManagedControlPlane back-reference label.
+func countShootsPerRegion(ctx context.Context, c client.Client, namespace string) (map[string]int, error) {
+	shoots := &gardenv1beta1.ShootList{}
+	if err := c.List(ctx, shoots, client.InNamespace(namespace), client.HasLabels{openmcpv1alpha1.ManagedControlPlaneBackReferenceLabelName}); err != nil {
+		return nil, err
+	}
+	res := map[string]int{}
+	for _, sh := range shoots.Items {
+		res[sh.Spec.Region]++
+	}
+	return res, nil
+}
+
+// regionsWithCapacity returns the names of all valid regions which can accept another shoot via desiredRegion resolution.
+// Regions with a weight of 0 and regions which reached their maximum number of shoots are excluded.
+func regionsWithCapacity(gcfg *config.CompletedGardenerConfiguration, shootCount map[string]int) []string {
+	res := make([]string, 0, len(gcfg.ValidRegions))
+	for name := range gcfg.ValidRegions {
+		if gcfg.RegionConfigurations[name].HasCapacity(shootCount[name]) {
+			res = append(res, name)
+		}
+	}
+	slices.Sort(res)
+	return res
+}
+
+// selectRegion chooses one of the given candidate regions for a new shoot.
+// The region with the lowest load is chosen, where load is the number of shoots the region would have with the new shoot, divided by its weight.
+// This distributes the shoots proportionally to the weights of the regions.
+// Ties are broken deterministically using the given hash value, so that APIServers without any existing shoots are still spread across all candidates.
+// Candidates must have a positive weight. Returns an empty string if there are no candidates.
+func selectRegion(candidates []string, gcfg *config.CompletedGardenerConfiguration, shootCount map[string]int, h int) string {
+	if len(candidates) == 0 {
+		return ""
+	}
+	candidates = slices.Sorted(slices.Values(candidates))
+
+	// compare loads as fractions to avoid floating point issues: (count_a+1)/weight_a < (count_b+1)/weight_b <=> (count_a+1)*weight_b < (count_b+1)*weight_a
+	compareLoad := func(a, b string) int {
+		la := int64(shootCount[a]+1) * int64(gcfg.RegionConfigurations[b].GetWeight())
+		lb := int64(shootCount[b]+1) * int64(gcfg.RegionConfigurations[a].GetWeight())
+		switch {
+		case la < lb:
+			return -1
+		case la > lb:
+			return 1
+		}
+		return 0
+	}
+
+	leastLoaded := []string{candidates[0]}
+	for _, c := range candidates[1:] {
+		switch compareLoad(c, leastLoaded[0]) {
+		case -1:
+			leastLoaded = []string{c}
+		case 0:
+			leastLoaded = append(leastLoaded, c)
+		}
+	}
+
+	return leastLoaded[h%len(leastLoaded)]
+}
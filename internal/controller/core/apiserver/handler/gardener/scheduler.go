@@ -0,0 +1,133 @@
+package gardener
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	"github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/config"
+	"github.com/openmcp-project/mcp-operator/internal/utils/region"
+)
+
+// LandscapeScheduler chooses the Gardener landscape configuration for APIServers which don't specify one.
+type LandscapeScheduler interface {
+	// Schedule returns the landscape configuration which should be used for the given APIServer.
+	// The result is expected to follow the format '<landscape-name>/<config-name>'.
+	Schedule(ctx context.Context, as *openmcpv1alpha1.APIServer) (string, error)
+}
+
+var _ LandscapeScheduler = &DefaultLandscapeScheduler{}
+
+// DefaultLandscapeScheduler is the default implementation of the LandscapeScheduler interface.
+// It determines the candidate landscape configurations using the scheduling rules and then chooses among them based on the APIServer's region preferences:
+//   - If the APIServer specifies an explicit region, the first candidate which supports this region is chosen.
+//   - If the APIServer specifies a desired region, the candidate whose regions are closest to it is chosen.
+//   - Otherwise, the first candidate is chosen.
+//
+// The candidates of a matching scheduling rule keep the order of the rule. If no rule matches, the default landscape configuration is the first candidate.
+type DefaultLandscapeScheduler struct {
+	cfg *config.CompletedMultiGardenerConfiguration
+}
+
+// NewDefaultLandscapeScheduler creates a new DefaultLandscapeScheduler for the given configuration.
+func NewDefaultLandscapeScheduler(cfg *config.CompletedMultiGardenerConfiguration) *DefaultLandscapeScheduler {
+	return &DefaultLandscapeScheduler{cfg: cfg}
+}
+
+// Schedule implements LandscapeScheduler.
+func (s *DefaultLandscapeScheduler) Schedule(ctx context.Context, as *openmcpv1alpha1.APIServer) (string, error) {
+	log := logging.FromContextOrPanic(ctx).WithName("LandscapeScheduler")
+
+	candidates := s.candidates(as)
+	log.Debug("Determined candidate landscape configurations", "candidates", candidates)
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("no landscape configuration is available for APIServer '%s/%s'", as.Namespace, as.Name)
+	}
+
+	// explicit region
+	if as.Spec.GardenerConfig != nil && as.Spec.GardenerConfig.Region != "" {
+		for _, lc := range candidates {
+			_, gcfg, err := s.cfg.LandscapeConfiguration(lc)
+			if err != nil {
+				return "", err
+			}
+			if _, ok := gcfg.ValidRegions[as.Spec.GardenerConfig.Region]; ok {
+				log.Debug("Chose landscape configuration supporting the explicitly requested region", "landscapeConfiguration", lc, "region", as.Spec.GardenerConfig.Region)
+				return lc, nil
+			}
+		}
+		return "", fmt.Errorf("none of the landscape configurations [%v] supports region '%s'", candidates, as.Spec.GardenerConfig.Region)
+	}
+
+	// desired region
+	if as.Spec.DesiredRegion != nil && as.Spec.DesiredRegion.Name != "" {
+		dr := as.Spec.DesiredRegion.DeepCopy()
+		if dr.Direction == "" {
+			dr.Direction = openmcpv1alpha1.CENTRAL
+		}
+		best := ""
+		bestDistance := -1
+		for _, lc := range candidates {
+			_, gcfg, err := s.cfg.LandscapeConfiguration(lc)
+			if err != nil {
+				return "", err
+			}
+			if gcfg.RegionMapper == nil {
+				continue
+			}
+			regions, distance, err := region.GetClosestRegionsWithDistance(*dr, gcfg.RegionMapper, sets.KeySet(gcfg.ValidRegions).UnsortedList(), true)
+			if err != nil {
+				log.Error(err, "error finding closest regions", "landscapeConfiguration", lc, "region", dr.Name, "direction", dr.Direction)
+				continue
+			}
+			if len(regions) > 0 && (bestDistance < 0 || distance < bestDistance) {
+				best = lc
+				bestDistance = distance
+			}
+		}
+		if best != "" {
+			log.Debug("Chose landscape configuration closest to the desired region", "landscapeConfiguration", best, "desiredRegion", dr.String(), "distance", bestDistance)
+			return best, nil
+		}
+		log.Debug("None of the candidate landscape configurations can resolve the desired region", "desiredRegion", dr.String())
+	}
+
+	log.Debug("Chose first candidate landscape configuration", "landscapeConfiguration", candidates[0])
+	return candidates[0], nil
+}
+
+// candidates returns the candidate landscape configurations for the given APIServer, ordered by preference.
+func (s *DefaultLandscapeScheduler) candidates(as *openmcpv1alpha1.APIServer) []string {
+	var res []string
+	matched := false
+	if s.cfg.Scheduling != nil {
+		for _, rule := range s.cfg.Scheduling.Rules {
+			if rule.Selector.Matches(labels.Set(as.GetLabels())) {
+				res = slices.Clone(rule.Configurations)
+				matched = true
+				break
+			}
+		}
+	}
+	if !matched {
+		for lsName, ls := range s.cfg.Landscapes {
+			for cfgName := range ls.Configurations {
+				res = append(res, config.CombinedName(lsName, cfgName))
+			}
+		}
+		slices.Sort(res)
+
+		// move the default configuration to the front
+		def := s.cfg.DefaultLandscapeConfiguration()
+		if idx := slices.Index(res, def); idx > 0 {
+			res = slices.Concat([]string{def}, slices.Delete(res, idx, idx+1))
+		}
+	}
+
+	return res
+}
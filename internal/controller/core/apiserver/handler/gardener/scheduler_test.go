@@ -0,0 +1,136 @@
+package gardener_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	apiserverconfig "github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/config"
+	"github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/handler/gardener"
+	testutils "github.com/openmcp-project/mcp-operator/test/utils"
+)
+
+var _ = Describe("Landscape Scheduler", func() {
+
+	var scheduler *gardener.DefaultLandscapeScheduler
+
+	BeforeEach(func() {
+		completedDefaultConfigMulti.GardenerConfig.Scheduling = &apiserverconfig.CompletedSchedulingConfiguration{
+			Rules: []apiserverconfig.CompletedSchedulingRule{
+				{
+					Selector:       labels.SelectorFromSet(labels.Set{openmcpv1alpha1.ManagedControlPlaneBackReferenceLabelProject: "foo"}),
+					Configurations: []string{"extra/bar", "extra/foo"},
+				},
+				{
+					Selector:       labels.SelectorFromSet(labels.Set{openmcpv1alpha1.ManagedControlPlaneBackReferenceLabelWorkspace: "hyperscaler"}),
+					Configurations: []string{"default/aws", "default/azure"},
+				},
+			},
+		}
+		scheduler = gardener.NewDefaultLandscapeScheduler(completedDefaultConfigMulti.GardenerConfig)
+	})
+
+	AfterEach(func() {
+		completedDefaultConfigMulti.GardenerConfig.Scheduling = nil
+	})
+
+	It("should choose the default configuration if nothing else is specified", func() {
+		_, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "", "testdata", "connector", "apiserver-03.yaml")
+		as.Spec.DesiredRegion = nil
+		lc, err := scheduler.Schedule(env.Ctx, as)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lc).To(Equal("default/gcp"))
+	})
+
+	It("should choose a configuration which supports the explicitly requested region", func() {
+		_, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "", "testdata", "connector", "apiserver-03.yaml")
+		as.Spec.GardenerConfig = &openmcpv1alpha1.GardenerConfiguration{Region: "eu-de-1"}
+		lc, err := scheduler.Schedule(env.Ctx, as)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lc).To(Equal("default/openstack"))
+	})
+
+	It("should fail if no candidate supports the explicitly requested region", func() {
+		_, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "", "testdata", "connector", "apiserver-03.yaml")
+		as.Labels[openmcpv1alpha1.ManagedControlPlaneBackReferenceLabelProject] = "foo"
+		as.Spec.GardenerConfig = &openmcpv1alpha1.GardenerConfiguration{Region: "eu-de-1"}
+		_, err := scheduler.Schedule(env.Ctx, as)
+		Expect(err).To(MatchError(ContainSubstring("eu-de-1")))
+	})
+
+	It("should choose the configuration closest to the desired region", func() {
+		_, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "", "testdata", "connector", "apiserver-03.yaml")
+		as.Labels[openmcpv1alpha1.ManagedControlPlaneBackReferenceLabelWorkspace] = "hyperscaler"
+		as.Spec.DesiredRegion = &openmcpv1alpha1.RegionSpecification{Name: openmcpv1alpha1.ASIA, Direction: openmcpv1alpha1.SOUTH}
+		lc, err := scheduler.Schedule(env.Ctx, as)
+		Expect(err).ToNot(HaveOccurred())
+		// only the aws configuration has a region in asia
+		Expect(lc).To(Equal("default/aws"))
+	})
+
+	It("should respect the order of the configurations in the first matching rule", func() {
+		_, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "", "testdata", "connector", "apiserver-03.yaml")
+		as.Labels[openmcpv1alpha1.ManagedControlPlaneBackReferenceLabelProject] = "foo"
+		as.Labels[openmcpv1alpha1.ManagedControlPlaneBackReferenceLabelWorkspace] = "hyperscaler"
+		// both configurations contain europe-west1
+		lc, err := scheduler.Schedule(env.Ctx, as)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lc).To(Equal("extra/bar"))
+	})
+
+	It("should keep the order of the matching rule, even if the default configuration is listed later", func() {
+		completedDefaultConfigMulti.GardenerConfig.Scheduling.Rules[1].Configurations = []string{"default/aws", "default/gcp"}
+		_, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "", "testdata", "connector", "apiserver-03.yaml")
+		as.Labels[openmcpv1alpha1.ManagedControlPlaneBackReferenceLabelWorkspace] = "hyperscaler"
+		as.Spec.DesiredRegion = nil
+		lc, err := scheduler.Schedule(env.Ctx, as)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lc).To(Equal("default/aws"))
+	})
+
+	Context("GardenerConnector", func() {
+
+		It("should write the scheduled landscape configuration into the APIServer spec", func() {
+			gc, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "", "testdata", "connector", "apiserver-03.yaml")
+			Expect(gc.Scheduler).ToNot(BeNil())
+			as.Spec.GardenerConfig = &openmcpv1alpha1.GardenerConfiguration{Region: "eu-de-1"}
+			crateClient := env.Client(testutils.CrateCluster)
+			Expect(crateClient.Create(env.Ctx, as)).To(Succeed())
+
+			_, _, _, err := gc.HandleCreateOrUpdate(env.Ctx, as, crateClient)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(crateClient.Get(env.Ctx, client.ObjectKeyFromObject(as), as)).To(Succeed())
+			Expect(as.Spec.Internal.GardenerConfig.LandscapeConfiguration).To(Equal("default/openstack"))
+		})
+
+		It("should assign the default landscape configuration to APIServers with an existing shoot", func() {
+			gc, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "", "testdata", "connector", "apiserver-04.yaml")
+			as.Spec.GardenerConfig = &openmcpv1alpha1.GardenerConfiguration{Region: "eu-de-1"}
+			crateClient := env.Client(testutils.CrateCluster)
+			Expect(crateClient.Create(env.Ctx, as)).To(Succeed())
+
+			_, _, _, err := gc.HandleCreateOrUpdate(env.Ctx, as, crateClient)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(crateClient.Get(env.Ctx, client.ObjectKeyFromObject(as), as)).To(Succeed())
+			Expect(as.Spec.Internal.GardenerConfig.LandscapeConfiguration).To(Equal("default/gcp"))
+		})
+
+		It("should not schedule APIServers which already specify a landscape configuration", func() {
+			gc, as := initGardenerHandlerTestMulti(openmcpv1alpha1.Gardener, "extra/foo", "testdata", "connector", "apiserver-03.yaml")
+			as.Spec.GardenerConfig = &openmcpv1alpha1.GardenerConfiguration{Region: "eu-de-1"}
+			crateClient := env.Client(testutils.CrateCluster)
+			Expect(crateClient.Create(env.Ctx, as)).To(Succeed())
+
+			_, _, _, _ = gc.HandleCreateOrUpdate(env.Ctx, as, crateClient)
+
+			Expect(crateClient.Get(env.Ctx, client.ObjectKeyFromObject(as), as)).To(Succeed())
+			Expect(as.Spec.Internal.GardenerConfig.LandscapeConfiguration).To(Equal("extra/foo"))
+		})
+
+	})
+
+})
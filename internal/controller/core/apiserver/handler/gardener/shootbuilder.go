@@ -10,6 +10,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	"github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/config"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -31,6 +32,7 @@ func getShootBuilderByCloudProvider(
 	shootTemplate *gardenv1beta1.ShootTemplate,
 	region *gardenv1beta1.Region,
 	haConfig *v1alpha1.HighAvailabilityConfig,
+	sizingProfile *config.SizingProfile,
 ) (shootBuilder, error) {
 	// build a numeric hash from shoot name and namespace
 	// this allows to choose a random element from a slice (e.g. the region) in a deterministic way
@@ -39,6 +41,7 @@ func getShootBuilderByCloudProvider(
 		region:        region,
 		workerZones:   region.Zones,
 		haConfig:      haConfig,
+		sizingProfile: sizingProfile,
 	}
 	// check if a control plane zone is already set in the shoot
 	// it's immutable and tied to the worker zones, so we shouldn't change it
@@ -66,6 +69,10 @@ func getShootBuilderByCloudProvider(
 		return &shootBuilderGCP{baseShootBuilder: bsb}, nil
 	case "aws":
 		return &shootBuilderAWS{baseShootBuilder: bsb}, nil
+	case "azure":
+		return &shootBuilderAzure{baseShootBuilder: bsb}, nil
+	case "openstack":
+		return &shootBuilderOpenStack{baseShootBuilder: bsb}, nil
 	}
 	return nil, fmt.Errorf("unsupported cloud provider: %s", provider)
 }
@@ -78,13 +85,15 @@ type baseShootBuilder struct {
 	controlPlaneZone string
 	workerZones      []gardenv1beta1.AvailabilityZone
 	haConfig         *v1alpha1.HighAvailabilityConfig
+	// sizingProfile overrides the worker sizing from the shoot template, might be nil
+	sizingProfile *config.SizingProfile
 }
 
 func (b *baseShootBuilder) newInfrastructureConfig(log logging.Logger) (*runtime.RawExtension, error) {
 	return b.shootTemplate.Spec.Provider.InfrastructureConfig, nil
 }
 
-// adjustWorkers adjusts the shoot's workers based on the shoot template and the HA configuration.
+// adjustWorkers adjusts the shoot's workers based on the shoot template, the sizing profile, and the HA configuration.
 // The reason why this is so complex is that some parts of a worker spec, e.g. the zones, are immutable.
 // Instead of changing it, one has to create a new worker spec (with a new name) and remove the old one.
 func (b *baseShootBuilder) adjustWorkers(log logging.Logger, provider *gardenv1beta1.Provider) {
@@ -94,6 +103,29 @@ func (b *baseShootBuilder) adjustWorkers(log logging.Logger, provider *gardenv1b
 		worker := &desiredWorkers[i]
 		worker.Name = fmt.Sprintf("worker-%s", randString(5))
 
+		if b.sizingProfile != nil {
+			if b.sizingProfile.MachineType != "" {
+				worker.Machine.Type = b.sizingProfile.MachineType
+			}
+			if b.sizingProfile.Minimum != nil {
+				worker.Minimum = *b.sizingProfile.Minimum
+			}
+			if b.sizingProfile.Maximum != nil {
+				worker.Maximum = *b.sizingProfile.Maximum
+			}
+		}
+
+		if len(b.workerZones) == 0 {
+			// The region doesn't have any availability zones (e.g. non-zoned Azure regions), so the workers cannot be pinned to zones.
+			// High availability still requires at least 3 nodes to tolerate the outage of 1 node.
+			if b.haConfig != nil {
+				worker.Minimum = 3
+				worker.Maximum = max(worker.Minimum, worker.Maximum)
+			}
+			worker.Zones = nil
+			continue
+		}
+
 		if b.haConfig != nil {
 			// Consensus-based software components depend on maintaining a quorum of (n/2)+1.
 			// Therefore, at least 3 zones are needed to tolerate the outage of 1 zone.
@@ -0,0 +1,100 @@
+package gardener
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"github.com/apparentlymart/go-cidr/cidr"
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+var _ shootBuilder = &shootBuilderAzure{}
+
+// azureWorkersSubnetMaskSize is the netmask size of the 'workers' subnet which is defaulted from the VNet CIDR range.
+const azureWorkersSubnetMaskSize = 19
+
+type shootBuilderAzure struct {
+	baseShootBuilder
+}
+
+func (b *shootBuilderAzure) newControlPlaneConfig(log logging.Logger) (*runtime.RawExtension, error) {
+	controlPlaneConfig := map[string]any{
+		"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+		"kind":       "ControlPlaneConfig",
+	}
+	controlPlaneConfigRaw, err := json.Marshal(controlPlaneConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{
+		Raw: controlPlaneConfigRaw,
+	}, nil
+}
+
+// The Azure infrastructure config expects a VNet and either a 'workers' CIDR range or zone-specific subnets.
+// Azure distinguishes between zoned and non-zoned clusters. If 'zoned' is not specified in the shoot template,
+// it is derived from the chosen region: regions with availability zones result in zoned clusters.
+// If neither 'workers' nor 'zones' is provided in the shoot template, the 'workers' CIDR range is computed from the VNet CIDR range.
+func (b *shootBuilderAzure) newInfrastructureConfig(log logging.Logger) (*runtime.RawExtension, error) {
+	azureInfraCfg := map[string]any{}
+	if err := yaml.Unmarshal(b.shootTemplate.Spec.Provider.InfrastructureConfig.Raw, &azureInfraCfg); err != nil {
+		return nil, err
+	}
+
+	zoned, found, err := unstructured.NestedBool(azureInfraCfg, "zoned")
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'zoned' field in the Azure infrastructure config: %w", err)
+	}
+	if !found {
+		zoned = len(b.workerZones) > 0
+		log.Debug("Setting zoned in Azure infrastructure config", "value", zoned)
+		if err := unstructured.SetNestedField(azureInfraCfg, zoned, "zoned"); err != nil {
+			return nil, err
+		}
+	} else if zoned && len(b.workerZones) == 0 {
+		return nil, fmt.Errorf("the Azure infrastructure config is zoned, but region '%s' does not have any availability zones", b.region.Name)
+	}
+
+	_, hasWorkers, err := unstructured.NestedString(azureInfraCfg, "networks", "workers")
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'networks.workers' field in the Azure infrastructure config: %w", err)
+	}
+	_, hasZones, err := unstructured.NestedSlice(azureInfraCfg, "networks", "zones")
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'networks.zones' field in the Azure infrastructure config: %w", err)
+	}
+	if !hasWorkers && !hasZones {
+		vnetRaw, found, err := unstructured.NestedString(azureInfraCfg, "networks", "vnet", "cidr")
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'networks.vnet.cidr' field in the Azure infrastructure config: %w", err)
+		}
+		if !found || vnetRaw == "" {
+			return nil, fmt.Errorf("networks.vnet.cidr is not defined in the Azure infrastructure config and neither networks.workers nor networks.zones are specified")
+		}
+		_, vnet, err := net.ParseCIDR(vnetRaw)
+		if err != nil {
+			return nil, fmt.Errorf("networks.vnet.cidr '%s' is not a valid CIDR: %w", vnetRaw, err)
+		}
+		workers := vnet
+		if ones, _ := vnet.Mask.Size(); ones < azureWorkersSubnetMaskSize {
+			workers, err = cidr.Subnet(vnet, azureWorkersSubnetMaskSize-ones, 0)
+			if err != nil {
+				return nil, fmt.Errorf("unable to calculate 'workers' subnet from vnet CIDR range '%s': %w", vnet.String(), err)
+			}
+		}
+		log.Debug("Setting networks.workers in Azure infrastructure config", "value", workers.String())
+		if err := unstructured.SetNestedField(azureInfraCfg, workers.String(), "networks", "workers"); err != nil {
+			return nil, err
+		}
+	}
+
+	azureInfraCfgRaw, err := json.Marshal(azureInfraCfg)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: azureInfraCfgRaw}, nil
+}
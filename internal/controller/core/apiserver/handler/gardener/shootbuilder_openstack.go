@@ -0,0 +1,97 @@
+package gardener
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+var _ shootBuilder = &shootBuilderOpenStack{}
+
+type shootBuilderOpenStack struct {
+	baseShootBuilder
+}
+
+// The OpenStack controlplane config requires a load balancer provider, which depends on the OpenStack landscape.
+// It is taken from the controlplane config of the shoot template, together with all other fields specified there (e.g. load balancer classes).
+func (b *shootBuilderOpenStack) newControlPlaneConfig(log logging.Logger) (*runtime.RawExtension, error) {
+	controlPlaneConfig := map[string]any{}
+	if b.shootTemplate.Spec.Provider.ControlPlaneConfig != nil && b.shootTemplate.Spec.Provider.ControlPlaneConfig.Raw != nil {
+		if err := yaml.Unmarshal(b.shootTemplate.Spec.Provider.ControlPlaneConfig.Raw, &controlPlaneConfig); err != nil {
+			return nil, err
+		}
+	}
+	controlPlaneConfig["apiVersion"] = "openstack.provider.extensions.gardener.cloud/v1alpha1"
+	controlPlaneConfig["kind"] = "ControlPlaneConfig"
+
+	lbProvider, _, err := unstructured.NestedString(controlPlaneConfig, "loadBalancerProvider")
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'loadBalancerProvider' field in the OpenStack controlplane config: %w", err)
+	}
+	if lbProvider == "" {
+		return nil, fmt.Errorf("loadBalancerProvider is not defined in the OpenStack controlplane config")
+	}
+	log.Debug("Using load balancer provider from shoot template", "loadBalancerProvider", lbProvider)
+
+	controlPlaneConfigRaw, err := json.Marshal(controlPlaneConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{
+		Raw: controlPlaneConfigRaw,
+	}, nil
+}
+
+// The OpenStack infrastructure config requires the name of a floating pool, which depends on the OpenStack landscape and therefore has to be specified in the shoot template.
+// An existing router or network can be referenced in the shoot template, otherwise Gardener creates them.
+// If no 'workers' CIDR range is provided in the shoot template, the nodes CIDR range of the shoot template is used.
+func (b *shootBuilderOpenStack) newInfrastructureConfig(log logging.Logger) (*runtime.RawExtension, error) {
+	osInfraCfg := map[string]any{}
+	if err := yaml.Unmarshal(b.shootTemplate.Spec.Provider.InfrastructureConfig.Raw, &osInfraCfg); err != nil {
+		return nil, err
+	}
+
+	floatingPoolName, _, err := unstructured.NestedString(osInfraCfg, "floatingPoolName")
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'floatingPoolName' field in the OpenStack infrastructure config: %w", err)
+	}
+	if floatingPoolName == "" {
+		return nil, fmt.Errorf("floatingPoolName is not defined in the OpenStack infrastructure config")
+	}
+
+	networkID, _, err := unstructured.NestedString(osInfraCfg, "networks", "id")
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'networks.id' field in the OpenStack infrastructure config: %w", err)
+	}
+	routerID, _, err := unstructured.NestedString(osInfraCfg, "networks", "router", "id")
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'networks.router.id' field in the OpenStack infrastructure config: %w", err)
+	}
+	if networkID != "" && routerID == "" {
+		return nil, fmt.Errorf("networks.id is defined in the OpenStack infrastructure config, but networks.router.id is not, an existing network requires an existing router")
+	}
+
+	workers, _, err := unstructured.NestedString(osInfraCfg, "networks", "workers")
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'networks.workers' field in the OpenStack infrastructure config: %w", err)
+	}
+	if workers == "" {
+		if b.shootTemplate.Spec.Networking == nil || b.shootTemplate.Spec.Networking.Nodes == nil {
+			return nil, fmt.Errorf("networks.workers is not defined in the OpenStack infrastructure config and cannot be defaulted, because networking.nodes is not defined in the shoot template")
+		}
+		log.Debug("Setting networks.workers in OpenStack infrastructure config", "value", *b.shootTemplate.Spec.Networking.Nodes)
+		if err := unstructured.SetNestedField(osInfraCfg, *b.shootTemplate.Spec.Networking.Nodes, "networks", "workers"); err != nil {
+			return nil, err
+		}
+	}
+
+	osInfraCfgRaw, err := json.Marshal(osInfraCfg)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: osInfraCfgRaw}, nil
+}
@@ -16,7 +16,9 @@ import (
 	. "github.com/onsi/gomega"
 	colactrlutil "github.com/openmcp-project/controller-utils/pkg/controller"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	openmcptesting "github.com/openmcp-project/controller-utils/pkg/testing"
@@ -87,6 +89,7 @@ var _ = BeforeSuite(func() {
 	// load test objects for the 2nd Garden cluster
 	testGardenObjs2, err = openmcptesting.LoadObjects(path.Join("testdata", "garden_cluster_2"), testutils.Scheme)
 	Expect(err).ToNot(HaveOccurred())
+	setOperatorManagedFields(append(testGardenObjs, testGardenObjs2...))
 
 	// load test objects for the Crate cluster
 	testCrateObjs, err = openmcptesting.LoadObjects(path.Join("testdata", "crate_cluster"), testutils.Scheme)
@@ -101,6 +104,30 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 })
 
+// setOperatorManagedFields sets the managed fields of the given Shoots, ConfigMaps and Secrets as if they had been created by the operator.
+// Objects loaded from the testdata don't have any managed fields, so all of their fields would be owned by 'before-first-apply' on the first apply.
+func setOperatorManagedFields(objs []client.Object) {
+	c := fake.NewClientBuilder().WithScheme(schemes.GardenerScheme).WithReturnManagedFields().Build()
+	for _, obj := range objs {
+		gvk := obj.GetObjectKind().GroupVersionKind()
+		switch gvk.Kind {
+		case "Shoot", "ConfigMap", "Secret":
+		default:
+			continue
+		}
+		// the fake client only tracks managed fields for typed objects
+		data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		Expect(err).ToNot(HaveOccurred())
+		created, err := c.Scheme().New(gvk)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(data, created)).To(Succeed())
+		createdObj := created.(client.Object)
+		createdObj.SetResourceVersion("")
+		Expect(c.Create(context.Background(), createdObj, client.FieldOwner(gardener.FieldManager))).To(Succeed())
+		obj.SetManagedFields(createdObj.GetManagedFields())
+	}
+}
+
 var _ = BeforeEach(func() {
 	var err error
 
@@ -119,10 +146,12 @@ var _ = BeforeEach(func() {
 		WithInitObjects(gardenCluster, testGardenObjs...).
 		WithDynamicObjectsWithStatus(gardenCluster, testGardenObjs...).
 		WithFakeClientBuilderCall(gardenCluster, "WithInterceptorFuncs", gardenClusterInterceptorFuncs).
+		WithFakeClientBuilderCall(gardenCluster, "WithReturnManagedFields").
 		WithFakeClient(gardenCluster2, schemes.GardenerScheme).
 		WithInitObjects(gardenCluster2, testGardenObjs2...).
 		WithDynamicObjectsWithStatus(gardenCluster2, testGardenObjs2...).
 		WithFakeClientBuilderCall(gardenCluster2, "WithInterceptorFuncs", gardenClusterInterceptorFuncs).
+		WithFakeClientBuilderCall(gardenCluster2, "WithReturnManagedFields").
 		Build()
 
 	// complete the single config
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/openmcp-project/mcp-operator/internal/components"
 	apiserverutils "github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver/utils"
 
 	"k8s.io/client-go/rest"
@@ -30,6 +31,14 @@ type APIServerHandler interface {
 	HandleDelete(ctx context.Context, dp *openmcpv1alpha1.APIServer, crateClient client.Client) (ctrl.Result, UpdateStatusFunc, []openmcpv1alpha1.ComponentCondition, openmcperrors.ReasonableError)
 }
 
+// APIServerPlanner can optionally be implemented by APIServerHandlers.
+// It is used to compute the changes HandleCreateOrUpdate would make, without making them.
+type APIServerPlanner interface {
+	// PlanCreateOrUpdate returns the changes which HandleCreateOrUpdate would make to the resources managed for the APIServer.
+	// It must not modify the APIServer or any other resource.
+	PlanCreateOrUpdate(ctx context.Context, dp *openmcpv1alpha1.APIServer) ([]components.DownstreamChange, error)
+}
+
 // ClusterAccessEnabler is a helper interface.
 // It is used to initially access the cluster in order to create serviceaccounts and generate kubeconfigs for them.
 type ClusterAccessEnabler interface {
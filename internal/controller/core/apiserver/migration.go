@@ -0,0 +1,330 @@
+package apiserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gcpv1alpha1 "github.com/openmcp-project/cluster-provider-gardener/api/core/v1alpha1"
+	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
+	clustersconst "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1/constants"
+	commonapi "github.com/openmcp-project/openmcp-operator/api/common"
+	openmcpconst "github.com/openmcp-project/openmcp-operator/api/constants"
+	openmcpclusterutils "github.com/openmcp-project/openmcp-operator/lib/utils"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
+	mcpocfg "github.com/openmcp-project/mcp-operator/internal/config"
+	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
+	"github.com/openmcp-project/mcp-operator/internal/utils/migration"
+)
+
+const (
+	migrationStepPrerequisites          = "Prerequisites"
+	migrationStepClusterRequestCreated  = "ClusterRequestCreated"
+	migrationStepClusterAdopted         = "ClusterAdopted"
+	migrationStepClusterRequestReleased = "ClusterRequestReleased"
+	migrationStepClusterReady           = "ClusterReady"
+)
+
+// handleMigration handles a requested migration of the APIServer from the v1 to the v2 architecture.
+// If the returned bool is true, the migration (or its rollback) is still in progress and the returned values should be used as reconciliation result.
+// Otherwise, the regular logic for the APIServer's architecture version should be executed and the returned conditions should be added to its conditions.
+func (r *APIServerProvider) handleMigration(ctx context.Context, as *openmcpv1alpha1.APIServer, deleteAPIServer bool) (bool, ctrl.Result, []openmcpv1alpha1.ComponentCondition, openmcperrors.ReasonableError) {
+	op := migration.Requested(as)
+	if op == "" {
+		return false, ctrl.Result{}, nil, nil
+	}
+	log := logging.FromContextOrPanic(ctx).WithName("migration")
+	ctx = logging.NewContext(ctx, log)
+
+	if op != openmcpv1alpha1.ArchitectureV2 && op != openmcpv1alpha1.ArchitectureMigrationValueRollback {
+		return false, ctrl.Result{}, []openmcpv1alpha1.ComponentCondition{componentutils.NewCondition(openmcpv1alpha1.APIServerComponent.ArchitectureMigrationCondition(), openmcpv1alpha1.ComponentConditionStatusFalse, cconst.ReasonMigrationNotSupported, fmt.Sprintf("unknown migration operation '%s', must be either '%s' or '%s'", op, openmcpv1alpha1.ArchitectureV2, openmcpv1alpha1.ArchitectureMigrationValueRollback))}, nil
+	}
+	purpose, ok := clusterPurpose(as.Spec.Type)
+	if !ok {
+		return false, ctrl.Result{}, []openmcpv1alpha1.ComponentCondition{componentutils.NewCondition(openmcpv1alpha1.APIServerComponent.ArchitectureMigrationCondition(), openmcpv1alpha1.ComponentConditionStatusFalse, cconst.ReasonMigrationNotSupported, fmt.Sprintf("APIServers of type '%s' cannot be migrated to the v2 architecture", as.Spec.Type))}, nil
+	}
+
+	m := migration.New(r.CrateClient, as, r.migrationSteps(as, purpose)...)
+	if as.GetLabels()[openmcpv1alpha1.ArchitectureVersionLabel] == openmcpv1alpha1.ArchitectureV2 {
+		// migration has already been completed, nothing to do
+		return false, ctrl.Result{}, m.Completed(), nil
+	}
+
+	var res migration.Result
+	if op == openmcpv1alpha1.ArchitectureV2 && !deleteAPIServer {
+		res = m.Run(ctx)
+	} else {
+		// an unfinished migration needs to be rolled back before the APIServer can be deleted via the v1 logic
+		res = m.Rollback(ctx)
+	}
+	if res.Completed {
+		return false, ctrl.Result{}, res.Conditions, nil
+	}
+
+	// the v1 logic is paused while the migration or its rollback is in progress
+	reason := cconst.ReasonMigrationInProgress
+	if op == openmcpv1alpha1.ArchitectureMigrationValueRollback || deleteAPIServer {
+		reason = cconst.ReasonMigrationRollingBack
+	}
+	return true, res.Result, clusterConditions(false, reason, "APIServer is paused while its migration to the v2 architecture is in progress or being rolled back", res.Conditions...), res.Error
+}
+
+// migrationSteps returns the steps for migrating the given APIServer from the v1 to the v2 architecture.
+// The existing shoot is adopted by creating a Cluster for it, which references the shoot by name, and a ClusterRequest which is granted with this Cluster.
+// The ClusterRequest is ignored by the scheduler until the Cluster has been assigned to it.
+// The shoot itself is never modified by the migration or its rollback.
+func (r *APIServerProvider) migrationSteps(as *openmcpv1alpha1.APIServer, purpose string) []migration.Step {
+	nsName, nsErr := openmcpclusterutils.StableMCPNamespace(as.Name, as.Namespace)
+	cr := &clustersv1alpha1.ClusterRequest{}
+	cr.Name = as.Name
+	cr.Namespace = nsName
+	cluster := &clustersv1alpha1.Cluster{}
+	cluster.Name = as.Name
+	cluster.Namespace = nsName
+	var shoot *unstructured.Unstructured
+
+	return []migration.Step{
+		{
+			Name: migrationStepPrerequisites,
+			Migrate: func(ctx context.Context) (bool, string, openmcperrors.ReasonableError) {
+				if nsErr != nil {
+					return false, "", openmcperrors.WithReason(fmt.Errorf("failed to compute stable namespace for APIServer %s/%s: %w", as.Namespace, as.Name, nsErr), clustersconst.ReasonInternalError)
+				}
+				if r.PlatformClient == nil {
+					return false, "", openmcperrors.WithReason(fmt.Errorf("no platform cluster configured"), cconst.ReasonConfigurationProblem)
+				}
+				if mcpocfg.Config.Architecture.Migration.ClusterProfile == "" {
+					return false, "", openmcperrors.WithReason(fmt.Errorf("no cluster profile configured for migrations"), cconst.ReasonConfigurationProblem)
+				}
+				var err error
+				shoot, err = as.Status.GardenerStatus.GetShoot()
+				if err != nil {
+					return false, "", openmcperrors.WithReason(fmt.Errorf("error reading shoot from APIServer status: %w", err), clustersconst.ReasonInternalError)
+				}
+				if shoot == nil || shoot.GetName() == "" {
+					return false, "Waiting for the shoot to be reported in the APIServer status", nil
+				}
+				if rerr := r.verifyMigrationClusterProfile(ctx, shoot.GetNamespace()); rerr != nil {
+					return false, "", rerr
+				}
+				return true, "", nil
+			},
+		},
+		{
+			Name: migrationStepClusterRequestCreated,
+			Migrate: func(ctx context.Context) (bool, string, openmcperrors.ReasonableError) {
+				nsm := resources.NewNamespaceMutator(nsName)
+				nsm.MetadataMutator().WithLabels(map[string]string{
+					openmcpv1alpha1.V1MCPReferenceLabelNamespace: as.Namespace,
+				})
+				if err := resources.CreateOrUpdateResource(ctx, r.PlatformClient, nsm); err != nil {
+					return false, "", openmcperrors.WithReason(fmt.Errorf("failed to create or update namespace %s: %w", nsName, err), clustersconst.ReasonPlatformClusterInteractionProblem)
+				}
+
+				if err := r.PlatformClient.Get(ctx, client.ObjectKeyFromObject(cr), cr); err != nil {
+					if !apierrors.IsNotFound(err) {
+						return false, "", openmcperrors.WithReason(fmt.Errorf("failed to get ClusterRequest %s/%s: %w", cr.Namespace, cr.Name, err), clustersconst.ReasonPlatformClusterInteractionProblem)
+					}
+					// the ClusterRequest must be ignored by the scheduler, otherwise it would create a new Cluster
+					logging.FromContextOrPanic(ctx).Info("Creating ClusterRequest", "resourceName", cr.Name, "resourceNamespace", cr.Namespace)
+					cr.Spec.Purpose = purpose
+					cr.SetAnnotations(map[string]string{openmcpconst.OperationAnnotation: openmcpconst.OperationAnnotationValueIgnore})
+					cr.SetLabels(map[string]string{
+						openmcpv1alpha1.V1MCPReferenceLabelName:      as.Name,
+						openmcpv1alpha1.V1MCPReferenceLabelNamespace: as.Namespace,
+					})
+					if err := r.PlatformClient.Create(ctx, cr); err != nil {
+						return false, "", openmcperrors.WithReason(fmt.Errorf("failed to create ClusterRequest %s/%s: %w", cr.Namespace, cr.Name, err), clustersconst.ReasonPlatformClusterInteractionProblem)
+					}
+					return true, "", nil
+				}
+				if cr.Status.Cluster == nil && cr.GetAnnotations()[openmcpconst.OperationAnnotation] != openmcpconst.OperationAnnotationValueIgnore {
+					return false, "", openmcperrors.WithReason(fmt.Errorf("clusterRequest %s/%s already exists and is not ignored by the scheduler", cr.Namespace, cr.Name), cconst.ReasonConfigurationProblem)
+				}
+				return true, "", nil
+			},
+			Rollback: func(ctx context.Context) (bool, string, openmcperrors.ReasonableError) {
+				return r.removeMigratedResource(ctx, "ClusterRequest", cr)
+			},
+		},
+		{
+			Name: migrationStepClusterAdopted,
+			Migrate: func(ctx context.Context) (bool, string, openmcperrors.ReasonableError) {
+				if err := r.PlatformClient.Get(ctx, client.ObjectKeyFromObject(cluster), cluster); err != nil {
+					if !apierrors.IsNotFound(err) {
+						return false, "", openmcperrors.WithReason(fmt.Errorf("failed to get Cluster %s/%s: %w", cluster.Namespace, cluster.Name, err), clustersconst.ReasonPlatformClusterInteractionProblem)
+					}
+					logging.FromContextOrPanic(ctx).Info("Creating Cluster for existing shoot", "resourceName", cluster.Name, "resourceNamespace", cluster.Namespace, "shootName", shoot.GetName(), "shootNamespace", shoot.GetNamespace())
+					k8sVersion, _, _ := unstructured.NestedString(shoot.Object, "spec", "kubernetes", "version")
+					cluster.SetLabels(map[string]string{
+						gcpv1alpha1.ShootNameLabel:                   shoot.GetName(),
+						clustersv1alpha1.DeleteWithoutRequestsLabel:  "true",
+						openmcpv1alpha1.V1MCPReferenceLabelName:      as.Name,
+						openmcpv1alpha1.V1MCPReferenceLabelNamespace: as.Namespace,
+					})
+					cluster.SetFinalizers([]string{cr.FinalizerForCluster()})
+					cluster.Spec = clustersv1alpha1.ClusterSpec{
+						Profile:    mcpocfg.Config.Architecture.Migration.ClusterProfile,
+						Kubernetes: clustersv1alpha1.K8sConfiguration{Version: k8sVersion},
+						Purposes:   []string{purpose},
+						Tenancy:    clustersv1alpha1.TENANCY_EXCLUSIVE,
+					}
+					if err := r.PlatformClient.Create(ctx, cluster); err != nil {
+						return false, "", openmcperrors.WithReason(fmt.Errorf("failed to create Cluster %s/%s: %w", cluster.Namespace, cluster.Name, err), clustersconst.ReasonPlatformClusterInteractionProblem)
+					}
+				} else if cluster.GetLabels()[gcpv1alpha1.ShootNameLabel] != shoot.GetName() {
+					return false, "", openmcperrors.WithReason(fmt.Errorf("cluster %s/%s already exists, but does not reference shoot '%s'", cluster.Namespace, cluster.Name, shoot.GetName()), cconst.ReasonConfigurationProblem)
+				}
+
+				// assign the Cluster to the ClusterRequest
+				if cr.Status.Cluster != nil {
+					if cr.Status.Cluster.Name != cluster.Name || cr.Status.Cluster.Namespace != cluster.Namespace {
+						return false, "", openmcperrors.WithReason(fmt.Errorf("clusterRequest %s/%s already references Cluster %s/%s", cr.Namespace, cr.Name, cr.Status.Cluster.Namespace, cr.Status.Cluster.Name), cconst.ReasonConfigurationProblem)
+					}
+					return true, "", nil
+				}
+				old := cr.DeepCopy()
+				cr.Status.Phase = clustersv1alpha1.REQUEST_GRANTED
+				cr.Status.Cluster = &commonapi.ObjectReference{
+					Name:      cluster.Name,
+					Namespace: cluster.Namespace,
+				}
+				if err := r.PlatformClient.Status().Patch(ctx, cr, client.MergeFrom(old)); err != nil {
+					return false, "", openmcperrors.WithReason(fmt.Errorf("failed to assign Cluster to ClusterRequest %s/%s: %w", cr.Namespace, cr.Name, err), clustersconst.ReasonPlatformClusterInteractionProblem)
+				}
+				return true, "", nil
+			},
+			Rollback: func(ctx context.Context) (bool, string, openmcperrors.ReasonableError) {
+				// the DeleteWithoutRequests label is removed so that the scheduler doesn't delete the Cluster (and with it the shoot) on its own
+				return r.removeMigratedResource(ctx, "Cluster", cluster, clustersv1alpha1.DeleteWithoutRequestsLabel)
+			},
+		},
+		{
+			Name: migrationStepClusterRequestReleased,
+			Migrate: func(ctx context.Context) (bool, string, openmcperrors.ReasonableError) {
+				if err := componentutils.PatchAnnotation(ctx, r.PlatformClient, cr, openmcpconst.OperationAnnotation, "", componentutils.ANNOTATION_DELETE); err != nil {
+					return false, "", openmcperrors.WithReason(fmt.Errorf("failed to remove operation annotation from ClusterRequest %s/%s: %w", cr.Namespace, cr.Name, err), clustersconst.ReasonPlatformClusterInteractionProblem)
+				}
+				return true, "", nil
+			},
+			Rollback: func(ctx context.Context) (bool, string, openmcperrors.ReasonableError) {
+				if r.PlatformClient == nil {
+					return true, "", nil
+				}
+				if err := r.PlatformClient.Get(ctx, client.ObjectKeyFromObject(cr), cr); err != nil {
+					if apierrors.IsNotFound(err) {
+						return true, "", nil
+					}
+					return false, "", openmcperrors.WithReason(fmt.Errorf("failed to get ClusterRequest %s/%s: %w", cr.Namespace, cr.Name, err), clustersconst.ReasonPlatformClusterInteractionProblem)
+				}
+				if err := componentutils.PatchAnnotation(ctx, r.PlatformClient, cr, openmcpconst.OperationAnnotation, openmcpconst.OperationAnnotationValueIgnore, componentutils.ANNOTATION_OVERWRITE); err != nil {
+					return false, "", openmcperrors.WithReason(fmt.Errorf("failed to add operation annotation to ClusterRequest %s/%s: %w", cr.Namespace, cr.Name, err), clustersconst.ReasonPlatformClusterInteractionProblem)
+				}
+				return true, "", nil
+			},
+		},
+		{
+			Name: migrationStepClusterReady,
+			Migrate: func(ctx context.Context) (bool, string, openmcperrors.ReasonableError) {
+				if err := r.PlatformClient.Get(ctx, client.ObjectKeyFromObject(cluster), cluster); err != nil {
+					return false, "", openmcperrors.WithReason(fmt.Errorf("failed to get Cluster %s/%s: %w", cluster.Namespace, cluster.Name, err), clustersconst.ReasonPlatformClusterInteractionProblem)
+				}
+				if cluster.Status.Phase != clustersv1alpha1.CLUSTER_PHASE_READY {
+					return false, fmt.Sprintf("Waiting for Cluster %s/%s to become ready", cluster.Namespace, cluster.Name), nil
+				}
+				return true, "", nil
+			},
+		},
+	}
+}
+
+// verifyMigrationClusterProfile verifies that the Gardener project which the cluster profile configured for migrations points to is the one which contains the shoot.
+// Otherwise, the cluster provider would look for the adopted shoot in the wrong project and create a new one.
+func (r *APIServerProvider) verifyMigrationClusterProfile(ctx context.Context, shootNamespace string) openmcperrors.ReasonableError {
+	profileName := mcpocfg.Config.Architecture.Migration.ClusterProfile
+	profile := &clustersv1alpha1.ClusterProfile{}
+	profile.Name = profileName
+	if err := r.PlatformClient.Get(ctx, client.ObjectKeyFromObject(profile), profile); err != nil {
+		if apierrors.IsNotFound(err) {
+			return openmcperrors.WithReason(fmt.Errorf("cluster profile '%s' configured for migrations does not exist", profileName), cconst.ReasonConfigurationProblem)
+		}
+		return openmcperrors.WithReason(fmt.Errorf("failed to get ClusterProfile '%s': %w", profileName, err), clustersconst.ReasonPlatformClusterInteractionProblem)
+	}
+	pc := &gcpv1alpha1.ProviderConfig{}
+	pc.Name = profile.Spec.ProviderConfigRef.Name
+	if err := r.PlatformClient.Get(ctx, client.ObjectKeyFromObject(pc), pc); err != nil {
+		return openmcperrors.WithReason(fmt.Errorf("failed to get ProviderConfig '%s' of cluster profile '%s': %w", pc.Name, profileName, err), clustersconst.ReasonPlatformClusterInteractionProblem)
+	}
+	ls := &gcpv1alpha1.Landscape{}
+	ls.Name = pc.Spec.LandscapeRef.Name
+	if err := r.PlatformClient.Get(ctx, client.ObjectKeyFromObject(ls), ls); err != nil {
+		return openmcperrors.WithReason(fmt.Errorf("failed to get Landscape '%s' of cluster profile '%s': %w", ls.Name, profileName, err), clustersconst.ReasonPlatformClusterInteractionProblem)
+	}
+	for _, p := range ls.Status.Projects {
+		if p.Name != pc.Spec.Project {
+			continue
+		}
+		if p.Namespace != shootNamespace {
+			return openmcperrors.WithReason(fmt.Errorf("cluster profile '%s' configured for migrations uses Gardener project '%s' with namespace '%s' on landscape '%s', but the shoot is in namespace '%s'", profileName, p.Name, p.Namespace, ls.Name, shootNamespace), cconst.ReasonConfigurationProblem)
+		}
+		return nil
+	}
+	return openmcperrors.WithReason(fmt.Errorf("gardener project '%s' of cluster profile '%s' configured for migrations is not available on landscape '%s'", pc.Spec.Project, profileName, ls.Name), cconst.ReasonConfigurationProblem)
+}
+
+// removeMigratedResource removes a resource that has been created on the platform cluster during a migration.
+// The resource is marked as ignored and its finalizers and the given labels are removed before it is deleted,
+// so that neither the scheduler nor the cluster provider act on its deletion.
+// Returns true once the resource is gone.
+func (r *APIServerProvider) removeMigratedResource(ctx context.Context, kind string, obj client.Object, removeLabels ...string) (bool, string, openmcperrors.ReasonableError) {
+	if r.PlatformClient == nil {
+		// nothing can have been created without a platform cluster
+		return true, "", nil
+	}
+	if err := r.PlatformClient.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, "", nil
+		}
+		return false, "", openmcperrors.WithReason(fmt.Errorf("failed to get %s %s/%s: %w", kind, obj.GetNamespace(), obj.GetName(), err), clustersconst.ReasonPlatformClusterInteractionProblem)
+	}
+
+	old := obj.DeepCopyObject().(client.Object)
+	anns := obj.GetAnnotations()
+	if anns == nil {
+		anns = map[string]string{}
+	}
+	anns[openmcpconst.OperationAnnotation] = openmcpconst.OperationAnnotationValueIgnore
+	obj.SetAnnotations(anns)
+	labels := obj.GetLabels()
+	for _, l := range removeLabels {
+		delete(labels, l)
+	}
+	obj.SetLabels(labels)
+	obj.SetFinalizers(nil)
+	if err := r.PlatformClient.Patch(ctx, obj, client.MergeFrom(old)); err != nil {
+		return false, "", openmcperrors.WithReason(fmt.Errorf("failed to prepare %s %s/%s for deletion: %w", kind, obj.GetNamespace(), obj.GetName(), err), clustersconst.ReasonPlatformClusterInteractionProblem)
+	}
+
+	if obj.GetDeletionTimestamp().IsZero() {
+		logging.FromContextOrPanic(ctx).Info("Deleting resource created during migration", "kind", kind, "resourceName", obj.GetName(), "resourceNamespace", obj.GetNamespace())
+		if err := r.PlatformClient.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return false, "", openmcperrors.WithReason(fmt.Errorf("failed to delete %s %s/%s: %w", kind, obj.GetNamespace(), obj.GetName(), err), clustersconst.ReasonPlatformClusterInteractionProblem)
+		}
+	}
+	if err := r.PlatformClient.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, "", nil
+		}
+		return false, "", openmcperrors.WithReason(fmt.Errorf("failed to verify deletion of %s %s/%s: %w", kind, obj.GetNamespace(), obj.GetName(), err), clustersconst.ReasonPlatformClusterInteractionProblem)
+	}
+	return false, fmt.Sprintf("Waiting for %s %s/%s to be deleted", kind, obj.GetNamespace(), obj.GetName()), nil
+}
@@ -70,13 +70,8 @@ func v2HandleCreateOrUpdate(ctx context.Context, as *openmcpv1alpha1.APIServer,
 	}
 
 	// create or update ClusterRequest
-	var purpose string
-	switch as.Spec.Type {
-	case openmcpv1alpha1.Gardener:
-		purpose = "mcp"
-	case openmcpv1alpha1.GardenerDedicated:
-		purpose = "mcp-worker"
-	default:
+	purpose, ok := clusterPurpose(as.Spec.Type)
+	if !ok {
 		rerr := openmcperrors.WithReason(fmt.Errorf("unknown APIServer type '%s'", as.Spec.Type), clustersconst.ReasonConfigurationProblem)
 		return ctrl.Result{}, nil, clusterConditions(false, rerr.Reason(), rerr.Error(), clusterRequestGrantedCon, clusterReadyCon, accessRequestGrantedCon), rerr
 	}
@@ -513,3 +508,15 @@ func clusterConditions(ready bool, reason, message string, additionalConditions
 	conditions = append(conditions, additionalConditions...)
 	return conditions
 }
+
+// clusterPurpose returns the purpose which is used for the ClusterRequest of a v2 APIServer of the given type.
+// The returned bool is false if APIServers of the given type are not supported by the v2 architecture.
+func clusterPurpose(t openmcpv1alpha1.APIServerType) (string, bool) {
+	switch t {
+	case openmcpv1alpha1.Gardener:
+		return "mcp", true
+	case openmcpv1alpha1.GardenerDedicated:
+		return "mcp-worker", true
+	}
+	return "", false
+}
@@ -6,6 +6,8 @@ import (
 	"strings"
 	"time"
 
+	mcpcomponents "github.com/openmcp-project/mcp-operator/internal/components"
+	mcpocfg "github.com/openmcp-project/mcp-operator/internal/config"
 	"github.com/openmcp-project/mcp-operator/internal/utils"
 	"github.com/openmcp-project/mcp-operator/internal/utils/apiserver"
 	"github.com/openmcp-project/mcp-operator/internal/utils/components"
@@ -116,26 +118,25 @@ func (ar *AuthenticationReconciler) reconcile(ctx context.Context, req ctrl.Requ
 		}
 	}
 
-	// checking for APIServer component
-	log.Debug("Checking for APIServer dependency")
+	// checking for dependencies
+	log.Debug("Checking for dependencies")
 	ownCPGeneration, ownICGeneration, _ := components.GetCreatedFromGeneration(auth)
-	as := &openmcpv1alpha1.APIServer{}
-	as.SetName(auth.Name)
-	as.SetNamespace(auth.Namespace)
-
-	if err := ar.Client.Get(ctx, client.ObjectKeyFromObject(as), as); err != nil {
-		if !apierrors.IsNotFound(err) {
-			return components.ReconcileResult[*openmcpv1alpha1.Authentication]{Component: auth, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error fetching APIServer resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
-		}
-		// APIServer not found
-		as = nil
+	deps, err := components.GetDependencies(mcpcomponents.Registry, ctx, ar.Client, auth)
+	if err != nil {
+		return components.ReconcileResult[*openmcpv1alpha1.Authentication]{Component: auth, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error fetching dependencies: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
 	}
-	if as == nil || !components.IsDependencyReady(as, ownCPGeneration, ownICGeneration) {
-		log.Info("APIServer not found or it isn't ready")
-		return components.ReconcileResult[*openmcpv1alpha1.Authentication]{Component: auth, Conditions: authenticationConditions(false, cconst.ReasonWaitingForDependencies, "Waiting for APIServer dependency to be ready."), Result: ctrl.Result{RequeueAfter: 60 * time.Second}}
+	as, _ := deps[openmcpv1alpha1.APIServerComponent].(*openmcpv1alpha1.APIServer)
+	if notReady := components.NotReadyDependencies(deps, ownCPGeneration, ownICGeneration); as == nil || len(notReady) > 0 {
+		log.Info("Dependencies not found or not ready", "notReadyDependencies", notReady)
+		return components.ReconcileResult[*openmcpv1alpha1.Authentication]{Component: auth, Conditions: authenticationConditions(false, cconst.ReasonWaitingForDependencies, components.WaitingForDependenciesMessage(notReady)), Result: ctrl.Result{RequeueAfter: 60 * time.Second}}
 	}
 
-	log.Debug("APIServer dependency is ready")
+	log.Debug("Dependencies are ready")
+
+	if mcpocfg.Config.Architecture.DecideVersion(auth) == openmcpv1alpha1.ArchitectureV2 {
+		log.Info("Using v2 logic for Authentication")
+		return ar.v2Reconcile(ctx, auth, deps)
+	}
 
 	if as.Spec.Type != openmcpv1alpha1.Gardener && as.Spec.Type != openmcpv1alpha1.GardenerDedicated {
 		log.Info("APIServer is not of type Gardener/GardenerDedicated")
@@ -171,10 +172,9 @@ func (ar *AuthenticationReconciler) reconcile(ctx context.Context, req ctrl.Requ
 			}
 		}
 
-		log.Debug("Ensuring dependency finalizer on APIServer resource")
-		err := components.EnsureDependencyFinalizer(ctx, ar.Client, as, auth, true)
-		if err != nil {
-			return components.ReconcileResult[*openmcpv1alpha1.Authentication]{Component: auth, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error setting dependency finalizer on APIServer component resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
+		log.Debug("Ensuring dependency finalizers on dependencies")
+		if err := components.EnsureDependencyFinalizers(ctx, ar.Client, auth, deps, true); err != nil {
+			return components.ReconcileResult[*openmcpv1alpha1.Authentication]{Component: auth, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error setting dependency finalizers: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
 		}
 	}
 
@@ -192,10 +192,9 @@ func (ar *AuthenticationReconciler) reconcile(ctx context.Context, req ctrl.Requ
 			return components.ReconcileResult[*openmcpv1alpha1.Authentication]{Component: auth, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error deleting access secret: %w", err), cconst.ReasonManagingOpenIDConnect)}
 		}
 
-		// remove the auth dependency finalizer from the APIServer resource if the auth resource is being deleted
-		err = components.EnsureDependencyFinalizer(ctx, ar.Client, as, auth, false)
-		if err != nil {
-			return components.ReconcileResult[*openmcpv1alpha1.Authentication]{Component: auth, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error removing dependency finalizer from APIServer component resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
+		// remove the auth dependency finalizers from the dependencies if the auth resource is being deleted
+		if err := components.EnsureDependencyFinalizers(ctx, ar.Client, auth, deps, false); err != nil {
+			return components.ReconcileResult[*openmcpv1alpha1.Authentication]{Component: auth, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error removing dependency finalizers: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
 		}
 
 		// remove finalizer from auth resource
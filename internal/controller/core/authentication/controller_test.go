@@ -3,6 +3,8 @@ package authentication_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	commonapi "github.com/openmcp-project/openmcp-operator/api/common"
+	corev2alpha1 "github.com/openmcp-project/openmcp-operator/api/core/v2alpha1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,6 +17,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/openmcp-project/mcp-operator/internal/components"
+	mcpocfg "github.com/openmcp-project/mcp-operator/internal/config"
 
 	"github.com/openmcp-project/mcp-operator/internal/controller/core/authentication"
 	"github.com/openmcp-project/mcp-operator/internal/controller/core/authentication/config"
@@ -667,4 +670,101 @@ var _ = Describe("CO-1153 Authentication Controller", func() {
 			}),
 		))
 	})
+
+	Context("v2", func() {
+
+		BeforeEach(func() {
+			mcpocfg.Config.Architecture.Authentication.Version = openmcpv1alpha1.ArchitectureV2
+		})
+
+		AfterEach(func() {
+			mcpocfg.Config.Architecture.Authentication.Version = openmcpv1alpha1.ArchitectureV1
+		})
+
+		It("should configure the identity providers in the v2 ControlPlane", func() {
+			env := testEnvWithAPIServerAccess("testdata", "test-05")
+
+			auth := &openmcpv1alpha1.Authentication{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, auth)).To(Succeed())
+
+			env.ShouldReconcile(authReconciler, testing.RequestFromObject(auth))
+
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(auth), auth)).To(Succeed())
+			Expect(auth.Finalizers).To(ContainElement(openmcpv1alpha1.AuthenticationComponent.Finalizer()))
+			Expect(auth.Status.UserAccess).To(BeNil())
+			Expect(auth.Status.Conditions).To(ContainElements(
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   openmcpv1alpha1.AuthenticationComponent.HealthyCondition(),
+					Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+					Reason: cconst.ReasonWaitingForV2ControlPlane,
+				}),
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   cconst.ConditionAuthenticationV2ResourceCreatedOrUpdated,
+					Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+				}),
+			))
+
+			// no OpenIDConnect resources are created in v2
+			openIDConnectList := getOpenIDConnectList()
+			Expect(env.Client(testutils.APIServerCluster).List(env.Ctx, openIDConnectList)).To(Succeed())
+			Expect(openIDConnectList.Items).To(BeEmpty())
+
+			cp := &corev2alpha1.ControlPlane{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(auth), cp)).To(Succeed())
+			Expect(cp.Labels).To(HaveKeyWithValue(openmcpv1alpha1.V1MCPReferenceLabelName, auth.Name))
+			Expect(cp.Labels).To(HaveKeyWithValue(openmcpv1alpha1.V1MCPReferenceLabelNamespace, auth.Namespace))
+			Expect(cp.Spec.IAM.OIDC.ExtraProviders).To(ConsistOf(commonapi.OIDCProviderConfig{
+				Name:          "customer",
+				Issuer:        "https://customer.local",
+				ClientID:      "xxx-yyy-zzz",
+				UsernameClaim: "u_name",
+				GroupsClaim:   "grp",
+				RoleBindings:  []commonapi.RoleBindings{},
+			}))
+
+			// the v2 ControlPlane becomes ready and exposes the access for the identity provider
+			cp.Status.Phase = commonapi.StatusPhaseReady
+			cp.Status.ObservedGeneration = cp.Generation
+			cp.Status.Access = map[string]commonapi.LocalObjectReference{
+				"customer": {Name: "test.customer.kubeconfig"},
+			}
+			cp.Status.Conditions = []v1.Condition{{Type: "Ready", Status: v1.ConditionTrue, Reason: "Ready", LastTransitionTime: v1.Now()}}
+			Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, cp)).To(Succeed())
+
+			env.ShouldReconcile(authReconciler, testing.RequestFromObject(auth))
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(auth), auth)).To(Succeed())
+			Expect(auth.Status.UserAccess).To(Equal(&openmcpv1alpha1.SecretReference{
+				NamespacedObjectReference: openmcpv1alpha1.NamespacedObjectReference{
+					Name:      "test.customer.kubeconfig",
+					Namespace: "test",
+				},
+				Key: "kubeconfig",
+			}))
+			Expect(auth.Status.Conditions).To(ContainElements(
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   openmcpv1alpha1.AuthenticationComponent.HealthyCondition(),
+					Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+				}),
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   "AuthNv2_Ready",
+					Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+					Reason: "Ready",
+				}),
+			))
+
+			// without any IAM configuration left, the v2 ControlPlane is deleted
+			Expect(env.Client(testutils.CrateCluster).Delete(env.Ctx, auth)).To(Succeed())
+			res := env.ShouldReconcile(authReconciler, testing.RequestFromObject(auth))
+			testing.ExpectRequeue(res)
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(cp), cp)).To(MatchError(errors.IsNotFound, "not found"))
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(auth), auth)).To(Succeed())
+
+			env.ShouldReconcile(authReconciler, testing.RequestFromObject(auth))
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(auth), auth)).To(MatchError(errors.IsNotFound, "not found"))
+			as := &openmcpv1alpha1.APIServer{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, as)).To(Succeed())
+			Expect(as.Finalizers).ToNot(ContainElement(openmcpv1alpha1.AuthenticationComponent.DependencyFinalizer()))
+		})
+
+	})
 })
@@ -0,0 +1,179 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	commonapi "github.com/openmcp-project/openmcp-operator/api/common"
+	corev2alpha1 "github.com/openmcp-project/openmcp-operator/api/core/v2alpha1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
+	mcpcomponents "github.com/openmcp-project/mcp-operator/internal/components"
+	"github.com/openmcp-project/mcp-operator/internal/utils/components"
+	"github.com/openmcp-project/mcp-operator/internal/utils/controlplane"
+)
+
+// v2ConditionPrefix is prepended to the conditions of the v2 ControlPlane when they are copied into the Authentication's status.
+const v2ConditionPrefix = "AuthNv2_"
+
+// v2Reconcile reconciles the Authentication by configuring its identity providers as extra OIDC providers in the v2 ControlPlane.
+// The system identity provider corresponds to the default OIDC provider of the v2 architecture, which is configured by the platform,
+// and the crate identity provider doesn't have a v2 counterpart.
+func (ar *AuthenticationReconciler) v2Reconcile(ctx context.Context, auth *openmcpv1alpha1.Authentication, deps map[openmcpv1alpha1.ComponentType]mcpcomponents.Component) components.ReconcileResult[*openmcpv1alpha1.Authentication] {
+	log := logging.FromContextOrPanic(ctx).WithName(openmcpv1alpha1.ArchitectureV2)
+
+	old := auth.DeepCopy()
+	if !auth.DeletionTimestamp.IsZero() {
+		log.Info("Deleting Authentication")
+		if components.HasAnyDependencyFinalizer(auth) {
+			depString := strings.Join(sets.List(components.GetDependents(auth)), ", ")
+			log.Info("Authentication cannot be deleted, because it still contains dependency finalizers", "dependingComponents", depString)
+			return components.ReconcileResult[*openmcpv1alpha1.Authentication]{Component: auth, Conditions: authenticationConditions(true, cconst.ReasonDeletionWaitingForDependingComponents, fmt.Sprintf("Deletion is waiting for the following dependencies to be removed: [%s]", depString)), Result: ctrl.Result{RequeueAfter: 60 * time.Second}}
+		}
+
+		con := components.NewCondition(cconst.ConditionAuthenticationV2ResourceDeleted, openmcpv1alpha1.ComponentConditionStatusFalse, "", "")
+		released, err := controlplane.ReleaseV2ControlPlane(ctx, ar.Client, auth.Name, auth.Namespace, func(cp *corev2alpha1.ControlPlane) {
+			if cp.Spec.IAM.OIDC != nil {
+				cp.Spec.IAM.OIDC.ExtraProviders = nil
+			}
+		})
+		if err != nil {
+			rerr := openmcperrors.WithReason(err, cconst.ReasonCrateClusterInteractionProblem)
+			con.Reason = rerr.Reason()
+			con.Message = rerr.Error()
+			return components.ReconcileResult[*openmcpv1alpha1.Authentication]{OldComponent: old, Component: auth, ReconcileError: rerr, Conditions: append(authenticationConditions(false, rerr.Reason(), cconst.MessageReconciliationError), con)}
+		}
+		if !released {
+			log.Info("Waiting for v2 ControlPlane to be deleted", "resourceName", auth.Name, "resourceNamespace", auth.Namespace)
+			con.Reason = cconst.ReasonWaitingForV2ControlPlane
+			con.Message = "Waiting for v2 ControlPlane to be deleted"
+			return components.ReconcileResult[*openmcpv1alpha1.Authentication]{OldComponent: old, Component: auth, Result: ctrl.Result{RequeueAfter: 30 * time.Second}, Conditions: append(authenticationConditions(false, cconst.ReasonWaitingForV2ControlPlane, con.Message), con)}
+		}
+
+		// remove the auth dependency finalizers from the dependencies
+		if err := components.EnsureDependencyFinalizers(ctx, ar.Client, auth, deps, false); err != nil {
+			return components.ReconcileResult[*openmcpv1alpha1.Authentication]{Component: auth, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error removing dependency finalizers: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
+		}
+
+		// remove finalizer from auth resource
+		if controllerutil.RemoveFinalizer(auth, openmcpv1alpha1.AuthenticationComponent.Finalizer()) {
+			if err := ar.Client.Patch(ctx, auth, client.MergeFrom(old)); err != nil {
+				return components.ReconcileResult[*openmcpv1alpha1.Authentication]{ReconcileError: openmcperrors.WithReason(fmt.Errorf("error removing finalizer from Authentication: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
+			}
+		}
+
+		auth.Status.UserAccess = nil
+		con.Status = openmcpv1alpha1.ComponentConditionStatusTrue
+		return components.ReconcileResult[*openmcpv1alpha1.Authentication]{OldComponent: old, Component: auth, Conditions: append(authenticationConditions(true, "", ""), con)}
+	}
+
+	log.Info("Triggering creation/update of Authentication")
+	if controllerutil.AddFinalizer(auth, openmcpv1alpha1.AuthenticationComponent.Finalizer()) {
+		log.Debug("Adding finalizer to Authentication resource")
+		if err := ar.Client.Patch(ctx, auth, client.MergeFrom(old)); err != nil {
+			return components.ReconcileResult[*openmcpv1alpha1.Authentication]{ReconcileError: openmcperrors.WithReason(fmt.Errorf("error patching finalizer on Authentication: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
+		}
+		old = auth.DeepCopy()
+	}
+
+	log.Debug("Ensuring dependency finalizers on dependencies")
+	if err := components.EnsureDependencyFinalizers(ctx, ar.Client, auth, deps, true); err != nil {
+		return components.ReconcileResult[*openmcpv1alpha1.Authentication]{Component: auth, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error setting dependency finalizers: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
+	}
+
+	log.Info("Creating or updating v2 ControlPlane", "resourceName", auth.Name, "resourceNamespace", auth.Namespace)
+	con := components.NewCondition(cconst.ConditionAuthenticationV2ResourceCreatedOrUpdated, openmcpv1alpha1.ComponentConditionStatusTrue, "", "")
+	cp, err := controlplane.CreateOrUpdateV2ControlPlane(ctx, ar.Client, auth.Name, auth.Namespace, func(cp *corev2alpha1.ControlPlane) error {
+		if cp.Spec.IAM.OIDC == nil {
+			cp.Spec.IAM.OIDC = &corev2alpha1.OIDCConfig{}
+		}
+		cp.Spec.IAM.OIDC.ExtraProviders = v2OIDCProviders(auth.Spec.IdentityProviders, cp.Spec.IAM.OIDC.ExtraProviders)
+		return nil
+	})
+	if err != nil {
+		rerr := openmcperrors.WithReason(err, cconst.ReasonCrateClusterInteractionProblem)
+		con.Status = openmcpv1alpha1.ComponentConditionStatusFalse
+		con.Reason = rerr.Reason()
+		con.Message = rerr.Error()
+		return components.ReconcileResult[*openmcpv1alpha1.Authentication]{OldComponent: old, Component: auth, ReconcileError: rerr, Conditions: append(authenticationConditions(false, rerr.Reason(), cconst.MessageReconciliationError), con)}
+	}
+
+	auth.Status.UserAccess = v2UserAccess(auth, cp)
+
+	cons := authenticationConditions(true, "", "")
+	var res ctrl.Result
+	if !controlplane.IsV2ControlPlaneReady(cp) {
+		cons = authenticationConditions(false, cconst.ReasonWaitingForV2ControlPlane, "Waiting for v2 ControlPlane to become ready")
+		res.RequeueAfter = 30 * time.Second
+	}
+	cons = append(cons, controlplane.V2ControlPlaneConditions(cp, v2ConditionPrefix)...)
+	cons = append(cons, con)
+	return components.ReconcileResult[*openmcpv1alpha1.Authentication]{OldComponent: old, Component: auth, Result: res, Conditions: cons}
+}
+
+// v2OIDCProviders converts the given identity providers into OIDC provider configurations for the v2 ControlPlane.
+// The role bindings of already existing providers with the same name are kept, because they are managed by the Authorization component.
+// Fields which the v2 architecture doesn't support (caBundle, signingAlgs, requiredClaims) are ignored.
+func v2OIDCProviders(idps []openmcpv1alpha1.IdentityProvider, existing []commonapi.OIDCProviderConfig) []commonapi.OIDCProviderConfig {
+	if len(idps) == 0 {
+		return nil
+	}
+	roleBindings := make(map[string][]commonapi.RoleBindings, len(existing))
+	for _, p := range existing {
+		roleBindings[p.Name] = p.RoleBindings
+	}
+	res := make([]commonapi.OIDCProviderConfig, 0, len(idps))
+	for _, idp := range idps {
+		p := commonapi.OIDCProviderConfig{
+			Name:          idp.Name,
+			Issuer:        idp.IssuerURL,
+			ClientID:      idp.ClientID,
+			UsernameClaim: idp.UsernameClaim,
+			GroupsClaim:   idp.GroupsClaim,
+			RoleBindings:  roleBindings[idp.Name],
+		}
+		if scopes, ok := idp.ClientConfig.ExtraConfig[openmcpv1alpha1.OIDCParameterExtraScope]; ok {
+			if scopes.Value != "" {
+				p.ExtraScopes = append(p.ExtraScopes, scopes.Value)
+			}
+			p.ExtraScopes = append(p.ExtraScopes, scopes.Values...)
+		}
+		if p.RoleBindings == nil {
+			p.RoleBindings = []commonapi.RoleBindings{}
+		}
+		res = append(res, p)
+	}
+	return res
+}
+
+// v2UserAccess returns a reference to the kubeconfig secret which the v2 ControlPlane exposes for the Authentication's default identity provider.
+// Like in v1, the first configured identity provider is the default one, with a fallback to the system identity provider.
+// Returns nil if the secret is not (yet) available.
+func v2UserAccess(auth *openmcpv1alpha1.Authentication, cp *corev2alpha1.ControlPlane) *openmcpv1alpha1.SecretReference {
+	key := "default"
+	if len(auth.Spec.IdentityProviders) > 0 {
+		key = auth.Spec.IdentityProviders[0].Name
+	} else if !auth.IsSystemIdentityProviderEnabled() {
+		return nil
+	}
+	ref, ok := cp.Status.Access[key]
+	if !ok || ref.Name == "" {
+		return nil
+	}
+	return &openmcpv1alpha1.SecretReference{
+		NamespacedObjectReference: openmcpv1alpha1.NamespacedObjectReference{
+			Name:      ref.Name,
+			Namespace: cp.Namespace,
+		},
+		Key: kubeconfigSecretValueKey,
+	}
+}
@@ -13,6 +13,7 @@ import (
 	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
 	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
 	"github.com/openmcp-project/mcp-operator/internal/components"
+	mcpocfg "github.com/openmcp-project/mcp-operator/internal/config"
 	authzconfig "github.com/openmcp-project/mcp-operator/internal/controller/core/authorization/config"
 	"github.com/openmcp-project/mcp-operator/internal/utils"
 	apiserverutils "github.com/openmcp-project/mcp-operator/internal/utils/apiserver"
@@ -105,26 +106,29 @@ func (ar *AuthorizationReconciler) reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
-	// checking for APIServer component
-	log.Debug("Checking for APIServer dependency")
+	// checking for dependencies
+	log.Debug("Checking for dependencies")
 	ownCPGeneration, ownICGeneration, _ := componentutils.GetCreatedFromGeneration(authz)
-	as := &openmcpv1alpha1.APIServer{}
-	as.SetName(authz.Name)
-	as.SetNamespace(authz.Namespace)
-
-	if err := ar.Client.Get(ctx, client.ObjectKeyFromObject(as), as); err != nil {
-		if !apierrors.IsNotFound(err) {
-			return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{Component: authz, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error fetching APIServer resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
+	deps, err := componentutils.GetDependencies(components.Registry, ctx, ar.Client, authz)
+	if err != nil {
+		return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{Component: authz, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error fetching dependencies: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
+	}
+	as, _ := deps[openmcpv1alpha1.APIServerComponent].(*openmcpv1alpha1.APIServer)
+	if as != nil && as.Status.Hibernation.IsHibernated() {
+		if !authz.DeletionTimestamp.IsZero() && !as.DeletionTimestamp.IsZero() {
+			// the cluster is deleted anyway, so there is no need to wait for it to be woken up to clean up the authorization resources
+			log.Info("APIServer is hibernated and in deletion, skipping cleanup of authorization resources")
+			return ar.removeFinalizers(ctx, authz, deps)
 		}
-		// APIServer not found
-		as = nil
+		log.Info("APIServer is hibernated, waiting for it to be woken up")
+		return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{Component: authz, Conditions: authorizationConditions(false, cconst.ReasonDependencyHibernated, "APIServer dependency is hibernated"), Result: reconcile.Result{RequeueAfter: componentutils.DependencyHibernatedRequeueInterval}}
 	}
-	if as == nil || !componentutils.IsDependencyReady(as, ownCPGeneration, ownICGeneration) {
-		log.Info("APIServer not found or it isn't ready")
-		return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{Component: authz, Conditions: authorizationConditions(false, cconst.ReasonWaitingForDependencies, "Waiting for APIServer dependency to be ready"), Result: reconcile.Result{RequeueAfter: 60 * time.Second}}
+	if notReady := componentutils.NotReadyDependencies(deps, ownCPGeneration, ownICGeneration); as == nil || len(notReady) > 0 {
+		log.Info("Dependencies not found or not ready", "notReadyDependencies", notReady)
+		return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{Component: authz, Conditions: authorizationConditions(false, cconst.ReasonWaitingForDependencies, componentutils.WaitingForDependenciesMessage(notReady)), Result: reconcile.Result{RequeueAfter: 60 * time.Second}}
 	}
 
-	log.Debug("APIServer dependency is ready")
+	log.Debug("Dependencies are ready")
 
 	if as.Status.AdminAccess == nil || as.Status.AdminAccess.Kubeconfig == "" {
 		return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{Component: authz, ReconcileError: openmcperrors.WithReason(fmt.Errorf("APIServer dependency is ready, but no kubeconfig could be found in its status"), cconst.ReasonDependencyStatusInvalid)}
@@ -135,6 +139,9 @@ func (ar *AuthorizationReconciler) reconcile(ctx context.Context, req ctrl.Reque
 		return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{Component: authz, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error creating client from APIServer kubeconfig: %w", err), cconst.ReasonDependencyStatusInvalid)}
 	}
 
+	var res ctrl.Result
+	var reason, message string
+	var v2cons []openmcpv1alpha1.ComponentCondition
 	old := authz.DeepCopy()
 	if !authz.DeletionTimestamp.IsZero() {
 		log.Info("Deleting Authorization")
@@ -166,25 +173,26 @@ func (ar *AuthorizationReconciler) reconcile(ctx context.Context, req ctrl.Reque
 			return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{Component: authz, Conditions: authorizationConditions(true, cconst.ReasonDeletionWaitingForDependingComponents, "Deletion is waiting for the ClusterAdmin to be removed"), Result: ctrl.Result{RequeueAfter: 60 * time.Second}}
 		}
 
+		if mcpocfg.Config.Architecture.DecideVersion(authz) == openmcpv1alpha1.ArchitectureV2 {
+			log.Info("Using v2 logic for Authorization")
+			released, con, errr := ar.v2HandleDelete(ctx, authz)
+			if errr != nil {
+				return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{Component: authz, ReconcileError: errr, Conditions: append(authorizationConditions(false, errr.Reason(), cconst.MessageReconciliationError), con)}
+			}
+			if !released {
+				return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{Component: authz, Conditions: append(authorizationConditions(false, cconst.ReasonWaitingForV2ControlPlane, con.Message), con), Result: ctrl.Result{RequeueAfter: 30 * time.Second}}
+			}
+			v2cons = append(v2cons, con)
+		}
+
 		log.Info("Deleting Authorization")
 		if err = ar.deleteAuthorization(ctx, apiServerClient); err != nil {
 			log.Error(err, "error deleting authorization resources")
 			return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{Component: authz, ReconcileError: openmcperrors.WithReason(err, cconst.ReasonManagingAuthorization)}
 		}
 
-		// remove the auth dependency finalizer from the APIServer resource if the auth resource is being deleted
-		err = componentutils.EnsureDependencyFinalizer(ctx, ar.Client, as, authz, false)
-		if err != nil {
-			return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{Component: authz, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error removing dependency finalizer from APIServer component resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
-		}
-
-		// remove finalizer from authz resource
-		old := authz.DeepCopy()
-		changed := controllerutil.RemoveFinalizer(authz, openmcpv1alpha1.AuthorizationComponent.Finalizer())
-		if changed {
-			if err := ar.Client.Patch(ctx, authz, client.MergeFrom(old)); err != nil {
-				return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{ReconcileError: openmcperrors.WithReason(fmt.Errorf("error removing finalizer from Authorization: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
-			}
+		if rr := ar.removeFinalizers(ctx, authz, deps); rr.ReconcileError != nil {
+			return rr
 		}
 	} else {
 		log.Info("Triggering creation/update of Authorization")
@@ -196,10 +204,9 @@ func (ar *AuthorizationReconciler) reconcile(ctx context.Context, req ctrl.Reque
 			}
 		}
 
-		log.Debug("Ensuring dependency finalizer on APIServer resource")
-		err = componentutils.EnsureDependencyFinalizer(ctx, ar.Client, as, authz, true)
-		if err != nil {
-			return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{Component: authz, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error setting dependency finalizer on APIServer component resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
+		log.Debug("Ensuring dependency finalizers on dependencies")
+		if err := componentutils.EnsureDependencyFinalizers(ctx, ar.Client, authz, deps, true); err != nil {
+			return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{Component: authz, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error setting dependency finalizers: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
 		}
 
 		log.Info("Creating/Updating Authorization")
@@ -208,7 +215,24 @@ func (ar *AuthorizationReconciler) reconcile(ctx context.Context, req ctrl.Reque
 			return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{OldComponent: old, Component: authz, ReconcileError: openmcperrors.WithReason(err, cconst.ReasonManagingAuthorization)}
 		}
 
-		if err = ar.ensureClusterRoleBindings(ctx, apiServerClient, authz); err != nil {
+		// in v2, the subjects which can be bound via the v2 ControlPlane are removed from the v1 ClusterRoleBindings
+		clusterRoleBindingsAuthz := authz
+		if mcpocfg.Config.Architecture.DecideVersion(authz) == openmcpv1alpha1.ArchitectureV2 {
+			log.Info("Using v2 logic for Authorization")
+			remaining, ready, con, errr := ar.v2HandleCreateOrUpdate(ctx, authz)
+			if errr != nil {
+				return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{OldComponent: old, Component: authz, ReconcileError: errr, Conditions: append(authorizationConditions(false, errr.Reason(), cconst.MessageReconciliationError), con)}
+			}
+			clusterRoleBindingsAuthz = remaining
+			v2cons = append(v2cons, con)
+			if !ready {
+				reason = cconst.ReasonWaitingForV2ControlPlane
+				message = "Waiting for v2 ControlPlane to become ready"
+				res.RequeueAfter = 30 * time.Second
+			}
+		}
+
+		if err = ar.ensureClusterRoleBindings(ctx, apiServerClient, clusterRoleBindingsAuthz); err != nil {
 			log.Error(err, "error ensuring cluster role bindings")
 			return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{OldComponent: old, Component: authz, ReconcileError: openmcperrors.WithReason(err, cconst.ReasonManagingAuthorization)}
 		}
@@ -219,7 +243,7 @@ func (ar *AuthorizationReconciler) reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
-	return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{OldComponent: old, Component: authz, Conditions: authorizationConditions(true, "", "")}
+	return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{OldComponent: old, Component: authz, Result: res, Conditions: append(authorizationConditions(reason == "", reason, message), v2cons...)}
 }
 
 // ensureClusterRoles creates or updates the cluster roles as defined in the configuration
@@ -546,6 +570,24 @@ func (ar *AuthorizationReconciler) deleteAuthorization(ctx context.Context, apiS
 	return allErrs.ToAggregate()
 }
 
+// removeFinalizers removes the dependency finalizers from the given dependencies and the finalizer from the Authorization resource.
+func (ar *AuthorizationReconciler) removeFinalizers(ctx context.Context, authz *openmcpv1alpha1.Authorization, deps map[openmcpv1alpha1.ComponentType]components.Component) componentutils.ReconcileResult[*openmcpv1alpha1.Authorization] {
+	// remove the authz dependency finalizers from the dependencies if the authz resource is being deleted
+	if err := componentutils.EnsureDependencyFinalizers(ctx, ar.Client, authz, deps, false); err != nil {
+		return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{Component: authz, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error removing dependency finalizers: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
+	}
+
+	// remove finalizer from authz resource
+	old := authz.DeepCopy()
+	changed := controllerutil.RemoveFinalizer(authz, openmcpv1alpha1.AuthorizationComponent.Finalizer())
+	if changed {
+		if err := ar.Client.Patch(ctx, authz, client.MergeFrom(old)); err != nil {
+			return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{ReconcileError: openmcperrors.WithReason(fmt.Errorf("error removing finalizer from Authorization: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
+		}
+	}
+	return componentutils.ReconcileResult[*openmcpv1alpha1.Authorization]{OldComponent: old, Component: authz, Conditions: authorizationConditions(true, "", "")}
+}
+
 // namespacesTask is a cyclic task that updates the Authorization object with the list of user namespaces from the APIServer
 func (ar *AuthorizationReconciler) namespacesTask(ctx context.Context, as *openmcpv1alpha1.APIServer, crateClient, apiServerClient client.Client) error {
 	// get the authorization object
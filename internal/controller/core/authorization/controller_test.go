@@ -4,12 +4,16 @@ import (
 	"strings"
 
 	components "github.com/openmcp-project/mcp-operator/internal/components"
+	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
 
+	mcpocfg "github.com/openmcp-project/mcp-operator/internal/config"
 	"github.com/openmcp-project/mcp-operator/internal/controller/core/authorization"
 	"github.com/openmcp-project/mcp-operator/internal/controller/core/authorization/config"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	commonapi "github.com/openmcp-project/openmcp-operator/api/common"
+	corev2alpha1 "github.com/openmcp-project/openmcp-operator/api/core/v2alpha1"
 	v1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -214,6 +218,35 @@ var _ = Describe("CO-1153 Authorization Controller", func() {
 		))
 	})
 
+	It("should back off when the APIServer is hibernated", func() {
+		var err error
+
+		env := testEnvWithAPIServerAccess("testdata", "test-10")
+
+		authz := &openmcpv1alpha1.Authorization{}
+		err = env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, authz)
+		Expect(err).ToNot(HaveOccurred())
+
+		req := testing.RequestFromObject(authz)
+		res := env.ShouldReconcile(authzReconciler, req)
+		Expect(res.RequeueAfter).To(Equal(componentutils.DependencyHibernatedRequeueInterval))
+
+		err = env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(authz), authz)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(authz.Status.Conditions).To(ConsistOf(
+			MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+				Type:   openmcpv1alpha1.AuthorizationComponent.ReconciliationCondition(),
+				Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+			}),
+			MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+				Type:   openmcpv1alpha1.AuthorizationComponent.HealthyCondition(),
+				Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+				Reason: cconst.ReasonDependencyHibernated,
+			}),
+		))
+	})
+
 	It("should fail to reconcile and set the status condition to false when APIServer status has no access kubeconfig", func() {
 		var err error
 
@@ -1086,6 +1119,93 @@ var _ = Describe("CO-1153 Authorization Controller", func() {
 		Expect(errors.IsNotFound(err)).To(BeTrue())
 	})
 
+	Context("v2", func() {
+
+		BeforeEach(func() {
+			mcpocfg.Config.Architecture.Authorization.Version = openmcpv1alpha1.ArchitectureV2
+		})
+
+		AfterEach(func() {
+			mcpocfg.Config.Architecture.Authorization.Version = openmcpv1alpha1.ArchitectureV1
+		})
+
+		It("should manage the cluster-scoped role bindings of OIDC subjects via the v2 ControlPlane", func() {
+			env := testEnvWithAPIServerAccess("testdata", "test-11")
+
+			authz := &openmcpv1alpha1.Authorization{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, authz)).To(Succeed())
+
+			env.ShouldReconcile(authzReconciler, testing.RequestFromObject(authz))
+
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(authz), authz)).To(Succeed())
+			Expect(authz.Status.Conditions).To(ContainElements(
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   openmcpv1alpha1.AuthorizationComponent.HealthyCondition(),
+					Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+					Reason: cconst.ReasonWaitingForV2ControlPlane,
+				}),
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   cconst.ConditionAuthorizationV2ResourceCreatedOrUpdated,
+					Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+				}),
+			))
+
+			cp := &corev2alpha1.ControlPlane{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(authz), cp)).To(Succeed())
+			Expect(cp.Labels).To(HaveKeyWithValue(openmcpv1alpha1.V1MCPReferenceLabelName, authz.Name))
+			Expect(cp.Spec.IAM.OIDC.DefaultProvider.RoleBindings).To(ConsistOf(commonapi.RoleBindings{
+				Subjects: []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "admin"}},
+				RoleRefs: []commonapi.RoleRef{{Kind: "ClusterRole", Name: openmcpv1alpha1.AdminClusterScopeRole}},
+			}))
+			Expect(cp.Spec.IAM.OIDC.ExtraProviders).To(HaveLen(1))
+			Expect(cp.Spec.IAM.OIDC.ExtraProviders[0].Issuer).To(Equal("https://customer.local"))
+			Expect(cp.Spec.IAM.OIDC.ExtraProviders[0].RoleBindings).To(ConsistOf(
+				commonapi.RoleBindings{
+					Subjects: []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "alice"}},
+					RoleRefs: []commonapi.RoleRef{{Kind: "ClusterRole", Name: openmcpv1alpha1.AdminClusterScopeRole}},
+				},
+				commonapi.RoleBindings{
+					Subjects: []rbacv1.Subject{{Kind: rbacv1.GroupKind, Name: "auditors"}},
+					RoleRefs: []commonapi.RoleRef{{Kind: "ClusterRole", Name: openmcpv1alpha1.ViewClusterScopeRole}},
+				},
+			))
+
+			// subjects which cannot be mapped to an OIDC provider are still bound by the v1 ClusterRoleBindings
+			adminClusterRoleBinding := &rbacv1.ClusterRoleBinding{}
+			Expect(env.Client(testutils.APIServerCluster).Get(env.Ctx, types.NamespacedName{Name: openmcpv1alpha1.AdminClusterRoleBinding}, adminClusterRoleBinding)).To(Succeed())
+			Expect(adminClusterRoleBinding.Subjects).To(ContainElement(rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "pipeline", Namespace: "automate"}))
+			Expect(adminClusterRoleBinding.Subjects).ToNot(ContainElement(HaveField("Name", "openmcp:admin")))
+			Expect(adminClusterRoleBinding.Subjects).ToNot(ContainElement(HaveField("Name", "customer:alice")))
+			viewClusterRoleBinding := &rbacv1.ClusterRoleBinding{}
+			Expect(env.Client(testutils.APIServerCluster).Get(env.Ctx, types.NamespacedName{Name: openmcpv1alpha1.ViewClusterRoleBinding}, viewClusterRoleBinding)).To(Succeed())
+			Expect(viewClusterRoleBinding.Subjects).To(ContainElement(rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "unknown:auditors"}))
+			Expect(viewClusterRoleBinding.Subjects).ToNot(ContainElement(HaveField("Name", "customer:auditors")))
+
+			// the v2 ControlPlane becomes ready
+			cp.Status.Phase = commonapi.StatusPhaseReady
+			cp.Status.ObservedGeneration = cp.Generation
+			Expect(env.Client(testutils.CrateCluster).Status().Update(env.Ctx, cp)).To(Succeed())
+			env.ShouldReconcile(authzReconciler, testing.RequestFromObject(authz))
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(authz), authz)).To(Succeed())
+			Expect(authz.Status.Conditions).To(ContainElement(
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   openmcpv1alpha1.AuthorizationComponent.HealthyCondition(),
+					Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+				}),
+			))
+
+			// the role bindings are removed from the v2 ControlPlane, but the OIDC providers are kept
+			Expect(env.Client(testutils.CrateCluster).Delete(env.Ctx, authz)).To(Succeed())
+			env.ShouldReconcile(authzReconciler, testing.RequestFromObject(authz))
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(authz), authz)).To(MatchError(errors.IsNotFound, "not found"))
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(cp), cp)).To(Succeed())
+			Expect(cp.Spec.IAM.OIDC.DefaultProvider.RoleBindings).To(BeEmpty())
+			Expect(cp.Spec.IAM.OIDC.ExtraProviders).To(HaveLen(1))
+			Expect(cp.Spec.IAM.OIDC.ExtraProviders[0].RoleBindings).To(BeEmpty())
+		})
+
+	})
+
 })
 
 func verifyStandardClusterRole(role *rbacv1.ClusterRole) {
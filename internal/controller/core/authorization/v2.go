@@ -0,0 +1,132 @@
+package authorization
+
+import (
+	"context"
+	"strings"
+
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	commonapi "github.com/openmcp-project/openmcp-operator/api/common"
+	corev2alpha1 "github.com/openmcp-project/openmcp-operator/api/core/v2alpha1"
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
+	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
+	"github.com/openmcp-project/mcp-operator/internal/utils/controlplane"
+)
+
+// In the v2 architecture, the cluster-scoped role bindings for the subjects from the Authorization's spec are managed via the v2 ControlPlane.
+// A subject is moved into the v2 ControlPlane if it is a user or group whose name is prefixed with the name of an OIDC provider known to the v2 ControlPlane,
+// all other subjects as well as the additional subjects from the configuration are still bound by the v1 ClusterRoleBindings.
+// The cluster roles and the namespace-scoped role bindings are managed via the APIServer's admin access, like in v1.
+
+// v2HandleCreateOrUpdate writes the role bindings into the v2 ControlPlane.
+// It returns a copy of the Authorization which only contains the subjects that could not be moved into the v2 ControlPlane
+// and whether the v2 ControlPlane is ready.
+func (ar *AuthorizationReconciler) v2HandleCreateOrUpdate(ctx context.Context, authz *openmcpv1alpha1.Authorization) (*openmcpv1alpha1.Authorization, bool, openmcpv1alpha1.ComponentCondition, openmcperrors.ReasonableError) {
+	log := logging.FromContextOrPanic(ctx).WithName(openmcpv1alpha1.ArchitectureV2)
+	log.Info("Creating or updating role bindings in v2 ControlPlane", "resourceName", authz.Name, "resourceNamespace", authz.Namespace)
+
+	con := componentutils.NewCondition(cconst.ConditionAuthorizationV2ResourceCreatedOrUpdated, openmcpv1alpha1.ComponentConditionStatusTrue, "", "")
+
+	remaining := authz.DeepCopy()
+	cp, err := controlplane.CreateOrUpdateV2ControlPlane(ctx, ar.Client, authz.Name, authz.Namespace, func(cp *corev2alpha1.ControlPlane) error {
+		if cp.Spec.IAM.OIDC == nil {
+			cp.Spec.IAM.OIDC = &corev2alpha1.OIDCConfig{}
+		}
+		remaining.Spec.RoleBindings = setV2RoleBindings(cp.Spec.IAM.OIDC, authz.Spec.RoleBindings)
+		return nil
+	})
+	if err != nil {
+		rerr := openmcperrors.WithReason(err, cconst.ReasonCrateClusterInteractionProblem)
+		con.Status = openmcpv1alpha1.ComponentConditionStatusFalse
+		con.Reason = rerr.Reason()
+		con.Message = rerr.Error()
+		return nil, false, con, rerr
+	}
+
+	return remaining, controlplane.IsV2ControlPlaneReady(cp), con, nil
+}
+
+// v2HandleDelete removes the role bindings from the v2 ControlPlane.
+// It returns true once this is done or the v2 ControlPlane has been deleted.
+func (ar *AuthorizationReconciler) v2HandleDelete(ctx context.Context, authz *openmcpv1alpha1.Authorization) (bool, openmcpv1alpha1.ComponentCondition, openmcperrors.ReasonableError) {
+	log := logging.FromContextOrPanic(ctx).WithName(openmcpv1alpha1.ArchitectureV2)
+
+	con := componentutils.NewCondition(cconst.ConditionAuthorizationV2ResourceDeleted, openmcpv1alpha1.ComponentConditionStatusFalse, "", "")
+
+	released, err := controlplane.ReleaseV2ControlPlane(ctx, ar.Client, authz.Name, authz.Namespace, func(cp *corev2alpha1.ControlPlane) {
+		if cp.Spec.IAM.OIDC != nil {
+			setV2RoleBindings(cp.Spec.IAM.OIDC, nil)
+		}
+	})
+	if err != nil {
+		rerr := openmcperrors.WithReason(err, cconst.ReasonCrateClusterInteractionProblem)
+		con.Reason = rerr.Reason()
+		con.Message = rerr.Error()
+		return false, con, rerr
+	}
+	if !released {
+		log.Info("Waiting for v2 ControlPlane to be deleted", "resourceName", authz.Name, "resourceNamespace", authz.Namespace)
+		con.Reason = cconst.ReasonWaitingForV2ControlPlane
+		con.Message = "Waiting for v2 ControlPlane to be deleted"
+		return false, con, nil
+	}
+
+	log.Info("Role bindings removed from v2 ControlPlane", "resourceName", authz.Name, "resourceNamespace", authz.Namespace)
+	con.Status = openmcpv1alpha1.ComponentConditionStatusTrue
+	return true, con, nil
+}
+
+// setV2RoleBindings replaces the role bindings of all OIDC providers in the given configuration with the ones derived from the given role bindings.
+// It returns the role bindings with the subjects which could not be assigned to any of the OIDC providers.
+func setV2RoleBindings(oidc *corev2alpha1.OIDCConfig, roleBindings []openmcpv1alpha1.RoleBinding) []openmcpv1alpha1.RoleBinding {
+	providers := map[string]*[]commonapi.RoleBindings{
+		corev2alpha1.DefaultOIDCProviderName: &oidc.DefaultProvider.RoleBindings,
+	}
+	for i := range oidc.ExtraProviders {
+		providers[oidc.ExtraProviders[i].Name] = &oidc.ExtraProviders[i].RoleBindings
+	}
+	for _, rbs := range providers {
+		*rbs = nil
+	}
+
+	remaining := make([]openmcpv1alpha1.RoleBinding, 0, len(roleBindings))
+	for _, rb := range roleBindings {
+		subjects := map[string][]rbacv1.Subject{}
+		rest := openmcpv1alpha1.RoleBinding{Role: rb.Role}
+		for _, s := range rb.Subjects {
+			provider, name, ok := strings.Cut(s.Name, ":")
+			if ok && (s.Kind == rbacv1.UserKind || s.Kind == rbacv1.GroupKind) && providers[provider] != nil {
+				subjects[provider] = append(subjects[provider], rbacv1.Subject{Kind: s.Kind, APIGroup: s.APIGroup, Name: name, Namespace: s.Namespace})
+				continue
+			}
+			rest.Subjects = append(rest.Subjects, s)
+		}
+		for provider, ss := range subjects {
+			*providers[provider] = append(*providers[provider], commonapi.RoleBindings{
+				Subjects: ss,
+				RoleRefs: []commonapi.RoleRef{{Kind: "ClusterRole", Name: clusterScopedRoleForRoleBinding(rb.Role)}},
+			})
+		}
+		if len(rest.Subjects) > 0 {
+			remaining = append(remaining, rest)
+		}
+	}
+
+	for i := range oidc.ExtraProviders {
+		if oidc.ExtraProviders[i].RoleBindings == nil {
+			oidc.ExtraProviders[i].RoleBindings = []commonapi.RoleBindings{}
+		}
+	}
+	return remaining
+}
+
+// clusterScopedRoleForRoleBinding returns the name of the cluster-scoped ClusterRole for the given role from the Authorization's spec.
+func clusterScopedRoleForRoleBinding(role string) string {
+	if role == openmcpv1alpha1.RoleBindingRoleAdmin {
+		return openmcpv1alpha1.AdminClusterScopeRole
+	}
+	return openmcpv1alpha1.ViewClusterScopeRole
+}
@@ -9,10 +9,13 @@ import (
 	"strings"
 	"time"
 
+	mcpcomponents "github.com/openmcp-project/mcp-operator/internal/components"
+	mcpocfg "github.com/openmcp-project/mcp-operator/internal/config"
 	"github.com/openmcp-project/mcp-operator/internal/utils"
 	"github.com/openmcp-project/mcp-operator/internal/utils/components"
 
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -86,11 +89,12 @@ var (
 	errModifyingControlPlane     openmcperrors.ReasonableError = openmcperrors.WithReason(errors.New("unable to create or update Cloud Orchestrator ControlPlane resource"), cconst.ReasonCOCoreClusterInteractionProblem)
 )
 
-func NewCloudOrchestratorController(crateClient, coreClient client.Client, coreCluster cluster.Cluster) *CloudOrchestratorReconciler {
+func NewCloudOrchestratorController(crateClient, coreClient, platformClient client.Client, coreCluster cluster.Cluster) *CloudOrchestratorReconciler {
 	return &CloudOrchestratorReconciler{
-		CoreCluster: coreCluster,
-		CoreClient:  coreClient,
-		CrateClient: crateClient,
+		CoreCluster:    coreCluster,
+		CoreClient:     coreClient,
+		CrateClient:    crateClient,
+		PlatformClient: platformClient,
 	}
 }
 
@@ -99,6 +103,8 @@ type CloudOrchestratorReconciler struct {
 	CoreCluster cluster.Cluster
 	CoreClient  client.Client
 	CrateClient client.Client
+	// PlatformClient is only used for CloudOrchestrators which are reconciled with the v2 logic.
+	PlatformClient client.Client
 }
 
 // +kubebuilder:rbac:groups=core.openmcp.cloud,resources=cloudorchestrators,verbs=get;list;watch;create;update;patch;delete
@@ -166,29 +172,28 @@ func (r *CloudOrchestratorReconciler) reconcile(ctx context.Context, req ctrl.Re
 		coreControlPlane = nil
 	}
 
-	// checking for APIServer component
-	log.Debug("Checking for APIServer dependency")
+	// checking for dependencies
+	log.Debug("Checking for dependencies")
 	ownCPGeneration, ownICGeneration, _ := components.GetCreatedFromGeneration(co)
-	as := &openmcpv1alpha1.APIServer{}
-	if err := r.CrateClient.Get(ctx, req.NamespacedName, as); err != nil {
-		if !apierrors.IsNotFound(err) {
-			return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error fetching APIServer resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}, coreControlPlane, "", ""
-		}
-		// APIServer not found
-		as = nil
+	deps, err := components.GetDependencies(mcpcomponents.Registry, ctx, r.CrateClient, co)
+	if err != nil {
+		return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error fetching dependencies: %w", err), cconst.ReasonCrateClusterInteractionProblem)}, coreControlPlane, "", ""
 	}
+	as, _ := deps[openmcpv1alpha1.APIServerComponent].(*openmcpv1alpha1.APIServer)
 
-	if as == nil || !components.IsDependencyReady(as, ownCPGeneration, ownICGeneration) {
-		log.Info("APIServer not found or it isn't ready")
-		return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, Result: ctrl.Result{RequeueAfter: 60 * time.Second}}, coreControlPlane, cconst.ReasonWaitingForDependencies, "Waiting for APIServer dependency to be ready."
+	// the deletion doesn't require the APIServer to be reachable, so a hibernated APIServer must not block it
+	apiServerHibernated := as != nil && as.Status.Hibernation.IsHibernated()
+	if apiServerHibernated && co.DeletionTimestamp.IsZero() {
+		log.Info("APIServer is hibernated, waiting for it to be woken up")
+		return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, Result: ctrl.Result{RequeueAfter: components.DependencyHibernatedRequeueInterval}}, coreControlPlane, cconst.ReasonDependencyHibernated, "APIServer dependency is hibernated."
+	}
+	// only the APIServer is required to be ready, Authentication and Authorization don't exist if they are not configured in the ManagedControlPlane
+	// they are protected from deletion via dependency finalizers if they exist
+	if notReady := components.NotReadyDependencies(components.FilterDependencies(deps, openmcpv1alpha1.APIServerComponent), ownCPGeneration, ownICGeneration); as == nil || (!apiServerHibernated && len(notReady) > 0) {
+		log.Info("Dependencies not found or not ready", "notReadyDependencies", notReady)
+		return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, Result: ctrl.Result{RequeueAfter: 60 * time.Second}}, coreControlPlane, cconst.ReasonWaitingForDependencies, components.WaitingForDependenciesMessage(notReady)
 	}
-	log.Debug("APIServer dependency is ready")
-	auth := &openmcpv1alpha1.Authentication{}
-	auth.SetName(co.Name)
-	auth.SetNamespace(co.Namespace)
-	authz := &openmcpv1alpha1.Authorization{}
-	authz.SetName(co.Name)
-	authz.SetNamespace(co.Namespace)
+	log.Debug("Dependencies are ready")
 
 	if as.Spec.Type != openmcpv1alpha1.Gardener && as.Spec.Type != openmcpv1alpha1.GardenerDedicated {
 		log.Info("APIServer is not of type Gardener/GardenerDedicated")
@@ -199,6 +204,31 @@ func (r *CloudOrchestratorReconciler) reconcile(ctx context.Context, req ctrl.Re
 		return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, ReconcileError: openmcperrors.WithReason(fmt.Errorf("APIServer dependency is ready, but no kubeconfig could be found in its status"), cconst.ReasonDependencyStatusInvalid)}, coreControlPlane, "", ""
 	}
 
+	// in v2, the ControlPlane resource gets its own access instead of the APIServer's admin access
+	asStatus := &as.Status
+	isV2 := mcpocfg.Config.Architecture.DecideVersion(co) == openmcpv1alpha1.ArchitectureV2
+	var v2cons []openmcpv1alpha1.ComponentCondition
+	var v2res ctrl.Result
+	if isV2 && (co.DeletionTimestamp.IsZero() || coreControlPlane != nil) {
+		log.Info("Using v2 logic for CloudOrchestrator")
+		res, access, con, errr := r.v2HandleAccess(ctx, co)
+		v2cons = append(v2cons, con)
+		if errr != nil {
+			return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, ReconcileError: errr, Conditions: v2cons}, coreControlPlane, "", ""
+		}
+		if access == nil && co.DeletionTimestamp.IsZero() {
+			log.Info("Waiting for AccessRequest to be granted")
+			return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, Result: res, Conditions: v2cons}, coreControlPlane, con.Reason, con.Message
+		}
+		// without access, the ControlPlane resource is not updated during the deletion
+		asStatus = nil
+		if access != nil {
+			asStatus = as.Status.DeepCopy()
+			asStatus.AdminAccess = access
+		}
+		v2res = res
+	}
+
 	// only create the ControlPlane resource if it doesn't exist yet and the CO resource is not being deleted
 	if co.DeletionTimestamp.IsZero() && coreControlPlane == nil {
 		// ControlPlane from Core Cluster
@@ -212,10 +242,10 @@ func (r *CloudOrchestratorReconciler) reconcile(ctx context.Context, req ctrl.Re
 	// create or update the ControlPlane resource in the Core Cluster
 	// this will handle both creation and update scenarios
 	// it is not being called when in deletion and the control plane doesn't exist anymore
-	if coreControlPlane != nil {
+	if coreControlPlane != nil && asStatus != nil {
 		// create or update the CO ControlPlane with the configuration from the openmcpv1alpha1.CloudOrchestrator CR
 		_, err = controllerutil.CreateOrUpdate(ctx, r.CoreClient, coreControlPlane, func() error {
-			spec, err := convertToControlPlaneSpec(&co.Spec, &as.Status)
+			spec, err := convertToControlPlaneSpec(&co.Spec, asStatus)
 			if err != nil {
 				return err
 			}
@@ -255,17 +285,19 @@ func (r *CloudOrchestratorReconciler) reconcile(ctx context.Context, req ctrl.Re
 			return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{OldComponent: oldCO, Component: co, Reason: cconst.ReasonComponentIsInDeletion, Result: ctrl.Result{RequeueAfter: 10 * time.Second}}, coreControlPlane, "", ""
 		}
 
-		// remove dependency finalizer from APIServer resource
-		if err = components.EnsureDependencyFinalizer(ctx, r.CrateClient, as, co, false); err != nil {
-			return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error removing dependency finalizer from APIServer component resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}, coreControlPlane, "", ""
-		}
-		// remove dependency finalizer from Authentication resource
-		if err := components.EnsureDependencyFinalizer(ctx, r.CrateClient, auth, co, false); client.IgnoreNotFound(err) != nil {
-			return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error removing dependency finalizer from Authentication component resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}, coreControlPlane, "", ""
+		if isV2 {
+			deleted, con, errr := r.v2HandleDelete(ctx, co)
+			if errr != nil {
+				return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, ReconcileError: errr, Conditions: []openmcpv1alpha1.ComponentCondition{con}}, nil, "", ""
+			}
+			if !deleted {
+				return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, Reason: cconst.ReasonComponentIsInDeletion, Result: ctrl.Result{RequeueAfter: 30 * time.Second}, Conditions: []openmcpv1alpha1.ComponentCondition{con}}, nil, con.Reason, con.Message
+			}
 		}
-		// remove dependency finalizer from Authorization resource
-		if err := components.EnsureDependencyFinalizer(ctx, r.CrateClient, authz, co, false); client.IgnoreNotFound(err) != nil {
-			return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error removing dependency finalizer from Authorization component resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}, coreControlPlane, "", ""
+
+		// remove dependency finalizers from dependencies
+		if err := components.EnsureDependencyFinalizers(ctx, r.CrateClient, co, deps, false); err != nil {
+			return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error removing dependency finalizers: %w", err), cconst.ReasonCrateClusterInteractionProblem)}, coreControlPlane, "", ""
 		}
 
 		// remove finalizer from CloudOrchestrator resource
@@ -290,17 +322,9 @@ func (r *CloudOrchestratorReconciler) reconcile(ctx context.Context, req ctrl.Re
 		}
 	}
 
-	log.Debug("Ensuring dependency finalizer on APIServer resource")
-	if err := components.EnsureDependencyFinalizer(ctx, r.CrateClient, as, co, true); err != nil {
-		return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error setting dependency finalizer on APIServer component resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}, coreControlPlane, "", ""
-	}
-	log.Debug("Ensuring dependency finalizer on Authentication resource")
-	if err := components.EnsureDependencyFinalizer(ctx, r.CrateClient, auth, co, true); err != nil {
-		return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error setting dependency finalizer on Authentication component resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}, coreControlPlane, "", ""
-	}
-	log.Debug("Ensuring dependency finalizer on Authorization resource")
-	if err := components.EnsureDependencyFinalizer(ctx, r.CrateClient, authz, co, true); err != nil {
-		return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error setting dependency finalizer on Authorization component resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}, coreControlPlane, "", ""
+	log.Debug("Ensuring dependency finalizers on dependencies")
+	if err := components.EnsureDependencyFinalizers(ctx, r.CrateClient, co, deps, true); err != nil {
+		return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{Component: co, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error setting dependency finalizers: %w", err), cconst.ReasonCrateClusterInteractionProblem)}, coreControlPlane, "", ""
 	}
 
 	if coreControlPlane == nil {
@@ -310,7 +334,7 @@ func (r *CloudOrchestratorReconciler) reconcile(ctx context.Context, req ctrl.Re
 	// find out if the CO ControlPlane resource is Ready
 	isReady := r.isCloudOrchestratorReady(coreControlPlane.Status)
 
-	res := ctrl.Result{}
+	res := v2res
 	reason := ""
 	message := ""
 	if !isReady {
@@ -322,7 +346,7 @@ func (r *CloudOrchestratorReconciler) reconcile(ctx context.Context, req ctrl.Re
 	// update CO status
 	old = co.DeepCopy()
 	updateCloudOrchestratorStatus(co, coreControlPlane)
-	return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{OldComponent: old, Component: co, Result: res}, coreControlPlane, reason, message
+	return components.ReconcileResult[*openmcpv1alpha1.CloudOrchestrator]{OldComponent: old, Component: co, Result: res, Conditions: v2cons}, coreControlPlane, reason, message
 }
 
 func updateCloudOrchestratorStatus(co *openmcpv1alpha1.CloudOrchestrator, coreControlPlane *corev1beta1.ControlPlane) {
@@ -331,9 +355,14 @@ func updateCloudOrchestratorStatus(co *openmcpv1alpha1.CloudOrchestrator, coreCo
 }
 
 // SetupWithManager sets up the controller with the Manager.
+// It also registers the controller as DownstreamPlanner for CloudOrchestrators.
 func (r *CloudOrchestratorReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	mcpcomponents.Planners.Register(openmcpv1alpha1.CloudOrchestratorComponent, r)
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openmcpv1alpha1.CloudOrchestrator{}).
+		Watches(&openmcpv1alpha1.APIServer{}, &handler.EnqueueRequestForObject{}, builder.WithPredicates(components.StatusChangedPredicate{})).
+		Watches(&openmcpv1alpha1.Authentication{}, &handler.EnqueueRequestForObject{}, builder.WithPredicates(components.StatusChangedPredicate{})).
+		Watches(&openmcpv1alpha1.Authorization{}, &handler.EnqueueRequestForObject{}, builder.WithPredicates(components.StatusChangedPredicate{})).
 		WatchesRawSource(source.Kind(r.CoreCluster.GetCache(), &corev1beta1.ControlPlane{}, handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, t *corev1beta1.ControlPlane) []reconcile.Request {
 			mcpName := t.Labels[openmcpv1alpha1.ManagedControlPlaneBackReferenceLabelName]
 			mcpNamespace := t.Labels[openmcpv1alpha1.ManagedControlPlaneBackReferenceLabelNamespace]
@@ -2,6 +2,8 @@ package cloudorchestrator_test
 
 import (
 	"path"
+	"strconv"
+	"time"
 
 	"github.com/openmcp-project/mcp-operator/internal/components"
 
@@ -10,6 +12,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1beta1 "github.com/openmcp-project/control-plane-operator/api/v1beta1"
+	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
+	commonapi "github.com/openmcp-project/openmcp-operator/api/common"
+	openmcpclusterutils "github.com/openmcp-project/openmcp-operator/lib/utils"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -23,6 +29,7 @@ import (
 
 	cconst "github.com/openmcp-project/mcp-operator/api/constants"
 	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	mcpocfg "github.com/openmcp-project/mcp-operator/internal/config"
 	testutils "github.com/openmcp-project/mcp-operator/test/utils"
 )
 
@@ -31,7 +38,7 @@ const (
 )
 
 func getReconciler(c ...client.Client) reconcile.Reconciler {
-	return cloudorchestrator.NewCloudOrchestratorController(c[0], c[1], nil)
+	return cloudorchestrator.NewCloudOrchestratorController(c[0], c[1], nil, nil)
 }
 
 func testEnvSetup(crateObjectsPath, coObjectsPath string, coDynamicObjects ...client.Object) *testing.ComplexEnvironment {
@@ -204,6 +211,31 @@ var _ = Describe("CO-1153 CloudOrchestrator Controller", func() {
 		Expect(cp.Spec.Kyverno.Version).To(Equal("3.2.7"))                            // configured
 	})
 
+	It("should create the ControlPlane resource if no Authorization is configured for the ManagedControlPlane", func() {
+		env := testEnvSetup(path.Join("testdata", "test-10"), "")
+
+		co := &openmcpv1alpha1.CloudOrchestrator{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, co)).To(Succeed())
+
+		req := testing.RequestFromObject(co)
+		_ = env.ShouldReconcile(coReconciler, req)
+
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(co), co)).To(Succeed())
+		Expect(co.Status.Conditions).To(ContainElement(MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+			Type:   openmcpv1alpha1.CloudOrchestratorComponent.HealthyCondition(),
+			Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+			Reason: cconst.ReasonWaitingForCloudOrchestrator,
+		})))
+
+		cp := &corev1beta1.ControlPlane{}
+		Expect(env.Client(testutils.COCoreCluster).Get(env.Ctx, types.NamespacedName{Name: "test--test"}, cp)).To(Succeed())
+
+		// the existing, not yet ready Authentication is protected by a dependency finalizer nonetheless
+		auth := &openmcpv1alpha1.Authentication{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(co), auth)).To(Succeed())
+		Expect(auth.Finalizers).To(ContainElement(openmcpv1alpha1.CloudOrchestratorComponent.DependencyFinalizer()))
+	})
+
 	It("should delete the ControlPlane resource and then the CO Resource", func() {
 		var err error
 
@@ -386,6 +418,44 @@ var _ = Describe("CO-1153 CloudOrchestrator Controller", func() {
 		Expect(cp.Spec.CertManager.Version).To(Equal("1.16.1"))
 	})
 
+	It("should plan changes of the ControlPlane resource without applying them", func() {
+		env := testEnvSetup(path.Join("testdata", "test-05"), "")
+		planner := cloudorchestrator.NewCloudOrchestratorController(env.Client(testutils.CrateCluster), env.Client(testutils.COCoreCluster), nil, nil)
+
+		co := &openmcpv1alpha1.CloudOrchestrator{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, co)).To(Succeed())
+
+		changes, err := planner.PlanDownstream(env.Ctx, co, co)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0].Kind).To(Equal("ControlPlane"))
+		Expect(changes[0].Name).To(Equal("test--test"))
+		Expect(changes[0].Action).To(Equal(components.PlanActionCreate))
+		Expect(changes[0].Desired).To(HaveKeyWithValue("spec", HaveKeyWithValue("target", Not(HaveKey("kubeconfig")))))
+		cp := &corev1beta1.ControlPlane{}
+		err = env.Client(testutils.COCoreCluster).Get(env.Ctx, types.NamespacedName{Name: "test--test"}, cp)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		_ = env.ShouldReconcile(coReconciler, testing.RequestFromObject(co))
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(co), co)).To(Succeed())
+		changes, err = planner.PlanDownstream(env.Ctx, co, co)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0].Action).To(Equal(components.PlanActionNone))
+		Expect(changes[0].ChangedFields).To(BeEmpty())
+
+		co.Spec.ExternalSecretsOperator = &openmcpv1alpha1.ExternalSecretsOperatorConfig{
+			Version: "0.10.0",
+		}
+		changes, err = planner.PlanDownstream(env.Ctx, co, co)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0].Action).To(Equal(components.PlanActionUpdate))
+		Expect(changes[0].ChangedFields).To(ConsistOf("spec.externalSecretsOperator"))
+		Expect(env.Client(testutils.COCoreCluster).Get(env.Ctx, types.NamespacedName{Name: "test--test"}, cp)).To(Succeed())
+		Expect(cp.Spec.ExternalSecretsOperator).To(BeNil())
+	})
+
 	It("should remove Crossplane configuration from the ControlPlane resource", func() {
 		var err error
 
@@ -499,4 +569,106 @@ var _ = Describe("CO-1153 CloudOrchestrator Controller", func() {
 		Expect(cp.Spec.Kyverno).ToNot(BeNil())
 		Expect(cp.Spec.Kyverno.Version).To(Equal("8.8.8"))
 	})
+
+	Context("v2", func() {
+
+		BeforeEach(func() {
+			mcpocfg.Config.Architecture.CloudOrchestrator.Version = openmcpv1alpha1.ArchitectureV2
+		})
+
+		AfterEach(func() {
+			mcpocfg.Config.Architecture.CloudOrchestrator.Version = openmcpv1alpha1.ArchitectureV1
+		})
+
+		It("should fail if no platform cluster is configured", func() {
+			env := testEnvSetup(path.Join("testdata", "test-08"), "")
+
+			co := &openmcpv1alpha1.CloudOrchestrator{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, co)).To(Succeed())
+
+			_ = env.ShouldNotReconcile(coReconciler, testing.RequestFromObject(co))
+
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(co), co)).To(Succeed())
+			Expect(co.Status.Conditions).To(ContainElement(
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   cconst.ConditionCloudOrchestratorAccessRequestGranted,
+					Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+					Reason: cconst.ReasonConfigurationProblem,
+				}),
+			))
+		})
+
+		It("should use its own AccessRequest for the ControlPlane resource", func() {
+			env := testutils.DefaultTestSetupBuilder("testdata", "test-08").
+				WithFakeClient(testutils.COCoreCluster, testutils.Scheme).
+				WithFakeClient(testutils.LaaSCoreCluster, testutils.Scheme).
+				WithDynamicObjectsWithStatus(testutils.LaaSCoreCluster, &clustersv1alpha1.AccessRequest{}).
+				WithReconcilerConstructor(coReconciler, func(c ...client.Client) reconcile.Reconciler {
+					return cloudorchestrator.NewCloudOrchestratorController(c[0], c[1], c[2], nil)
+				}, testutils.CrateCluster, testutils.COCoreCluster, testutils.LaaSCoreCluster).
+				Build()
+
+			co := &openmcpv1alpha1.CloudOrchestrator{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, co)).To(Succeed())
+
+			res := env.ShouldReconcile(coReconciler, testing.RequestFromObject(co))
+			Expect(res.RequeueAfter).To(BeNumerically(">", 0))
+
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(co), co)).To(Succeed())
+			Expect(co.Status.Conditions).To(ContainElements(
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   openmcpv1alpha1.CloudOrchestratorComponent.HealthyCondition(),
+					Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+					Reason: cconst.ReasonAccessRequestNotGranted,
+				}),
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   cconst.ConditionCloudOrchestratorAccessRequestGranted,
+					Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+					Reason: cconst.ReasonAccessRequestNotGranted,
+				}),
+			))
+
+			// no ControlPlane resource without access
+			cp := &corev1beta1.ControlPlane{}
+			Expect(env.Client(testutils.COCoreCluster).Get(env.Ctx, types.NamespacedName{Name: "test--test"}, cp)).To(MatchError(apierrors.IsNotFound, "not found"))
+
+			nsName, err := openmcpclusterutils.StableMCPNamespace(co.Name, co.Namespace)
+			Expect(err).ToNot(HaveOccurred())
+			ar := &clustersv1alpha1.AccessRequest{}
+			ar.Name = "test-co"
+			ar.Namespace = nsName
+			Expect(env.Client(testutils.LaaSCoreCluster).Get(env.Ctx, client.ObjectKeyFromObject(ar), ar)).To(Succeed())
+			Expect(ar.Spec.RequestRef).ToNot(BeNil())
+			Expect(ar.Spec.RequestRef.Name).To(Equal(co.Name))
+			Expect(ar.Labels).To(HaveKeyWithValue(openmcpv1alpha1.V1MCPReferenceLabelName, co.Name))
+
+			// mock AccessRequest status and secret
+			access := &corev1.Secret{}
+			access.Name = "test-co-access"
+			access.Namespace = ar.Namespace
+			access.Data = map[string][]byte{
+				"kubeconfig":          []byte("fake"),
+				"creationTimestamp":   []byte(strconv.FormatInt(time.Now().Unix(), 10)),
+				"expirationTimestamp": []byte(strconv.FormatInt(time.Now().Add(24*time.Hour).Unix(), 10)),
+			}
+			Expect(env.Client(testutils.LaaSCoreCluster).Create(env.Ctx, access)).To(Succeed())
+			ar.Status.Phase = clustersv1alpha1.REQUEST_GRANTED
+			ar.Status.SecretRef = &commonapi.LocalObjectReference{Name: access.Name}
+			Expect(env.Client(testutils.LaaSCoreCluster).Status().Update(env.Ctx, ar)).To(Succeed())
+
+			res = env.ShouldReconcile(coReconciler, testing.RequestFromObject(co))
+			Expect(res.RequeueAfter).To(BeNumerically(">", time.Hour))
+
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(co), co)).To(Succeed())
+			Expect(co.Status.Conditions).To(ContainElement(
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   cconst.ConditionCloudOrchestratorAccessRequestGranted,
+					Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+				}),
+			))
+			Expect(env.Client(testutils.COCoreCluster).Get(env.Ctx, types.NamespacedName{Name: "test--test"}, cp)).To(Succeed())
+			Expect(cp.Spec.Target.Kubeconfig).NotTo(BeNil())
+		})
+
+	})
 })
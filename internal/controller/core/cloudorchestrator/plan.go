@@ -0,0 +1,88 @@
+package cloudorchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openmcp-project/mcp-operator/internal/components"
+	"github.com/openmcp-project/mcp-operator/internal/utils"
+	"github.com/openmcp-project/mcp-operator/internal/utils/drift"
+
+	corev1beta1 "github.com/openmcp-project/control-plane-operator/api/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+)
+
+var _ components.DownstreamPlanner = &CloudOrchestratorReconciler{}
+
+// PlanDownstream implements components.DownstreamPlanner.
+// It computes the ControlPlane which would be written to the Core cluster and compares it to the existing one, if any.
+// The target kubeconfig is not part of the plan, as it contains credentials.
+func (r *CloudOrchestratorReconciler) PlanDownstream(ctx context.Context, desired, _ components.Component) ([]components.DownstreamChange, error) {
+	co, ok := desired.(*openmcpv1alpha1.CloudOrchestrator)
+	if !ok {
+		return nil, fmt.Errorf("expected component of type *CloudOrchestrator, got %T", desired)
+	}
+
+	// the kubeconfig is removed from the spec anyway, so a placeholder is sufficient
+	spec, err := convertToControlPlaneSpec(&co.Spec, &openmcpv1alpha1.APIServerStatus{AdminAccess: &openmcpv1alpha1.APIServerAccess{Kubeconfig: "{}"}})
+	if err != nil {
+		return nil, fmt.Errorf("error converting CloudOrchestrator spec: %w", err)
+	}
+	spec.Target.Kubeconfig = nil
+	labels, err := r.copyLabels(ctx, co)
+	if err != nil {
+		return nil, fmt.Errorf("error computing ControlPlane labels: %w", err)
+	}
+	desiredData, err := comparableControlPlaneData(labels, spec)
+	if err != nil {
+		return nil, fmt.Errorf("error converting desired ControlPlane: %w", err)
+	}
+
+	change := components.DownstreamChange{
+		Kind:    "ControlPlane",
+		Name:    utils.PrefixWithNamespace(co.Namespace, co.Name),
+		Desired: desiredData,
+	}
+	live := &corev1beta1.ControlPlane{}
+	if err := r.CoreClient.Get(ctx, client.ObjectKey{Name: change.Name}, live); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error fetching ControlPlane '%s': %w", change.Name, err)
+		}
+		change.Action = components.PlanActionCreate
+		return []components.DownstreamChange{change}, nil
+	}
+	liveSpec := live.Spec.DeepCopy()
+	liveSpec.Target.Kubeconfig = nil
+	liveData, err := comparableControlPlaneData(live.Labels, liveSpec)
+	if err != nil {
+		return nil, fmt.Errorf("error converting live ControlPlane: %w", err)
+	}
+	change.ChangedFields = drift.Diff(liveData, desiredData)
+	change.Action = components.PlanActionNone
+	if len(change.ChangedFields) > 0 {
+		change.Action = components.PlanActionUpdate
+	}
+	return []components.DownstreamChange{change}, nil
+}
+
+// comparableControlPlaneData returns the parts of a ControlPlane which are managed by the controller in unstructured form.
+func comparableControlPlaneData(labels map[string]string, spec *corev1beta1.ControlPlaneSpec) (map[string]any, error) {
+	uSpec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(spec)
+	if err != nil {
+		return nil, err
+	}
+	uLabels := make(map[string]any, len(labels))
+	for k, v := range labels {
+		uLabels[k] = v
+	}
+	return map[string]any{
+		"metadata": map[string]any{
+			"labels": uLabels,
+		},
+		"spec": uSpec,
+	}, nil
+}
@@ -0,0 +1,172 @@
+package cloudorchestrator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openmcp-project/controller-utils/pkg/clusteraccess"
+	"github.com/openmcp-project/controller-utils/pkg/collections"
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
+	clustersconst "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1/constants"
+	openmcpclusterutils "github.com/openmcp-project/openmcp-operator/lib/utils"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
+	apiservercontroller "github.com/openmcp-project/mcp-operator/internal/controller/core/apiserver"
+	"github.com/openmcp-project/mcp-operator/internal/utils/components"
+)
+
+// In the v2 architecture, the CloudOrchestrator doesn't use the admin access of the APIServer,
+// but requests its own access to the ManagedControlPlane's cluster via an AccessRequest on the platform cluster.
+// The AccessRequest references the APIServer's ClusterRequest and lives in the same namespace.
+
+// v2AccessRequestName returns the name of the AccessRequest for the given CloudOrchestrator.
+func v2AccessRequestName(co *openmcpv1alpha1.CloudOrchestrator) string {
+	return co.Name + "-co"
+}
+
+// v2HandleAccess creates or updates the CloudOrchestrator's AccessRequest and returns the access from the secret it references.
+// The returned access is nil if the AccessRequest has not been granted yet.
+func (r *CloudOrchestratorReconciler) v2HandleAccess(ctx context.Context, co *openmcpv1alpha1.CloudOrchestrator) (ctrl.Result, *openmcpv1alpha1.APIServerAccess, openmcpv1alpha1.ComponentCondition, openmcperrors.ReasonableError) {
+	log := logging.FromContextOrPanic(ctx).WithName(openmcpv1alpha1.ArchitectureV2)
+
+	con := components.NewCondition(cconst.ConditionCloudOrchestratorAccessRequestGranted, openmcpv1alpha1.ComponentConditionStatusFalse, "", "")
+	fail := func(rerr openmcperrors.ReasonableError) (ctrl.Result, *openmcpv1alpha1.APIServerAccess, openmcpv1alpha1.ComponentCondition, openmcperrors.ReasonableError) {
+		con.Reason = rerr.Reason()
+		con.Message = rerr.Error()
+		return ctrl.Result{}, nil, con, rerr
+	}
+
+	if r.PlatformClient == nil {
+		return fail(openmcperrors.WithReason(fmt.Errorf("no platform cluster configured, which is required for the v2 architecture"), cconst.ReasonConfigurationProblem))
+	}
+	nsName, err := openmcpclusterutils.StableMCPNamespace(co.Name, co.Namespace)
+	if err != nil {
+		return fail(openmcperrors.WithReason(fmt.Errorf("failed to compute stable namespace for CloudOrchestrator %s/%s: %w", co.Namespace, co.Name, err), clustersconst.ReasonInternalError))
+	}
+
+	ar := &clustersv1alpha1.AccessRequest{}
+	ar.Name = v2AccessRequestName(co)
+	ar.Namespace = nsName
+	log.Info("Creating or updating AccessRequest", "resourceName", ar.Name, "resourceNamespace", ar.Namespace)
+	arm := apiservercontroller.NewAccessRequestMutator(ar.Name, ar.Namespace, co.Name, nsName, false, []clustersv1alpha1.PermissionsRequest{
+		{
+			Rules: []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{"*"},
+					Resources: []string{"*"},
+					Verbs:     []string{"*"},
+				},
+			},
+		},
+	})
+	arm.MetadataMutator().WithLabels(map[string]string{
+		openmcpv1alpha1.V1MCPReferenceLabelName:      co.Name,
+		openmcpv1alpha1.V1MCPReferenceLabelNamespace: co.Namespace,
+	})
+	if err := resources.CreateOrUpdateResource(ctx, r.PlatformClient, arm); err != nil {
+		return fail(openmcperrors.WithReason(fmt.Errorf("failed to create or update AccessRequest %s/%s: %w", ar.Namespace, ar.Name, err), clustersconst.ReasonPlatformClusterInteractionProblem))
+	}
+	if err := r.PlatformClient.Get(ctx, client.ObjectKeyFromObject(ar), ar); err != nil {
+		return fail(openmcperrors.WithReason(fmt.Errorf("failed to get AccessRequest %s/%s: %w", ar.Namespace, ar.Name, err), clustersconst.ReasonPlatformClusterInteractionProblem))
+	}
+	if ar.Status.Phase != clustersv1alpha1.REQUEST_GRANTED || ar.Status.SecretRef == nil {
+		arMessage := strings.Join(collections.ProjectSliceToSlice(ar.Status.Conditions, func(con metav1.Condition) string {
+			return fmt.Sprintf("[%s] %s", con.Reason, con.Message)
+		}), "\n")
+		if arMessage == "" {
+			arMessage = "<NoMessage>"
+		}
+		con.Reason = cconst.ReasonAccessRequestNotGranted
+		con.Message = fmt.Sprintf("AccessRequest '%s/%s' is not granted or does not reference a secret: %s", ar.Namespace, ar.Name, arMessage)
+		rr := ctrl.Result{RequeueAfter: 30 * time.Second}
+		if ar.Status.Phase == clustersv1alpha1.REQUEST_DENIED {
+			// a denied request will never become granted, so no reason to wait for it
+			rr = ctrl.Result{}
+		}
+		return rr, nil, con, nil
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = ar.Status.SecretRef.Name
+	secret.Namespace = ar.Namespace
+	if err := r.PlatformClient.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		return fail(openmcperrors.WithReason(fmt.Errorf("failed to get Secret %s/%s: %w", secret.Namespace, secret.Name, err), clustersconst.ReasonPlatformClusterInteractionProblem))
+	}
+	access := &openmcpv1alpha1.APIServerAccess{}
+	kcfg, ok := secret.Data["kubeconfig"]
+	if !ok {
+		return fail(openmcperrors.WithReason(fmt.Errorf("kubeconfig not found in secret %s/%s", secret.Namespace, secret.Name), clustersconst.ReasonInternalError))
+	}
+	access.Kubeconfig = string(kcfg)
+	for key, target := range map[string]**metav1.Time{"creationTimestamp": &access.CreationTimestamp, "expirationTimestamp": &access.ExpirationTimestamp} {
+		raw, ok := secret.Data[key]
+		if !ok {
+			return fail(openmcperrors.WithReason(fmt.Errorf("%s not found in secret %s/%s", key, secret.Namespace, secret.Name), clustersconst.ReasonInternalError))
+		}
+		seconds, err := strconv.ParseInt(string(raw), 10, 64)
+		if err != nil {
+			return fail(openmcperrors.WithReason(fmt.Errorf("error parsing %s from secret %s/%s to int64: %w", key, secret.Namespace, secret.Name, err), clustersconst.ReasonInternalError))
+		}
+		*target = &metav1.Time{Time: time.Unix(seconds, 0)}
+	}
+
+	con.Status = openmcpv1alpha1.ComponentConditionStatusTrue
+	return ctrl.Result{
+		RequeueAfter: time.Until(clusteraccess.ComputeTokenRenewalTimeWithRatio(access.CreationTimestamp.Time, access.ExpirationTimestamp.Time, 0.85)),
+	}, access, con, nil
+}
+
+// v2HandleDelete deletes the CloudOrchestrator's AccessRequest.
+// It returns true once the AccessRequest is gone.
+func (r *CloudOrchestratorReconciler) v2HandleDelete(ctx context.Context, co *openmcpv1alpha1.CloudOrchestrator) (bool, openmcpv1alpha1.ComponentCondition, openmcperrors.ReasonableError) {
+	log := logging.FromContextOrPanic(ctx).WithName(openmcpv1alpha1.ArchitectureV2)
+
+	con := components.NewCondition(cconst.ConditionCloudOrchestratorAccessRequestDeleted, openmcpv1alpha1.ComponentConditionStatusFalse, "", "")
+	fail := func(rerr openmcperrors.ReasonableError) (bool, openmcpv1alpha1.ComponentCondition, openmcperrors.ReasonableError) {
+		con.Reason = rerr.Reason()
+		con.Message = rerr.Error()
+		return false, con, rerr
+	}
+
+	if r.PlatformClient == nil {
+		return fail(openmcperrors.WithReason(fmt.Errorf("no platform cluster configured, which is required for the v2 architecture"), cconst.ReasonConfigurationProblem))
+	}
+	nsName, err := openmcpclusterutils.StableMCPNamespace(co.Name, co.Namespace)
+	if err != nil {
+		return fail(openmcperrors.WithReason(fmt.Errorf("failed to compute stable namespace for CloudOrchestrator %s/%s: %w", co.Namespace, co.Name, err), clustersconst.ReasonInternalError))
+	}
+
+	ar := &clustersv1alpha1.AccessRequest{}
+	ar.Name = v2AccessRequestName(co)
+	ar.Namespace = nsName
+	if err := r.PlatformClient.Get(ctx, client.ObjectKeyFromObject(ar), ar); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fail(openmcperrors.WithReason(fmt.Errorf("failed to get AccessRequest %s/%s: %w", ar.Namespace, ar.Name, err), clustersconst.ReasonPlatformClusterInteractionProblem))
+		}
+		con.Status = openmcpv1alpha1.ComponentConditionStatusTrue
+		return true, con, nil
+	}
+
+	if ar.DeletionTimestamp.IsZero() {
+		log.Info("Deleting AccessRequest", "resourceName", ar.Name, "resourceNamespace", ar.Namespace)
+		if err := r.PlatformClient.Delete(ctx, ar); client.IgnoreNotFound(err) != nil {
+			return fail(openmcperrors.WithReason(fmt.Errorf("failed to delete AccessRequest %s/%s: %w", ar.Namespace, ar.Name, err), clustersconst.ReasonPlatformClusterInteractionProblem))
+		}
+	}
+	con.Reason = cconst.ReasonAccessRequestNotDeleted
+	con.Message = fmt.Sprintf("AccessRequest '%s/%s' has not been deleted yet", ar.Namespace, ar.Name)
+	return false, con, nil
+}
@@ -0,0 +1,291 @@
+package componentdefinition
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	openmcpctrlutil "github.com/openmcp-project/controller-utils/pkg/controller"
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/openmcp-project/mcp-operator/internal/components"
+	"github.com/openmcp-project/mcp-operator/internal/utils"
+	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+)
+
+// ControllerName is the name of the controller
+const ControllerName = "ComponentDefinition"
+
+const (
+	// RetryInterval is the interval after which the registration of a ComponentDefinition is retried if it failed.
+	RetryInterval = 1 * time.Minute
+	// DeletionRetryInterval is the interval after which the deletion of a ComponentDefinition is retried if the component's resources still exist.
+	DeletionRetryInterval = 30 * time.Second
+)
+
+// ComponentDefinitionReconciler registers the components defined by ComponentDefinitions in the component registry.
+// Since the registry is process-local, the controller doesn't use leader election and runs in every replica of the operator.
+type ComponentDefinitionReconciler struct {
+	Client     client.Client
+	RESTMapper meta.RESTMapper
+	// OnRegister is called whenever a component has been registered or its registration has changed, with an empty resource of the component.
+	// It is used to make the ManagedControlPlane controller watch the component's resources.
+	// If nil, nothing is called.
+	OnRegister func(comp components.Component) error
+
+	lock sync.Mutex
+	// registered maps the names of all ComponentDefinitions whose components are currently registered to the definition that was used for the registration.
+	registered map[string]*openmcpv1alpha1.ComponentDefinition
+}
+
+// NewComponentDefinitionReconciler creates a new ComponentDefinitionReconciler
+func NewComponentDefinitionReconciler(c client.Client, mapper meta.RESTMapper, onRegister func(comp components.Component) error) *ComponentDefinitionReconciler {
+	return &ComponentDefinitionReconciler{
+		Client:     c,
+		RESTMapper: mapper,
+		OnRegister: onRegister,
+		registered: map[string]*openmcpv1alpha1.ComponentDefinition{},
+	}
+}
+
+// +kubebuilder:rbac:groups=core.openmcp.cloud,resources=componentdefinitions,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=core.openmcp.cloud,resources=componentdefinitions/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core.openmcp.cloud,resources=componentdefinitions/finalizers,verbs=update
+
+// Reconcile registers the component of a ComponentDefinition and unregisters it again once the ComponentDefinition is deleted.
+func (r *ComponentDefinitionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log, ctx := utils.InitializeControllerLogger(ctx, ControllerName)
+	log.Debug(cconst.MsgStartReconcile)
+
+	cd := &openmcpv1alpha1.ComponentDefinition{}
+	if err := r.Client.Get(ctx, req.NamespacedName, cd); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("unable to get resource '%s' from cluster: %w", req.Name, err)
+		}
+		// the finalizer might have been removed by another replica, make sure the component is unregistered here too
+		log.Debug("Resource not found")
+		r.unregister(ctx, req.Name)
+		return ctrl.Result{}, nil
+	}
+
+	if !cd.DeletionTimestamp.IsZero() {
+		return r.handleDelete(ctx, cd)
+	}
+
+	if !controllerutil.ContainsFinalizer(cd, openmcpv1alpha1.ComponentDefinitionFinalizer) {
+		old := cd.DeepCopy()
+		controllerutil.AddFinalizer(cd, openmcpv1alpha1.ComponentDefinitionFinalizer)
+		if err := r.Client.Patch(ctx, cd, client.MergeFrom(old)); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error adding finalizer: %w", err)
+		}
+	}
+
+	res := ctrl.Result{}
+	status := openmcpv1alpha1.ComponentDefinitionStatus{
+		ObservedGeneration: cd.Generation,
+		Registered:         true,
+	}
+	if err := r.register(ctx, cd); err != nil {
+		log.Error(err, "unable to register component", "component", string(cd.Spec.Type))
+		status.Registered = false
+		status.Message = err.Error()
+		res.RequeueAfter = RetryInterval
+	}
+	return res, r.updateStatus(ctx, cd, status)
+}
+
+// register validates the given ComponentDefinition and registers its component, unless the same definition is already registered.
+func (r *ComponentDefinitionReconciler) register(ctx context.Context, cd *openmcpv1alpha1.ComponentDefinition) error {
+	log := logging.FromContextOrPanic(ctx)
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	ct := cd.Spec.Type
+	prev := r.registered[cd.Name]
+	if prev != nil && prev.Generation == cd.Generation {
+		// nothing changed
+		return nil
+	}
+
+	if err := r.validate(cd); err != nil {
+		if prev != nil {
+			// keep the component registered, the previous definition is still valid
+			return fmt.Errorf("invalid ComponentDefinition, keeping the registration of generation %d: %w", prev.Generation, err)
+		}
+		return fmt.Errorf("invalid ComponentDefinition: %w", err)
+	}
+
+	// rollback restores the registration of the previous definition, if any
+	rollback := func() {
+		if prev != nil {
+			components.Registry.Register(ct, components.NewExternalComponentHandlerFn(prev), prev.Spec.Dependencies...)
+		} else {
+			components.Registry.Register(ct, nil)
+		}
+	}
+	components.Registry.Register(ct, components.NewExternalComponentHandlerFn(cd), cd.Spec.Dependencies...)
+	if err := componentutils.ValidateDependencies(components.Registry); err != nil {
+		rollback()
+		return err
+	}
+	if r.OnRegister != nil {
+		if err := r.OnRegister(components.Registry.GetComponent(ct).Resource()); err != nil {
+			rollback()
+			return fmt.Errorf("error handling the registration of component '%s': %w", string(ct), err)
+		}
+	}
+	r.registered[cd.Name] = cd.DeepCopy()
+	log.Info("Registered component", "component", string(ct), "resource", cd.Spec.Resource.GroupVersionKind().String(), "generation", cd.Generation)
+	return nil
+}
+
+// validate checks whether the component of the given ComponentDefinition can be registered.
+// It expects the lock to be held.
+func (r *ComponentDefinitionReconciler) validate(cd *openmcpv1alpha1.ComponentDefinition) error {
+	ct := cd.Spec.Type
+	gvk := cd.Spec.Resource.GroupVersionKind()
+	if components.IsBuiltinComponent(ct) {
+		return fmt.Errorf("component type '%s' belongs to a built-in component", string(ct))
+	}
+	for name, other := range r.registered {
+		if name == cd.Name {
+			continue
+		}
+		if other.Spec.Type == ct {
+			return fmt.Errorf("component type '%s' is already registered by ComponentDefinition '%s'", string(ct), name)
+		}
+		if other.Spec.Resource.GroupVersionKind().GroupKind() == gvk.GroupKind() {
+			return fmt.Errorf("resource kind '%s' is already used by ComponentDefinition '%s'", gvk.GroupKind().String(), name)
+		}
+	}
+	if _, owned := r.registered[cd.Name]; !owned && components.Registry.Has(ct) {
+		return fmt.Errorf("component type '%s' is already registered", string(ct))
+	}
+	for ot, ch := range components.Registry.GetKnownComponents() {
+		otherGVK, err := apiutil.GVKForObject(ch.Resource(), components.Registry.Scheme())
+		if err != nil {
+			return fmt.Errorf("error determining resource kind of component '%s': %w", string(ot), err)
+		}
+		if ot != ct && otherGVK.GroupKind() == gvk.GroupKind() {
+			return fmt.Errorf("resource kind '%s' is already used by component '%s'", gvk.GroupKind().String(), string(ot))
+		}
+	}
+
+	mapping, err := r.RESTMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return fmt.Errorf("unable to find resource '%s', is the CustomResourceDefinition installed?: %w", gvk.String(), err)
+	}
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return fmt.Errorf("resource '%s' must be namespaced", gvk.String())
+	}
+
+	for _, dep := range cd.Spec.Dependencies {
+		if dep == ct {
+			return fmt.Errorf("component '%s' must not depend on itself", string(ct))
+		}
+		if !components.Registry.Has(dep) {
+			return fmt.Errorf("component '%s' depends on unknown component '%s'", string(ct), string(dep))
+		}
+	}
+	return nil
+}
+
+// unregister removes the component of the ComponentDefinition with the given name from the registry, if it is registered.
+func (r *ComponentDefinitionReconciler) unregister(ctx context.Context, name string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	cd, ok := r.registered[name]
+	if !ok {
+		return
+	}
+	components.Registry.Register(cd.Spec.Type, nil)
+	delete(r.registered, name)
+	logging.FromContextOrPanic(ctx).Info("Unregistered component", "component", string(cd.Spec.Type))
+}
+
+// handleDelete unregisters the component of the given ComponentDefinition and removes the finalizer.
+// As long as resources for the component exist, the component stays registered, so that the ManagedControlPlane controller can still delete them.
+func (r *ComponentDefinitionReconciler) handleDelete(ctx context.Context, cd *openmcpv1alpha1.ComponentDefinition) (ctrl.Result, error) {
+	log := logging.FromContextOrPanic(ctx)
+	if !controllerutil.ContainsFinalizer(cd, openmcpv1alpha1.ComponentDefinitionFinalizer) {
+		r.unregister(ctx, cd.Name)
+		return ctrl.Result{}, nil
+	}
+
+	inUse, err := r.resourcesExist(ctx, cd.Spec.Resource.GroupVersionKind())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if inUse {
+		log.Info("Component resources still exist, waiting for them to be deleted before unregistering the component", "component", string(cd.Spec.Type))
+		status := cd.Status.DeepCopy()
+		status.Message = fmt.Sprintf("Waiting for all resources of kind '%s' to be deleted. Remove the component's configuration from all ManagedControlPlanes.", cd.Spec.Resource.Kind)
+		return ctrl.Result{RequeueAfter: DeletionRetryInterval}, r.updateStatus(ctx, cd, *status)
+	}
+
+	r.unregister(ctx, cd.Name)
+	old := cd.DeepCopy()
+	controllerutil.RemoveFinalizer(cd, openmcpv1alpha1.ComponentDefinitionFinalizer)
+	if err := r.Client.Patch(ctx, cd, client.MergeFrom(old)); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(fmt.Errorf("error removing finalizer: %w", err))
+	}
+	return ctrl.Result{}, nil
+}
+
+// resourcesExist returns true if any resources of the given kind exist in the cluster.
+// If the resource kind doesn't exist anymore, this returns false.
+func (r *ComponentDefinitionReconciler) resourcesExist(ctx context.Context, gvk schema.GroupVersionKind) (bool, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := r.Client.List(ctx, list, client.Limit(1)); err != nil {
+		if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error listing resources of kind '%s': %w", gvk.String(), err)
+	}
+	return len(list.Items) > 0, nil
+}
+
+// updateStatus updates the status of the given ComponentDefinition, if it changed.
+func (r *ComponentDefinitionReconciler) updateStatus(ctx context.Context, cd *openmcpv1alpha1.ComponentDefinition, status openmcpv1alpha1.ComponentDefinitionStatus) error {
+	if cd.Status == status {
+		return nil
+	}
+	cd.Status = status
+	// all replicas update the status, so conflicts are expected and resolved by the next reconciliation
+	if err := r.Client.Status().Update(ctx, cd); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("error updating status: %w", err)
+	}
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ComponentDefinitionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&openmcpv1alpha1.ComponentDefinition{}, builder.WithPredicates(predicate.Or(
+			predicate.GenerationChangedPredicate{},
+			openmcpctrlutil.DeletionTimestampChangedPredicate{},
+		))).
+		WithOptions(controller.Options{
+			// every replica has its own component registry, so every replica has to register the components
+			NeedLeaderElection: ptr.To(false),
+		}).
+		Named(ControllerName).
+		Complete(r)
+}
@@ -0,0 +1,182 @@
+package componentdefinition_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openmcptesting "github.com/openmcp-project/controller-utils/pkg/testing"
+
+	"github.com/openmcp-project/mcp-operator/internal/components"
+	"github.com/openmcp-project/mcp-operator/internal/controller/core/componentdefinition"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	testutils "github.com/openmcp-project/mcp-operator/test/utils"
+)
+
+var (
+	fooGVK     = schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Foo"}
+	clusterGVK = schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "ClusterFoo"}
+)
+
+func componentDefinition(name string, ct openmcpv1alpha1.ComponentType, gvk schema.GroupVersionKind, deps ...openmcpv1alpha1.ComponentType) *openmcpv1alpha1.ComponentDefinition {
+	return &openmcpv1alpha1.ComponentDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 1},
+		Spec: openmcpv1alpha1.ComponentDefinitionSpec{
+			Type:         ct,
+			Resource:     openmcpv1alpha1.ComponentDefinitionResource{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind},
+			SpecPath:     "components.external." + name,
+			Dependencies: deps,
+		},
+	}
+}
+
+var _ = Describe("ComponentDefinition Controller", func() {
+	var (
+		ctx        context.Context
+		mapper     *meta.DefaultRESTMapper
+		registered []components.Component
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContext(context.Background(), logging.Discard())
+		mapper = meta.NewDefaultRESTMapper(nil)
+		mapper.Add(fooGVK, meta.RESTScopeNamespace)
+		mapper.Add(clusterGVK, meta.RESTScopeRoot)
+		registered = nil
+	})
+
+	AfterEach(func() {
+		components.Registry.Register("Foo", nil)
+		components.Registry.Register("Bar", nil)
+	})
+
+	newReconciler := func(c client.Client) *componentdefinition.ComponentDefinitionReconciler {
+		return componentdefinition.NewComponentDefinitionReconciler(c, mapper, func(comp components.Component) error {
+			registered = append(registered, comp)
+			return nil
+		})
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().WithScheme(testutils.Scheme).WithRESTMapper(mapper).WithObjects(objs...).WithStatusSubresource(&openmcpv1alpha1.ComponentDefinition{}).Build()
+	}
+
+	It("should register the component and unregister it after all of its resources have been deleted", func() {
+		cd := componentDefinition("foo", "Foo", fooGVK, openmcpv1alpha1.APIServerComponent)
+		c := newClient(cd)
+		r := newReconciler(c)
+
+		_, err := r.Reconcile(ctx, openmcptesting.RequestFromObject(cd))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(cd), cd)).To(Succeed())
+		Expect(cd.Finalizers).To(ContainElement(openmcpv1alpha1.ComponentDefinitionFinalizer))
+		Expect(cd.Status.Registered).To(BeTrue())
+		Expect(cd.Status.ObservedGeneration).To(Equal(cd.Generation))
+		Expect(cd.Status.Message).To(BeEmpty())
+
+		Expect(components.Registry.Has("Foo")).To(BeTrue())
+		Expect(components.Registry.Dependencies("Foo")).To(ConsistOf(openmcpv1alpha1.APIServerComponent))
+		ch := components.Registry.GetComponent("Foo")
+		Expect(components.IsExternalComponent(ch.Resource())).To(BeTrue())
+		Expect(ch.Resource().GetObjectKind().GroupVersionKind()).To(Equal(fooGVK))
+		Expect(registered).To(HaveLen(1))
+		Expect(registered[0].Type()).To(Equal(openmcpv1alpha1.ComponentType("Foo")))
+
+		// reconciling the same generation again doesn't register the component again
+		_, err = r.Reconcile(ctx, openmcptesting.RequestFromObject(cd))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(registered).To(HaveLen(1))
+
+		// a resource of the component still exists
+		res := &unstructured.Unstructured{}
+		res.SetGroupVersionKind(fooGVK)
+		res.SetName("test")
+		res.SetNamespace("test")
+		Expect(c.Create(ctx, res)).To(Succeed())
+		Expect(c.Delete(ctx, cd)).To(Succeed())
+		rr, err := r.Reconcile(ctx, openmcptesting.RequestFromObject(cd))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rr.RequeueAfter).To(Equal(componentdefinition.DeletionRetryInterval))
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(cd), cd)).To(Succeed())
+		Expect(cd.Status.Registered).To(BeTrue())
+		Expect(cd.Status.Message).To(ContainSubstring("Waiting for all resources of kind 'Foo' to be deleted"))
+		Expect(components.Registry.Has("Foo")).To(BeTrue())
+
+		// all resources have been deleted
+		Expect(c.Delete(ctx, res)).To(Succeed())
+		_, err = r.Reconcile(ctx, openmcptesting.RequestFromObject(cd))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(cd), cd)).ToNot(Succeed())
+		Expect(components.Registry.Has("Foo")).To(BeFalse())
+	})
+
+	It("should unregister the component if the ComponentDefinition has been removed by another replica", func() {
+		cd := componentDefinition("foo", "Foo", fooGVK)
+		c := newClient(cd)
+		r := newReconciler(c)
+
+		_, err := r.Reconcile(ctx, openmcptesting.RequestFromObject(cd))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(components.Registry.Has("Foo")).To(BeTrue())
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(cd), cd)).To(Succeed())
+		cd.Finalizers = nil
+		Expect(c.Update(ctx, cd)).To(Succeed())
+		Expect(c.Delete(ctx, cd)).To(Succeed())
+		_, err = r.Reconcile(ctx, openmcptesting.RequestFromObject(cd))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(components.Registry.Has("Foo")).To(BeFalse())
+	})
+
+	It("should not register invalid ComponentDefinitions", func() {
+		mapper.Add(openmcpv1alpha1.GroupVersion.WithKind("APIServer"), meta.RESTScopeNamespace)
+		for _, tc := range []struct {
+			cd      *openmcpv1alpha1.ComponentDefinition
+			message string
+		}{
+			{componentDefinition("builtin", openmcpv1alpha1.LandscaperComponent, fooGVK), "belongs to a built-in component"},
+			{componentDefinition("unknown", "Foo", schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Unknown"}), "is the CustomResourceDefinition installed?"},
+			{componentDefinition("cluster", "Foo", clusterGVK), "must be namespaced"},
+			{componentDefinition("dep", "Foo", fooGVK, "Bar"), "depends on unknown component 'Bar'"},
+			{componentDefinition("self", "Foo", fooGVK, "Foo"), "must not depend on itself"},
+			{componentDefinition("kind", "Foo", schema.GroupVersionKind{Group: openmcpv1alpha1.GroupVersion.Group, Version: "v1alpha1", Kind: "APIServer"}), "is already used by component 'APIServer'"},
+		} {
+			c := newClient(tc.cd)
+			rr, err := newReconciler(c).Reconcile(ctx, openmcptesting.RequestFromObject(tc.cd))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rr.RequeueAfter).To(Equal(componentdefinition.RetryInterval))
+			cd := &openmcpv1alpha1.ComponentDefinition{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(tc.cd), cd)).To(Succeed())
+			Expect(cd.Status.Registered).To(BeFalse(), tc.cd.Name)
+			Expect(cd.Status.Message).To(ContainSubstring(tc.message), tc.cd.Name)
+			Expect(components.Registry.Has("Foo")).To(BeFalse(), tc.cd.Name)
+		}
+		Expect(registered).To(BeEmpty())
+	})
+
+	It("should not register the same component type twice", func() {
+		foo := componentDefinition("foo", "Foo", fooGVK)
+		other := componentDefinition("other", "Foo", schema.GroupVersionKind{Group: "other.example.org", Version: "v1", Kind: "Foo"})
+		mapper.Add(other.Spec.Resource.GroupVersionKind(), meta.RESTScopeNamespace)
+		c := newClient(foo, other)
+		r := newReconciler(c)
+
+		_, err := r.Reconcile(ctx, openmcptesting.RequestFromObject(foo))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = r.Reconcile(ctx, openmcptesting.RequestFromObject(other))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(other), other)).To(Succeed())
+		Expect(other.Status.Registered).To(BeFalse())
+		Expect(other.Status.Message).To(ContainSubstring("already registered by ComponentDefinition 'foo'"))
+		Expect(components.Registry.GetComponent("Foo").Resource().GetObjectKind().GroupVersionKind()).To(Equal(fooGVK))
+	})
+})
@@ -11,6 +11,7 @@ import (
 	"github.com/openmcp-project/mcp-operator/internal/utils/apiserver"
 	"github.com/openmcp-project/mcp-operator/internal/utils/components"
 
+	mcpcomponents "github.com/openmcp-project/mcp-operator/internal/components"
 	mcpocfg "github.com/openmcp-project/mcp-operator/internal/config"
 	"github.com/openmcp-project/mcp-operator/internal/controller/core/landscaper/conversion"
 	lsutils "github.com/openmcp-project/mcp-operator/internal/controller/core/landscaper/utils"
@@ -107,33 +108,24 @@ func (r *LandscaperConnector) reconcile(ctx context.Context, req ctrl.Request) c
 		}
 	}
 
-	// checking for APIServer component
-	log.Debug("Checking for APIServer dependency")
+	// checking for dependencies
+	log.Debug("Checking for dependencies")
 	ownCPGeneration, ownICGeneration, _ := components.GetCreatedFromGeneration(ls)
-	as := &openmcpv1alpha1.APIServer{}
-	as.SetName(ls.Name)
-	as.SetNamespace(ls.Namespace)
-	if err := r.CrateClient.Get(ctx, client.ObjectKeyFromObject(as), as); err != nil {
-		if !apierrors.IsNotFound(err) {
-			return components.ReconcileResult[*openmcpv1alpha1.Landscaper]{Component: ls, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error fetching APIServer resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
-		}
-		// APIServer not found
-		as = nil
+	deps, err := components.GetDependencies(mcpcomponents.Registry, ctx, r.CrateClient, ls)
+	if err != nil {
+		return components.ReconcileResult[*openmcpv1alpha1.Landscaper]{Component: ls, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error fetching dependencies: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
 	}
-	if as == nil || !components.IsDependencyReady(as, ownCPGeneration, ownICGeneration) {
-		log.Info("APIServer not found or it isn't ready")
-		return components.ReconcileResult[*openmcpv1alpha1.Landscaper]{Component: ls, Conditions: landscaperConditions(false, cconst.ReasonWaitingForDependencies, "Waiting for APIServer dependency to be ready."), Result: ctrl.Result{RequeueAfter: 60 * time.Second}}
+	as, _ := deps[openmcpv1alpha1.APIServerComponent].(*openmcpv1alpha1.APIServer)
+	// only the APIServer is required to be ready, Authentication and Authorization don't exist if they are not configured in the ManagedControlPlane
+	// they are protected from deletion via dependency finalizers if they exist
+	if notReady := components.NotReadyDependencies(components.FilterDependencies(deps, openmcpv1alpha1.APIServerComponent), ownCPGeneration, ownICGeneration); as == nil || len(notReady) > 0 {
+		log.Info("Dependencies not found or not ready", "notReadyDependencies", notReady)
+		return components.ReconcileResult[*openmcpv1alpha1.Landscaper]{Component: ls, Conditions: landscaperConditions(false, cconst.ReasonWaitingForDependencies, components.WaitingForDependenciesMessage(notReady)), Result: ctrl.Result{RequeueAfter: 60 * time.Second}}
 	}
-	log.Debug("APIServer dependency is ready")
+	log.Debug("Dependencies are ready")
 	if as.Status.AdminAccess == nil || as.Status.AdminAccess.Kubeconfig == "" {
 		return components.ReconcileResult[*openmcpv1alpha1.Landscaper]{Component: ls, ReconcileError: openmcperrors.WithReason(fmt.Errorf("APIServer dependency is ready, but no kubeconfig could be found in its status"), cconst.ReasonDependencyStatusInvalid)}
 	}
-	auth := &openmcpv1alpha1.Authentication{}
-	auth.SetName(ls.Name)
-	auth.SetNamespace(ls.Namespace)
-	authz := &openmcpv1alpha1.Authorization{}
-	authz.SetName(ls.Name)
-	authz.SetNamespace(ls.Namespace)
 
 	deleteLandscaper := false
 	if !ls.DeletionTimestamp.IsZero() {
@@ -155,17 +147,9 @@ func (r *LandscaperConnector) reconcile(ctx context.Context, req ctrl.Request) c
 			}
 		}
 
-		log.Debug("Ensuring dependency finalizer on APIServer resource")
-		if err := components.EnsureDependencyFinalizer(ctx, r.CrateClient, as, ls, true); err != nil {
-			return components.ReconcileResult[*openmcpv1alpha1.Landscaper]{Component: ls, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error setting dependency finalizer on APIServer component resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
-		}
-		log.Debug("Ensuring dependency finalizer on Authentication resource")
-		if err := components.EnsureDependencyFinalizer(ctx, r.CrateClient, auth, ls, true); err != nil {
-			return components.ReconcileResult[*openmcpv1alpha1.Landscaper]{Component: ls, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error setting dependency finalizer on Authentication component resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
-		}
-		log.Debug("Ensuring dependency finalizer on Authorization resource")
-		if err := components.EnsureDependencyFinalizer(ctx, r.CrateClient, authz, ls, true); err != nil {
-			return components.ReconcileResult[*openmcpv1alpha1.Landscaper]{Component: ls, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error setting dependency finalizer on Authorization component resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
+		log.Debug("Ensuring dependency finalizers on dependencies")
+		if err := components.EnsureDependencyFinalizers(ctx, r.CrateClient, ls, deps, true); err != nil {
+			return components.ReconcileResult[*openmcpv1alpha1.Landscaper]{Component: ls, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error setting dependency finalizers: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
 		}
 	}
 
@@ -185,6 +169,11 @@ func (r *LandscaperConnector) reconcile(ctx context.Context, req ctrl.Request) c
 	var v2cons []openmcpv1alpha1.ComponentCondition
 	var errr openmcperrors.ReasonableError
 	old := ls.DeepCopy()
+	migrating, migRes, migCons, migErr := r.handleMigration(ctx, ls, as, deleteLandscaper)
+	if migrating {
+		// migration from v1 to v2 (or its rollback) is in progress
+		return components.ReconcileResult[*openmcpv1alpha1.Landscaper]{OldComponent: old, Component: ls, Result: migRes, ReconcileError: migErr, Conditions: migCons}
+	}
 	if mcpocfg.Config.Architecture.DecideVersion(ls) == openmcpv1alpha1.ArchitectureV2 {
 		// v2 logic
 		log.Info("Using v2 logic for APIServer")
@@ -207,17 +196,9 @@ func (r *LandscaperConnector) reconcile(ctx context.Context, req ctrl.Request) c
 	errs := openmcperrors.NewReasonableErrorList(errr)
 
 	if deleteLandscaper && ready {
-		// remove dependency finalizer from APIServer resource
-		if err := components.EnsureDependencyFinalizer(ctx, r.CrateClient, as, ls, false); err != nil {
-			return components.ReconcileResult[*openmcpv1alpha1.Landscaper]{OldComponent: old, Component: ls, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error removing dependency finalizer from APIServer component resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
-		}
-		// remove dependency finalizer from Authentication resource
-		if err := components.EnsureDependencyFinalizer(ctx, r.CrateClient, auth, ls, false); client.IgnoreNotFound(err) != nil {
-			return components.ReconcileResult[*openmcpv1alpha1.Landscaper]{OldComponent: old, Component: ls, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error removing dependency finalizer from Authentication component resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
-		}
-		// remove dependency finalizer from Authorization resource
-		if err := components.EnsureDependencyFinalizer(ctx, r.CrateClient, authz, ls, false); client.IgnoreNotFound(err) != nil {
-			return components.ReconcileResult[*openmcpv1alpha1.Landscaper]{OldComponent: old, Component: ls, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error removing dependency finalizer from Authorization component resource: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
+		// remove dependency finalizers from dependencies
+		if err := components.EnsureDependencyFinalizers(ctx, r.CrateClient, ls, deps, false); err != nil {
+			return components.ReconcileResult[*openmcpv1alpha1.Landscaper]{OldComponent: old, Component: ls, ReconcileError: openmcperrors.WithReason(fmt.Errorf("error removing dependency finalizers: %w", err), cconst.ReasonCrateClusterInteractionProblem)}
 		}
 
 		// remove finalizer from Landscaper resource
@@ -238,6 +219,10 @@ func (r *LandscaperConnector) reconcile(ctx context.Context, req ctrl.Request) c
 		}
 	}
 	cons = append(cons, v2cons...)
+	if !deleteLandscaper {
+		// report the migration status, unless this would block the deletion
+		cons = append(cons, migCons...)
+	}
 	return components.ReconcileResult[*openmcpv1alpha1.Landscaper]{OldComponent: old, Component: ls, Result: res, Reason: reason, ReconcileError: errs.Aggregate(), Conditions: cons}
 }
 
@@ -351,6 +336,8 @@ func (r *LandscaperConnector) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openmcpv1alpha1.Landscaper{}, builder.WithPredicates(components.DefaultComponentControllerPredicates())).
 		Watches(&openmcpv1alpha1.APIServer{}, &handler.EnqueueRequestForObject{}, builder.WithPredicates(components.StatusChangedPredicate{})).
+		Watches(&openmcpv1alpha1.Authentication{}, &handler.EnqueueRequestForObject{}, builder.WithPredicates(components.StatusChangedPredicate{})).
+		Watches(&openmcpv1alpha1.Authorization{}, &handler.EnqueueRequestForObject{}, builder.WithPredicates(components.StatusChangedPredicate{})).
 		Complete(r)
 }
 
@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/openmcp-project/controller-utils/pkg/testing"
 	lssv1alpha1 "github.com/openmcp-project/landscaper-service/pkg/apis/core/v1alpha1"
+	commonapi "github.com/openmcp-project/openmcp-operator/api/common"
 	openmcpls "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,6 +21,7 @@ import (
 	mcpocfg "github.com/openmcp-project/mcp-operator/internal/config"
 	"github.com/openmcp-project/mcp-operator/internal/controller/core/landscaper"
 	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
+	"github.com/openmcp-project/mcp-operator/internal/utils/migration"
 	. "github.com/openmcp-project/mcp-operator/test/matchers"
 	testutils "github.com/openmcp-project/mcp-operator/test/utils"
 )
@@ -196,6 +198,35 @@ var _ = Describe("CO-1153 Landscaper Controller", func() {
 		))
 	})
 
+	It("should create a LandscaperDeployment if no Authorization is configured for the ManagedControlPlane", func() {
+		env := testEnvSetup(path.Join("testdata", "test-15"), "", &lssv1alpha1.LandscaperDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "test",
+			},
+		})
+
+		ls := &openmcpv1alpha1.Landscaper{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, ls)).To(Succeed())
+
+		req := testing.RequestFromObject(ls)
+		res := env.ShouldReconcile(lsReconciler, req)
+		testing.ExpectRequeue(res)
+
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+		Expect(ls.Status.Conditions).To(ContainElement(MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+			Type:   openmcpv1alpha1.LandscaperComponent.HealthyCondition(),
+			Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+			Reason: cconst.ReasonWaitingForLaaS,
+		})))
+		Expect(ls.Status.LandscaperDeploymentInfo).NotTo(BeNil())
+
+		// the existing, not yet ready Authentication is protected by a dependency finalizer nonetheless
+		auth := &openmcpv1alpha1.Authentication{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(ls), auth)).To(Succeed())
+		Expect(auth.Finalizers).To(ContainElement(openmcpv1alpha1.LandscaperComponent.DependencyFinalizer()))
+	})
+
 	It("should handle when the referenced LandscaperDeployment is not found", func() {
 		var err error
 
@@ -528,4 +559,83 @@ var _ = Describe("CO-1153 Landscaper Controller", func() {
 
 	})
 
+	Context("migration", func() {
+
+		It("should replace the LandscaperDeployment with a v2 Landscaper object once the APIServer has been migrated", func() {
+			env := testEnvSetup(path.Join("testdata", "test-14"), path.Join("testdata", "test-14", "laas"))
+
+			ls := &openmcpv1alpha1.Landscaper{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, ls)).To(Succeed())
+			req := testing.RequestFromObject(ls)
+
+			// the migration waits for the APIServer to be migrated first
+			res := env.ShouldReconcile(lsReconciler, req)
+			testing.ExpectRequeue(res)
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Conditions).To(ContainElements(
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   openmcpv1alpha1.LandscaperComponent.HealthyCondition(),
+					Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+					Reason: cconst.ReasonMigrationInProgress,
+				}),
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   migration.StepCondition(openmcpv1alpha1.LandscaperComponent, "Prerequisites"),
+					Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+					Reason: cconst.ReasonMigrationStepInProgress,
+				}),
+			))
+			ld := &lssv1alpha1.LandscaperDeployment{}
+			ld.SetName("test")
+			ld.SetNamespace("test")
+			Expect(env.Client(testutils.LaaSCoreCluster).Get(env.Ctx, client.ObjectKeyFromObject(ld), ld)).To(Succeed())
+
+			as := &openmcpv1alpha1.APIServer{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(ls), as)).To(Succeed())
+			as.Labels[openmcpv1alpha1.ArchitectureVersionLabel] = openmcpv1alpha1.ArchitectureV2
+			Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, as)).To(Succeed())
+
+			// the LandscaperDeployment is removed and the v2 Landscaper object is created
+			env.ShouldReconcile(lsReconciler, req)
+			Expect(env.Client(testutils.LaaSCoreCluster).Get(env.Ctx, client.ObjectKeyFromObject(ld), ld)).To(MatchError(apierrors.IsNotFound, "not found"))
+			env.ShouldReconcile(lsReconciler, req)
+
+			lsv2 := &openmcpls.Landscaper{}
+			lsv2.SetName(ls.Name)
+			lsv2.SetNamespace(ls.Namespace)
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(lsv2), lsv2)).To(Succeed())
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Labels).To(HaveKeyWithValue(openmcpv1alpha1.ArchitectureVersionLabel, openmcpv1alpha1.ArchitectureV1))
+			Expect(ls.Status.LandscaperDeploymentInfo).To(BeNil())
+			Expect(ls.Status.Conditions).To(ContainElement(
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   migration.StepCondition(openmcpv1alpha1.LandscaperComponent, "V2ResourceReady"),
+					Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+					Reason: cconst.ReasonMigrationStepInProgress,
+				}),
+			))
+
+			// the architecture version label is switched once the v2 Landscaper object is ready
+			lsv2.Status.Phase = commonapi.StatusPhaseReady
+			lsv2.Status.ObservedGeneration = lsv2.Generation
+			Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, lsv2)).To(Succeed())
+			env.ShouldReconcile(lsReconciler, req)
+
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Labels).To(HaveKeyWithValue(openmcpv1alpha1.ArchitectureVersionLabel, openmcpv1alpha1.ArchitectureV2))
+			Expect(ls.Status.Conditions).To(ContainElements(
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   openmcpv1alpha1.LandscaperComponent.ArchitectureMigrationCondition(),
+					Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+					Reason: cconst.ReasonMigrationCompleted,
+				}),
+				MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+					Type:   openmcpv1alpha1.LandscaperComponent.HealthyCondition(),
+					Status: openmcpv1alpha1.ComponentConditionStatusTrue,
+				}),
+			))
+			Expect(env.Client(testutils.LaaSCoreCluster).Get(env.Ctx, client.ObjectKeyFromObject(ld), ld)).To(MatchError(apierrors.IsNotFound, "not found"))
+		})
+
+	})
+
 })
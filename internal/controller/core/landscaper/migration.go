@@ -0,0 +1,130 @@
+package landscaper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
+	mcpocfg "github.com/openmcp-project/mcp-operator/internal/config"
+	lsutils "github.com/openmcp-project/mcp-operator/internal/controller/core/landscaper/utils"
+	"github.com/openmcp-project/mcp-operator/internal/utils/components"
+	"github.com/openmcp-project/mcp-operator/internal/utils/migration"
+)
+
+const (
+	migrationStepPrerequisites               = "Prerequisites"
+	migrationStepLandscaperDeploymentRemoved = "LandscaperDeploymentRemoved"
+	migrationStepV2ResourceReady             = "V2ResourceReady"
+)
+
+// handleMigration handles a requested migration of the Landscaper from the v1 to the v2 architecture.
+// If the returned bool is true, the migration (or its rollback) is still in progress and the returned values should be used as reconciliation result.
+// Otherwise, the regular logic for the Landscaper's architecture version should be executed and the returned conditions should be added to its conditions.
+func (r *LandscaperConnector) handleMigration(ctx context.Context, ls *openmcpv1alpha1.Landscaper, as *openmcpv1alpha1.APIServer, deleteLandscaper bool) (bool, ctrl.Result, []openmcpv1alpha1.ComponentCondition, openmcperrors.ReasonableError) {
+	op := migration.Requested(ls)
+	if op == "" {
+		return false, ctrl.Result{}, nil, nil
+	}
+	log := logging.FromContextOrPanic(ctx).WithName("migration")
+	ctx = logging.NewContext(ctx, log)
+
+	if op != openmcpv1alpha1.ArchitectureV2 && op != openmcpv1alpha1.ArchitectureMigrationValueRollback {
+		return false, ctrl.Result{}, []openmcpv1alpha1.ComponentCondition{components.NewCondition(openmcpv1alpha1.LandscaperComponent.ArchitectureMigrationCondition(), openmcpv1alpha1.ComponentConditionStatusFalse, cconst.ReasonMigrationNotSupported, fmt.Sprintf("unknown migration operation '%s', must be either '%s' or '%s'", op, openmcpv1alpha1.ArchitectureV2, openmcpv1alpha1.ArchitectureMigrationValueRollback))}, nil
+	}
+
+	m := migration.New(r.CrateClient, ls, r.migrationSteps(ls, as)...)
+	if ls.GetLabels()[openmcpv1alpha1.ArchitectureVersionLabel] == openmcpv1alpha1.ArchitectureV2 {
+		// migration has already been completed, nothing to do
+		return false, ctrl.Result{}, m.Completed(), nil
+	}
+
+	var res migration.Result
+	if op == openmcpv1alpha1.ArchitectureV2 && !deleteLandscaper {
+		res = m.Run(ctx)
+	} else {
+		// an unfinished migration needs to be rolled back before the Landscaper can be deleted via the v1 logic
+		res = m.Rollback(ctx)
+	}
+	if res.Completed {
+		return false, ctrl.Result{}, res.Conditions, nil
+	}
+
+	// the v1 logic is paused while the migration or its rollback is in progress
+	reason := cconst.ReasonMigrationInProgress
+	if op == openmcpv1alpha1.ArchitectureMigrationValueRollback || deleteLandscaper {
+		reason = cconst.ReasonMigrationRollingBack
+	}
+	cons := landscaperConditions(false, reason, "Landscaper is paused while its migration to the v2 architecture is in progress or being rolled back")
+	return true, res.Result, append(cons, res.Conditions...), res.Error
+}
+
+// migrationSteps returns the steps for migrating the given Landscaper from the v1 to the v2 architecture.
+// The LandscaperDeployment is removed and replaced by a v2 Landscaper resource, the Installations within the ManagedControlPlane are not touched.
+// Rolling back the migration removes the v2 Landscaper resource, the LandscaperDeployment is then recreated by the v1 logic.
+// The removal of the LandscaperDeployment cannot be reverted, because the v2 Landscaper is deployed by a different system and cannot adopt it,
+// so the Landscaper is unavailable until the LandscaperDeployment has been recreated.
+func (r *LandscaperConnector) migrationSteps(ls *openmcpv1alpha1.Landscaper, as *openmcpv1alpha1.APIServer) []migration.Step {
+	return []migration.Step{
+		{
+			Name: migrationStepPrerequisites,
+			Migrate: func(_ context.Context) (bool, string, openmcperrors.ReasonableError) {
+				// the v2 Landscaper requires the ManagedControlPlane's cluster to be managed by the v2 architecture
+				if mcpocfg.Config.Architecture.DecideVersion(as) != openmcpv1alpha1.ArchitectureV2 {
+					return false, "Waiting for the APIServer to be migrated to the v2 architecture", nil
+				}
+				return true, "", nil
+			},
+		},
+		{
+			Name: migrationStepLandscaperDeploymentRemoved,
+			Migrate: func(ctx context.Context) (bool, string, openmcperrors.ReasonableError) {
+				ld, err := lsutils.GetCorrespondingLandscaperDeployment(ctx, r.LaaSClient, ls)
+				if err != nil && !apierrors.IsNotFound(err) {
+					return false, "", openmcperrors.WithReason(fmt.Errorf("error trying to fetch corresponding LandscaperDeployment: %w", err), cconst.ReasonLaaSCoreClusterInteractionProblem)
+				}
+				if err != nil || ld == nil {
+					ls.Status.LandscaperDeploymentInfo = nil
+					return true, "", nil
+				}
+				if ld.DeletionTimestamp.IsZero() {
+					logging.FromContextOrPanic(ctx).Info("Deleting LandscaperDeployment", "ldNamespace", ld.Namespace, "ldName", ld.Name)
+					if err := r.LaaSClient.Delete(ctx, ld); client.IgnoreNotFound(err) != nil {
+						return false, "", openmcperrors.WithReason(fmt.Errorf("error deleting LandscaperDeployment: %w", err), cconst.ReasonLaaSCoreClusterInteractionProblem)
+					}
+				}
+				return false, fmt.Sprintf("Waiting for LandscaperDeployment %s/%s to be deleted", ld.Namespace, ld.Name), nil
+			},
+			NotReversible: true,
+		},
+		{
+			Name: migrationStepV2ResourceReady,
+			Migrate: func(ctx context.Context) (bool, string, openmcperrors.ReasonableError) {
+				_, ready, _, rerr := r.v2HandleCreateOrUpdate(ctx, ls)
+				if rerr != nil {
+					return false, "", rerr
+				}
+				if !ready {
+					return false, "Waiting for the v2 Landscaper resource to become ready", nil
+				}
+				return true, "", nil
+			},
+			Rollback: func(ctx context.Context) (bool, string, openmcperrors.ReasonableError) {
+				_, done, _, rerr := r.v2HandleDelete(ctx, ls)
+				if rerr != nil {
+					return false, "", rerr
+				}
+				if !done {
+					return false, "Waiting for the v2 Landscaper resource to be deleted", nil
+				}
+				return true, "", nil
+			},
+		},
+	}
+}
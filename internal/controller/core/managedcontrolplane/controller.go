@@ -6,11 +6,14 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/openmcp-project/mcp-operator/internal/components"
+	mcpometrics "github.com/openmcp-project/mcp-operator/internal/metrics"
 	"github.com/openmcp-project/mcp-operator/internal/utils"
 	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
+	"github.com/openmcp-project/mcp-operator/internal/utils/migration"
 
 	"github.com/openmcp-project/controller-utils/pkg/collections/filters"
 	"github.com/openmcp-project/controller-utils/pkg/collections/maps"
@@ -18,10 +21,13 @@ import (
 	"github.com/openmcp-project/controller-utils/pkg/logging"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	cconst "github.com/openmcp-project/mcp-operator/api/constants"
@@ -36,6 +42,11 @@ var isMCPKeyFilter = filters.ApplyToNthArgument(0, filters.Wrap(strings.HasPrefi
 // ManagedControlPlaneController reconciles a ManagedControlPlane object
 type ManagedControlPlaneController struct {
 	Client client.Client
+	// EventRecorder is used to emit events for ManagedControlPlanes.
+	// If nil, no events are emitted.
+	EventRecorder record.EventRecorder
+
+	watcher *componentWatcher
 }
 
 func NewManagedControlPlaneController(c client.Client) *ManagedControlPlaneController {
@@ -47,6 +58,7 @@ func NewManagedControlPlaneController(c client.Client) *ManagedControlPlaneContr
 // +kubebuilder:rbac:groups=core.openmcp.cloud,resources=managedcontrolplanes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core.openmcp.cloud,resources=managedcontrolplanes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=core.openmcp.cloud,resources=managedcontrolplanes/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -62,9 +74,12 @@ func (r *ManagedControlPlaneController) Reconcile(ctx context.Context, req ctrl.
 		}
 		return ctrl.Result{}, err
 	}
+	oldStatus := cp.Status.DeepCopy()
 
 	// handle operation annotation
 	hadReconcileAnnotation := false
+	hibernationOp := ""
+	planMode := false
 	if cp.GetAnnotations() != nil {
 		op, ok := cp.GetAnnotations()[openmcpv1alpha1.OperationAnnotation]
 		if ok {
@@ -72,12 +87,27 @@ func (r *ManagedControlPlaneController) Reconcile(ctx context.Context, req ctrl.
 			case openmcpv1alpha1.OperationAnnotationValueIgnore:
 				log.Info("Ignoring resource due to ignore operation annotation")
 				return ctrl.Result{}, nil
+			case openmcpv1alpha1.OperationAnnotationValuePlan:
+				// the annotation is not removed, the resource stays in plan mode until it is removed or replaced
+				planMode = true
 			case openmcpv1alpha1.OperationAnnotationValueReconcile:
 				hadReconcileAnnotation = true
 				log.Debug("Removing reconcile operation annotation from resource")
 				if err := componentutils.PatchAnnotation(ctx, r.Client, cp, openmcpv1alpha1.OperationAnnotation, "", componentutils.ANNOTATION_DELETE); err != nil {
 					return ctrl.Result{}, fmt.Errorf("error removing operation annotation: %w", err)
 				}
+			case openmcpv1alpha1.OperationAnnotationValueHibernate, openmcpv1alpha1.OperationAnnotationValueWakeUp:
+				if isSuspended(cp) {
+					// the annotation is kept and handled once the ManagedControlPlane is resumed
+					log.Info("Postponing hibernation operation until the ManagedControlPlane is resumed", "operation", op)
+					break
+				}
+				// the operation is passed on to the APIServer component
+				hibernationOp = op
+				log.Debug("Removing hibernation operation annotation from resource", "operation", op)
+				if err := componentutils.PatchAnnotation(ctx, r.Client, cp, openmcpv1alpha1.OperationAnnotation, "", componentutils.ANNOTATION_DELETE); err != nil {
+					return ctrl.Result{}, fmt.Errorf("error removing operation annotation: %w", err)
+				}
 			}
 		}
 	}
@@ -119,14 +149,57 @@ func (r *ManagedControlPlaneController) Reconcile(ctx context.Context, req ctrl.
 		}
 	}
 
+	inDeletion := !cp.DeletionTimestamp.IsZero()
+	if planMode && !inDeletion {
+		log.Info("Computing plan for ManagedControlPlane due to plan operation annotation")
+		return ctrl.Result{}, r.handlePlan(ctx, cp, icfg, ns)
+	}
+
+	// handle suspension
+	if isSuspended(cp) {
+		log.Info("ManagedControlPlane is suspended, its components are not reconciled")
+		err := r.handleSuspension(ctx, cp)
+		cp.Status.ObservedGeneration = cp.Generation
+		cp.Status.Status = openmcpv1alpha1.MCPStatusSuspended
+		cp.Status.Message = suspensionMessage(cp.Status.Suspension)
+		if err != nil {
+			cp.Status.Message = fmt.Sprintf("%s, reconcile error: %s", cp.Status.Message, err.Error())
+		}
+		return ctrl.Result{}, errors.Join(err, r.updateStatus(ctx, cp, oldStatus))
+	}
+
+	// handle deletion grace period
+	pendingDeletion := false
+	var untilDeletion time.Duration
+	if !inDeletion {
+		var pendingDeletionOp string
+		var err error
+		pendingDeletion, untilDeletion, pendingDeletionOp, err = r.handlePendingDeletion(ctx, cp)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if pendingDeletion && untilDeletion <= 0 {
+			// the ManagedControlPlane has been deleted, the deletion is handled in the next reconciliation
+			return ctrl.Result{Requeue: true}, nil
+		}
+		if pendingDeletionOp != "" {
+			hibernationOp = pendingDeletionOp
+		}
+	}
+
 	// handle deployment or deletion
 	var cons []openmcpv1alpha1.ManagedControlPlaneComponentCondition
 	var res ctrl.Result
 	var err error
-	inDeletion := !cp.DeletionTimestamp.IsZero()
 	if !inDeletion {
 		log.Info("Handling creation/update of ManagedControlPlane")
-		cons, res, err = r.handleCreateOrUpdate(ctx, cp, icfg, ns, hadReconcileAnnotation)
+		pds := cp.Status.PendingDeletion
+		cons, res, err = r.handleCreateOrUpdate(ctx, cp, icfg, ns, hadReconcileAnnotation, hibernationOp, pendingDeletion)
+		// patching the ManagedControlPlane during the creation/update resets its status to the stored one
+		cp.Status.PendingDeletion = pds
+		if pendingDeletion && (res.RequeueAfter <= 0 || untilDeletion < res.RequeueAfter) {
+			res.RequeueAfter = untilDeletion
+		}
 	} else {
 		log.Info("Handling deletion of ManagedControlPlane")
 		cons, res, err = r.handleDelete(ctx, cp, ns, hadReconcileAnnotation)
@@ -134,35 +207,60 @@ func (r *ManagedControlPlaneController) Reconcile(ctx context.Context, req ctrl.
 
 	// set ManagedControlPlane meta status
 	cp.Status.ObservedGeneration = cp.Generation
+	cp.Status.Suspension = nil
 	cp.Status.Status = openmcpv1alpha1.MCPStatusReady
+	cp.Status.Message = ""
+	if cons != nil {
+		cp.Status.Conditions = cons
+		cp.Status.Status, cp.Status.Message = aggregateStatus(cons, cp.Spec.ReadinessGates)
+	}
 	if err != nil {
 		cp.Status.Message = fmt.Sprintf("reconcile error: %s", err.Error())
 		cp.Status.Status = openmcpv1alpha1.MCPStatusNotReady
-	} else {
-		cp.Status.Message = ""
 	}
-	if cons != nil {
-		cp.Status.Conditions = cons
-		for _, con := range cons {
-			if con.Status != openmcpv1alpha1.ComponentConditionStatusTrue {
-				cp.Status.Status = openmcpv1alpha1.MCPStatusNotReady
-				break
-			}
-		}
+	if err == nil && cp.Status.Status != openmcpv1alpha1.MCPStatusReady && cp.Status.Components.APIServer != nil && cp.Status.Components.APIServer.Hibernation.IsHibernated() {
+		// components are expected to be not ready while the API server is hibernated
+		cp.Status.Status = openmcpv1alpha1.MCPStatusHibernated
+	}
+	if err == nil && pendingDeletion {
+		cp.Status.Status = openmcpv1alpha1.MCPStatusPendingDeletion
+		cp.Status.Message = fmt.Sprintf("deletion confirmed, the ManagedControlPlane will be deleted after %s unless the deletion is cancelled", cp.Status.PendingDeletion.DeleteAfter.UTC().Format(time.RFC3339))
 	}
 	if inDeletion {
 		cp.Status.Status = openmcpv1alpha1.MCPStatusDeleting
 	}
+	var recordProgressMetrics func()
+	if cp.Status.Status != openmcpv1alpha1.MCPStatusHibernated && cp.Status.Status != openmcpv1alpha1.MCPStatusPendingDeletion {
+		// the progress is not updated while the API server is hibernated, as the components are expected to be not ready then
+		var perr error
+		recordProgressMetrics, perr = r.updateProgress(ctx, cp, err != nil)
+		if perr != nil {
+			log.Error(perr, "error computing ManagedControlPlane progress")
+		}
+	}
 
-	errs := []error{err}
-	if err := r.Client.Status().Update(ctx, cp); err != nil {
-		errs = append(errs, fmt.Errorf("error updating ManagedControlPlane status: %w", err))
+	serr := r.updateStatus(ctx, cp, oldStatus)
+	if serr == nil && recordProgressMetrics != nil {
+		recordProgressMetrics()
 	}
+	return res, errors.Join(err, serr)
+}
 
-	return res, errors.Join(errs...)
+// updateStatus writes the status of the given ManagedControlPlane and emits events for the changes compared to the given old status.
+func (r *ManagedControlPlaneController) updateStatus(ctx context.Context, mcp *openmcpv1alpha1.ManagedControlPlane, oldStatus *openmcpv1alpha1.ManagedControlPlaneStatus) error {
+	if err := r.Client.Status().Update(ctx, mcp); err != nil {
+		// the ManagedControlPlane is gone if its finalizer has been removed after its last component was deleted
+		if !mcp.DeletionTimestamp.IsZero() && apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error updating ManagedControlPlane status: %w", err)
+	}
+	// events are only emitted for changes which have been persisted, otherwise they would be emitted again during the next reconciliation
+	r.recordStatusEvents(mcp, oldStatus)
+	return nil
 }
 
-func (r *ManagedControlPlaneController) handleCreateOrUpdate(ctx context.Context, mcp *openmcpv1alpha1.ManagedControlPlane, icfg *openmcpv1alpha1.InternalConfiguration, ns *corev1.Namespace, hadReconcileAnnotation bool) ([]openmcpv1alpha1.ManagedControlPlaneComponentCondition, ctrl.Result, error) {
+func (r *ManagedControlPlaneController) handleCreateOrUpdate(ctx context.Context, mcp *openmcpv1alpha1.ManagedControlPlane, icfg *openmcpv1alpha1.InternalConfiguration, ns *corev1.Namespace, hadReconcileAnnotation bool, hibernationOp string, pendingDeletion bool) ([]openmcpv1alpha1.ManagedControlPlaneComponentCondition, ctrl.Result, error) {
 	log := logging.FromContextOrPanic(ctx)
 
 	// add finalizer and potentially project-workspace-labels, if they doesn't exist
@@ -212,13 +310,27 @@ func (r *ManagedControlPlaneController) handleCreateOrUpdate(ctx context.Context
 	if err != nil {
 		return nil, ctrl.Result{}, fmt.Errorf("unable to convert ManagedControlPlane to internal resources: %w", err)
 	}
+	if genCh, ok := genCompHandlers[openmcpv1alpha1.APIServerComponent]; ok && pendingDeletion {
+		if as, ok := genCh.Resource().(*openmcpv1alpha1.APIServer); ok {
+			keepHibernated(as)
+		}
+	}
+	if genCh, ok := genCompHandlers[openmcpv1alpha1.APIServerComponent]; ok && hibernationOp != "" {
+		if _, hasOp := genCh.Resource().GetAnnotations()[openmcpv1alpha1.OperationAnnotation]; !hasOp {
+			log.Info("Passing hibernation operation on to APIServer", "operation", hibernationOp)
+			genCh.Resource().SetAnnotations(maps.Merge(genCh.Resource().GetAnnotations(), map[string]string{
+				openmcpv1alpha1.OperationAnnotation: hibernationOp,
+			}))
+		}
+	}
 	log.Info("Generated and existing components", "generatedComponents", keyStringList(genCompHandlers, true), "existingComponents", keyStringList(curCompHandlers, true))
 	allErrs := []error{}
 	mcpSuccessful := true
 	componentErrors := []string{}
 	componentMessages := []string{}
 	cpcConditions := map[string]openmcpv1alpha1.ManagedControlPlaneComponentCondition{}
-	for ct := range allCompHandlers {
+	// handle the components in dependency order, so that dependencies are created before the components depending on them
+	for _, ct := range componentutils.SortByDependencies(components.Registry, sets.List(sets.KeySet(allCompHandlers))) {
 		clog := log.WithValues("component", string(ct))
 		ch, existingOk := curCompHandlers[ct]
 		genCh, generatedOk := genCompHandlers[ct]
@@ -269,6 +381,8 @@ func (r *ManagedControlPlaneController) handleCreateOrUpdate(ctx context.Context
 					if !apierrors.IsNotFound(err) {
 						allErrs = append(allErrs, err)
 					}
+				} else {
+					r.eventf(mcp, corev1.EventTypeNormal, EventReasonComponentDeleted, "Deleting resource for component '%s', as it has been removed from the spec", string(ct))
 				}
 			}
 			cpGen, icGen, err := componentutils.GetCreatedFromGeneration(ch.Resource())
@@ -294,23 +408,44 @@ func (r *ManagedControlPlaneController) handleCreateOrUpdate(ctx context.Context
 		} else {
 			clog.Debug("Updating resource for component")
 		}
-		if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, ch.Resource(), func() error {
+		opRes, err := controllerutil.CreateOrUpdate(ctx, r.Client, ch.Resource(), func() error {
+			restoredOp := ""
 			// remove potentially leftover ignore annotation
 			if openmcpctrlutil.HasAnnotationWithValue(ch.Resource(), openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueIgnore) && !openmcpctrlutil.HasAnnotationWithValue(genCh.Resource(), openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueIgnore) {
 				anns := ch.Resource().GetAnnotations()
 				// since removing an annotation usually doesn't trigger a reconciliation, add the reconcile annotation instead
 				anns[openmcpv1alpha1.OperationAnnotation] = openmcpv1alpha1.OperationAnnotationValueReconcile
 				ch.Resource().SetAnnotations(anns)
+				// if the ignore annotation was set because of a suspension, the operation which was pending back then is restored
+				restoredOp = anns[openmcpv1alpha1.SuspendedOperationAnnotation]
+			}
+			// the migration annotation is only kept as long as it is set on the MCP
+			anns := ch.Resource().GetAnnotations()
+			delete(anns, openmcpv1alpha1.ArchitectureMigrationAnnotation)
+			// a component that has been migrated to the v2 architecture must not be switched back to v1
+			migrated := ch.Resource().GetLabels()[openmcpv1alpha1.ArchitectureVersionLabel] == openmcpv1alpha1.ArchitectureV2
+			ch.Resource().SetAnnotations(maps.Merge(filters.FilterMap(anns, filters.Not(isMCPKeyFilter)), genCh.Resource().GetAnnotations()))
+			if _, hasOp := genCh.Resource().GetAnnotations()[openmcpv1alpha1.OperationAnnotation]; restoredOp != "" && !hasOp {
+				anns := ch.Resource().GetAnnotations()
+				anns[openmcpv1alpha1.OperationAnnotation] = restoredOp
+				ch.Resource().SetAnnotations(anns)
 			}
-			ch.Resource().SetAnnotations(maps.Merge(filters.FilterMap(ch.Resource().GetAnnotations(), filters.Not(isMCPKeyFilter)), genCh.Resource().GetAnnotations()))
 			ch.Resource().SetLabels(maps.Merge(filters.FilterMap(ch.Resource().GetLabels(), filters.Not(isMCPKeyFilter)), genCh.Resource().GetLabels()))
+			if migrated {
+				labels := ch.Resource().GetLabels()
+				labels[openmcpv1alpha1.ArchitectureVersionLabel] = openmcpv1alpha1.ArchitectureV2
+				ch.Resource().SetLabels(labels)
+			}
 			ch.Resource().SetOwnerReferences(genCh.Resource().GetOwnerReferences())
 			if err := ch.Resource().SetSpec(genCh.Resource().GetSpec()); err != nil {
 				return fmt.Errorf("internal error transferring generated spec to existing resource for component '%s': %w", string(ct), err)
 			}
 			return nil
-		}); err != nil {
+		})
+		if err != nil {
 			allErrs = append(allErrs, fmt.Errorf("error creating/updating component resource for component '%s': %w", string(ct), err))
+		} else if opRes == controllerutil.OperationResultCreated {
+			r.eventf(mcp, corev1.EventTypeNormal, EventReasonComponentCreated, "Created resource for component '%s'", string(ct))
 		}
 
 		if err := ch.Converter().InjectStatus(ch.Resource().GetExternalStatus(), &mcp.Status); err != nil {
@@ -363,20 +498,32 @@ func (r *ManagedControlPlaneController) handleDelete(ctx context.Context, mcp *o
 			if err := r.Client.Patch(ctx, mcp, client.MergeFrom(old)); err != nil {
 				return nil, ctrl.Result{}, fmt.Errorf("error removing finalizer from ManagedControlPlane: %w", err)
 			}
+			r.eventf(mcp, corev1.EventTypeNormal, EventReasonComponentsDeleted, "All components have been deleted")
 		}
 		return nil, ctrl.Result{}, nil
 	}
 
-	log.Info("Deleting remaining components", "existingComponents", keyStringList(compHandlers, true))
+	// components are deleted in stages, a component is only deleted after all components depending on it are gone
+	stage, waiting := deletionStage(compHandlers)
+	log.Info("Deleting remaining components", "existingComponents", keyStringList(compHandlers, true), "deletionStage", stage, "waitingForDeletion", waiting)
+	if prev := mcp.Status.Deletion; prev == nil || !slices.Equal(prev.Stage, stage) {
+		if len(waiting) > 0 {
+			r.eventf(mcp, corev1.EventTypeNormal, EventReasonDeletionStageStarted, "Deleting components %s, waiting for the deletion of components %s", formatComponentTypes(stage), formatComponentTypes(waiting))
+		} else {
+			r.eventf(mcp, corev1.EventTypeNormal, EventReasonDeletionStageStarted, "Deleting components %s", formatComponentTypes(stage))
+		}
+	}
 	allErrs := []error{}
 	mcpSuccessful := true
 	componentErrors := []string{}
 	componentMessages := []string{}
 	cpcConditions := map[string]openmcpv1alpha1.ManagedControlPlaneComponentCondition{}
 	for ct, ch := range compHandlers {
-		// delete components
-		if err := r.Client.Delete(ctx, ch.Resource()); err != nil {
-			allErrs = append(allErrs, fmt.Errorf("error deleting resource for component '%s': %w", string(ct), err))
+		// delete components of the current stage
+		if slices.Contains(stage, ct) {
+			if err := r.Client.Delete(ctx, ch.Resource()); client.IgnoreNotFound(err) != nil {
+				allErrs = append(allErrs, fmt.Errorf("error deleting resource for component '%s': %w", string(ct), err))
+			}
 		}
 		if hadReconcileAnnotation {
 			if err := componentutils.PatchAnnotation(ctx, r.Client, ch.Resource(), openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueReconcile); err != nil && !componentutils.IsAnnotationAlreadyExistsError(err) {
@@ -417,7 +564,10 @@ func (r *ManagedControlPlaneController) handleDelete(ctx context.Context, mcp *o
 		mcpReadyCon.Reason = cconst.ReasonNotAllComponentsReconciledSuccessfully
 		mcpReadyCon.Message = fmt.Sprintf("The following components could not be reconciled successfully:\n%s", strings.Join(componentErrors, "\n"))
 	}
-	return append(sortConditions(cpcConditions), openmcpv1alpha1.ManagedControlPlaneComponentCondition{ComponentCondition: mcpReadyCon}), ctrl.Result{}, errors.Join(allErrs...)
+	deletionStuckCon, untilTimeout := updateDeletionStatus(mcp, compHandlers, stage, waiting)
+	// the components trigger a reconciliation when they are gone, requeueing is only required to detect an exceeded deletion stage timeout
+	res := ctrl.Result{RequeueAfter: untilTimeout}
+	return append(sortConditions(cpcConditions), openmcpv1alpha1.ManagedControlPlaneComponentCondition{ComponentCondition: mcpReadyCon}, openmcpv1alpha1.ManagedControlPlaneComponentCondition{ComponentCondition: deletionStuckCon}), res, errors.Join(allErrs...)
 }
 
 // keyStringList returns the keys of the given map as list.
@@ -475,6 +625,45 @@ func sortConditions(mappedCons map[string]openmcpv1alpha1.ManagedControlPlaneCom
 	return cons
 }
 
+// aggregateStatus computes the status of a ManagedControlPlane from its conditions.
+// Without readiness gates, all conditions must be true for the ManagedControlPlane to be ready.
+// Otherwise, only the gated conditions must be true and the ManagedControlPlane is degraded if any other condition is not true.
+// The returned message lists the conditions which are responsible for the ManagedControlPlane not being ready, if any.
+func aggregateStatus(cons []openmcpv1alpha1.ManagedControlPlaneComponentCondition, gates []openmcpv1alpha1.ManagedControlPlaneReadinessGate) (openmcpv1alpha1.MCPStatus, string) {
+	gated := sets.New[string]()
+	for _, gate := range gates {
+		gated.Insert(gate.ConditionType)
+	}
+	found := sets.New[string]()
+	failedGates := []string{}
+	degraded := []string{}
+	for _, con := range cons {
+		found.Insert(con.Type)
+		if con.Status == openmcpv1alpha1.ComponentConditionStatusTrue {
+			continue
+		}
+		if len(gates) == 0 || gated.Has(con.Type) {
+			failedGates = append(failedGates, con.Type)
+		} else {
+			degraded = append(degraded, con.Type)
+		}
+	}
+	for _, missing := range sets.List(gated.Difference(found)) {
+		failedGates = append(failedGates, fmt.Sprintf("%s (missing)", missing))
+	}
+
+	if len(failedGates) > 0 {
+		if len(gates) == 0 {
+			return openmcpv1alpha1.MCPStatusNotReady, fmt.Sprintf("conditions not true: %s", strings.Join(failedGates, ", "))
+		}
+		return openmcpv1alpha1.MCPStatusNotReady, fmt.Sprintf("readiness gates not fulfilled: %s", strings.Join(failedGates, ", "))
+	}
+	if len(degraded) > 0 {
+		return openmcpv1alpha1.MCPStatusDegraded, fmt.Sprintf("conditions not true: %s", strings.Join(degraded, ", "))
+	}
+	return openmcpv1alpha1.MCPStatusReady, ""
+}
+
 // componentErrorFromCondition creates a one-liner error message from a component condition.
 func componentErrorFromCondition(ct openmcpv1alpha1.ComponentType, con openmcpv1alpha1.ComponentCondition) string {
 	return fmt.Sprintf("%s: [%s] %s", string(ct), con.Reason, con.Message)
@@ -482,15 +671,37 @@ func componentErrorFromCondition(ct openmcpv1alpha1.ComponentType, con openmcpv1
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ManagedControlPlaneController) SetupWithManager(mgr ctrl.Manager) error {
+	migrationPredicates := make([]predicate.Predicate, 0, migration.SupportedComponents.Len())
+	for _, ct := range sets.List(migration.SupportedComponents) {
+		migrationPredicates = append(migrationPredicates, componentutils.AnnotationChangedPredicate{Key: ct.ArchitectureMigrationAnnotation()})
+	}
 	ctrlbuild := ctrl.NewControllerManagedBy(mgr).For(&openmcpv1alpha1.ManagedControlPlane{}, builder.WithPredicates(predicate.Or(
+		predicate.Or(migrationPredicates...),
 		predicate.GenerationChangedPredicate{},
 		predicate.LabelChangedPredicate{},
 		openmcpctrlutil.GotAnnotationPredicate(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueReconcile),
+		openmcpctrlutil.GotAnnotationPredicate(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueHibernate),
+		openmcpctrlutil.GotAnnotationPredicate(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueWakeUp),
+		openmcpctrlutil.GotAnnotationPredicate(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValuePlan),
+		openmcpctrlutil.LostAnnotationPredicate(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValuePlan),
 		openmcpctrlutil.LostAnnotationPredicate(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueIgnore),
+		openmcpctrlutil.GotAnnotationPredicate(openmcpv1alpha1.ManagedControlPlaneDeletionConfirmationAnnotation, "true"),
+		openmcpctrlutil.LostAnnotationPredicate(openmcpv1alpha1.ManagedControlPlaneDeletionConfirmationAnnotation, "true"),
+		openmcpctrlutil.GotAnnotationPredicate(openmcpv1alpha1.ManagedControlPlaneCancelDeletionAnnotation, "true"),
 	)))
 	ctrlbuild.Owns(&openmcpv1alpha1.InternalConfiguration{}, builder.WithPredicates(predicate.GenerationChangedPredicate{}))
 	for _, ch := range components.Registry.GetKnownComponents() {
 		ctrlbuild.Owns(ch.Resource(), builder.WithPredicates(componentutils.StatusChangedPredicate{}))
 	}
-	return ctrlbuild.Complete(r)
+	r.EventRecorder = mgr.GetEventRecorderFor(ControllerName)
+	if err := crmetrics.Registry.Register(mcpometrics.NewManagedControlPlaneCollector(mgr.GetClient())); err != nil {
+		return fmt.Errorf("error registering ManagedControlPlane metrics: %w", err)
+	}
+	c, err := ctrlbuild.Build(r)
+	if err != nil {
+		return err
+	}
+	// components which are registered later on via ComponentDefinitions are watched via WatchComponent
+	r.watcher, err = r.newComponentWatcher(c, mgr)
+	return err
 }
@@ -1,6 +1,7 @@
 package managedcontrolplane_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path"
@@ -19,10 +20,18 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
 
 	openmcptesting "github.com/openmcp-project/controller-utils/pkg/testing"
 
@@ -232,7 +241,10 @@ var _ = Describe("CO-1153 ManagedControlPlane Controller", func() {
 
 		// delete the MCP and verify that all component resources are deleted
 		Expect(env.Client(testutils.CrateCluster).Delete(env.Ctx, mcp)).To(Succeed())
-		env.ShouldReconcile(mcpReconciler, req)
+		// components are deleted in stages, one reconciliation per stage
+		for range 3 {
+			env.ShouldReconcile(mcpReconciler, req)
+		}
 		for ct, ch := range components.Registry.GetKnownComponents() {
 			if ch != nil && ch.Resource() != nil && ch.Converter() != nil && ch.Converter().IsConfigured(mcp) {
 				err := env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), ch.Resource())
@@ -282,7 +294,7 @@ var _ = Describe("CO-1153 ManagedControlPlane Controller", func() {
 				}),
 			}),
 		))
-		Expect(mcp.Status.Conditions).To(HaveLen(2))
+		Expect(mcp.Status.Conditions).To(HaveLen(3))
 	})
 
 	It("should apply InternalConfigurations correctly", func() {
@@ -451,6 +463,728 @@ var _ = Describe("CO-1153 ManagedControlPlane Controller", func() {
 		}
 	})
 
+	It("should only require the conditions listed in the readiness gates for the MCP to be ready", func() {
+		env := testutils.DefaultTestSetupBuilder("testdata", "test-04").WithReconcilerConstructor(mcpReconciler, getReconciler, testutils.CrateCluster).Build()
+
+		mcp := &openmcpv1alpha1.ManagedControlPlane{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, mcp)).To(Succeed())
+		req := openmcptesting.RequestFromObject(mcp)
+
+		reconcileWithGates := func(gates ...string) {
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+			mcp.Spec.ReadinessGates = nil
+			for _, g := range gates {
+				mcp.Spec.ReadinessGates = append(mcp.Spec.ReadinessGates, openmcpv1alpha1.ManagedControlPlaneReadinessGate{ConditionType: g})
+			}
+			Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, mcp)).To(Succeed())
+			env.ShouldReconcile(mcpReconciler, req)
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		}
+
+		By("without readiness gates, all conditions are required")
+		reconcileWithGates()
+		Expect(mcp.Status.Status).To(Equal(openmcpv1alpha1.MCPStatusNotReady))
+		Expect(mcp.Status.Message).To(ContainSubstring(string(openmcpv1alpha1.AuthenticationComponent)))
+
+		By("conditions which are not gated only degrade the MCP")
+		reconcileWithGates("AdditionalCondition", cconst.ConditionMCPSuccessful)
+		Expect(mcp.Status.Status).To(Equal(openmcpv1alpha1.MCPStatusDegraded))
+		Expect(mcp.Status.Message).To(ContainSubstring(string(openmcpv1alpha1.AuthenticationComponent)))
+
+		By("gated conditions which are not true prevent readiness")
+		reconcileWithGates(cconst.ConditionMCPSuccessful, string(openmcpv1alpha1.AuthenticationComponent))
+		Expect(mcp.Status.Status).To(Equal(openmcpv1alpha1.MCPStatusNotReady))
+		Expect(mcp.Status.Message).To(ContainSubstring("readiness gates"))
+
+		By("gated conditions which don't exist prevent readiness")
+		reconcileWithGates(cconst.ConditionMCPSuccessful, "DoesNotExist")
+		Expect(mcp.Status.Status).To(Equal(openmcpv1alpha1.MCPStatusNotReady))
+		Expect(mcp.Status.Message).To(ContainSubstring("DoesNotExist (missing)"))
+	})
+
+	It("should report the progress of the MCP and its components", func() {
+		env := testutils.DefaultTestSetupBuilder("testdata", "test-01").WithReconcilerConstructor(mcpReconciler, getReconciler, testutils.CrateCluster).Build()
+
+		mcp := &openmcpv1alpha1.ManagedControlPlane{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, mcp)).To(Succeed())
+		req := openmcptesting.RequestFromObject(mcp)
+		componentPhase := func(ct openmcpv1alpha1.ComponentType, phase openmcpv1alpha1.MCPPhase) OmegaMatcher {
+			return MatchFields(IgnoreExtras, Fields{
+				"Component": Equal(ct),
+				"Phase":     Equal(phase),
+			})
+		}
+
+		By("provisioning")
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.Status.Progress).ToNot(BeNil())
+		Expect(mcp.Status.Progress.Phase).To(Equal(openmcpv1alpha1.MCPPhaseProvisioning))
+		Expect(mcp.Status.Progress.PhaseTransitionTime.IsZero()).To(BeFalse())
+		Expect(mcp.Status.Progress.Components).To(HaveLen(5))
+		for _, cp := range mcp.Status.Progress.Components {
+			Expect(cp.Phase).To(Equal(openmcpv1alpha1.MCPPhaseProvisioning), "component %s", cp.Component)
+		}
+		Expect(mcp.Status.Progress.RemainingSteps).To(Equal([]openmcpv1alpha1.ManagedControlPlaneStep{
+			{Component: openmcpv1alpha1.APIServerComponent},
+			{Component: openmcpv1alpha1.AuthenticationComponent, BlockedBy: []openmcpv1alpha1.ComponentType{openmcpv1alpha1.APIServerComponent}},
+			{Component: openmcpv1alpha1.AuthorizationComponent, BlockedBy: []openmcpv1alpha1.ComponentType{openmcpv1alpha1.APIServerComponent}},
+			{Component: openmcpv1alpha1.CloudOrchestratorComponent, BlockedBy: []openmcpv1alpha1.ComponentType{openmcpv1alpha1.APIServerComponent, openmcpv1alpha1.AuthenticationComponent, openmcpv1alpha1.AuthorizationComponent}},
+			{Component: openmcpv1alpha1.LandscaperComponent, BlockedBy: []openmcpv1alpha1.ComponentType{openmcpv1alpha1.APIServerComponent, openmcpv1alpha1.AuthenticationComponent, openmcpv1alpha1.AuthorizationComponent}},
+		}))
+		transitionTime := mcp.Status.Progress.PhaseTransitionTime
+
+		By("APIServer becomes ready")
+		as := &openmcpv1alpha1.APIServer{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), as)).To(Succeed())
+		as.Status.Conditions = []openmcpv1alpha1.ComponentCondition{
+			{Type: openmcpv1alpha1.APIServerComponent.HealthyCondition(), Status: openmcpv1alpha1.ComponentConditionStatusTrue},
+		}
+		as.Status.ObservedGenerations = openmcpv1alpha1.ObservedGenerations{
+			Resource:              as.Generation,
+			ManagedControlPlane:   mcp.Generation,
+			InternalConfiguration: -1,
+		}
+		// the APIServer has been created by the reconciler, so the fake client doesn't handle its status as subresource
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, as)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.Status.Progress.Phase).To(Equal(openmcpv1alpha1.MCPPhaseProvisioning))
+		Expect(mcp.Status.Progress.PhaseTransitionTime).To(Equal(transitionTime))
+		Expect(mcp.Status.Progress.FirstReadyTime).To(BeNil())
+		Expect(mcp.Status.Progress.Components).To(ContainElement(componentPhase(openmcpv1alpha1.APIServerComponent, openmcpv1alpha1.MCPPhaseReady)))
+		Expect(mcp.Status.Progress.Components).To(ContainElement(HaveField("FirstReadyTime", Not(BeNil()))))
+		Expect(mcp.Status.Progress.RemainingSteps).To(HaveLen(4))
+		Expect(mcp.Status.Progress.RemainingSteps[0]).To(Equal(openmcpv1alpha1.ManagedControlPlaneStep{Component: openmcpv1alpha1.AuthenticationComponent}))
+
+		By("APIServer fails")
+		as.Status.Conditions = append(as.Status.Conditions, openmcpv1alpha1.ComponentCondition{Type: openmcpv1alpha1.APIServerComponent.ReconciliationCondition(), Status: openmcpv1alpha1.ComponentConditionStatusFalse})
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, as)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.Status.Progress.Phase).To(Equal(openmcpv1alpha1.MCPPhaseFailed))
+		Expect(mcp.Status.Progress.Components).To(ContainElement(componentPhase(openmcpv1alpha1.APIServerComponent, openmcpv1alpha1.MCPPhaseFailed)))
+
+		By("Authentication fails before it has been ready")
+		auth := &openmcpv1alpha1.Authentication{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), auth)).To(Succeed())
+		auth.Status.Conditions = []openmcpv1alpha1.ComponentCondition{
+			{Type: openmcpv1alpha1.AuthenticationComponent.ReconciliationCondition(), Status: openmcpv1alpha1.ComponentConditionStatusFalse},
+		}
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, auth)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.Status.Progress.Components).To(ContainElement(componentPhase(openmcpv1alpha1.AuthenticationComponent, openmcpv1alpha1.MCPPhaseFailed)))
+
+		By("APIServer and Authentication recover, the MCP is provisioning again, as it has not been ready before")
+		as.Status.Conditions = as.Status.Conditions[:1]
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, as)).To(Succeed())
+		auth.Status.Conditions = nil
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, auth)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.Status.Progress.Phase).To(Equal(openmcpv1alpha1.MCPPhaseProvisioning))
+		Expect(mcp.Status.Progress.FirstReadyTime).To(BeNil())
+		Expect(mcp.Status.Progress.Components).To(ContainElements(
+			componentPhase(openmcpv1alpha1.APIServerComponent, openmcpv1alpha1.MCPPhaseReady),
+			componentPhase(openmcpv1alpha1.AuthenticationComponent, openmcpv1alpha1.MCPPhaseProvisioning),
+		))
+
+		By("deletion")
+		// keep the APIServer and CloudOrchestrator resources from being removed immediately
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), as)).To(Succeed())
+		as.SetFinalizers([]string{openmcpv1alpha1.APIServerComponent.Finalizer()})
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, as)).To(Succeed())
+		co := &openmcpv1alpha1.CloudOrchestrator{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), co)).To(Succeed())
+		co.SetFinalizers([]string{openmcpv1alpha1.CloudOrchestratorComponent.Finalizer()})
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, co)).To(Succeed())
+		mcp.SetAnnotations(map[string]string{openmcpv1alpha1.ManagedControlPlaneDeletionConfirmationAnnotation: "true"})
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, mcp)).To(Succeed())
+		Expect(env.Client(testutils.CrateCluster).Delete(env.Ctx, mcp)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.Status.Progress.Phase).To(Equal(openmcpv1alpha1.MCPPhaseDeleting))
+		Expect(mcp.Status.Progress.RemainingSteps).To(Equal([]openmcpv1alpha1.ManagedControlPlaneStep{
+			{Component: openmcpv1alpha1.CloudOrchestratorComponent},
+			{Component: openmcpv1alpha1.AuthorizationComponent, BlockedBy: []openmcpv1alpha1.ComponentType{openmcpv1alpha1.CloudOrchestratorComponent}},
+			{Component: openmcpv1alpha1.AuthenticationComponent, BlockedBy: []openmcpv1alpha1.ComponentType{openmcpv1alpha1.CloudOrchestratorComponent}},
+			{Component: openmcpv1alpha1.APIServerComponent, BlockedBy: []openmcpv1alpha1.ComponentType{openmcpv1alpha1.CloudOrchestratorComponent, openmcpv1alpha1.AuthorizationComponent, openmcpv1alpha1.AuthenticationComponent}},
+		}))
+	})
+
+	It("should record the time-to-ready of a component only once the progress has been persisted", func() {
+		failStatusUpdate := false
+		env := testutils.DefaultTestSetupBuilder("testdata", "test-01").WithReconcilerConstructor(mcpReconciler, getReconciler, testutils.CrateCluster).
+			WithFakeClientBuilderCall(testutils.CrateCluster, "WithInterceptorFuncs", interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					if _, ok := obj.(*openmcpv1alpha1.ManagedControlPlane); ok && failStatusUpdate {
+						return fmt.Errorf("status update failed")
+					}
+					return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+				},
+			}).Build()
+
+		mcp := &openmcpv1alpha1.ManagedControlPlane{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, mcp)).To(Succeed())
+		req := openmcptesting.RequestFromObject(mcp)
+		env.ShouldReconcile(mcpReconciler, req)
+		observations := componentReadyObservations(openmcpv1alpha1.APIServerComponent)
+
+		By("APIServer becomes ready, but the status update fails")
+		as := &openmcpv1alpha1.APIServer{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), as)).To(Succeed())
+		as.Status.Conditions = []openmcpv1alpha1.ComponentCondition{
+			{Type: openmcpv1alpha1.APIServerComponent.HealthyCondition(), Status: openmcpv1alpha1.ComponentConditionStatusTrue},
+		}
+		as.Status.ObservedGenerations = openmcpv1alpha1.ObservedGenerations{
+			Resource:              as.Generation,
+			ManagedControlPlane:   mcp.Generation,
+			InternalConfiguration: -1,
+		}
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, as)).To(Succeed())
+		failStatusUpdate = true
+		env.ShouldNotReconcileWithError(mcpReconciler, req, MatchError(ContainSubstring("status update failed")))
+		Expect(componentReadyObservations(openmcpv1alpha1.APIServerComponent)).To(Equal(observations))
+
+		By("the status update succeeds")
+		failStatusUpdate = false
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(componentReadyObservations(openmcpv1alpha1.APIServerComponent)).To(Equal(observations + 1))
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(componentReadyObservations(openmcpv1alpha1.APIServerComponent)).To(Equal(observations + 1))
+	})
+
+	It("should not record the time-to-ready of components which have been ready before the progress was reported", func() {
+		env := testutils.DefaultTestSetupBuilder("testdata", "test-01").WithReconcilerConstructor(mcpReconciler, getReconciler, testutils.CrateCluster).Build()
+
+		mcp := &openmcpv1alpha1.ManagedControlPlane{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, mcp)).To(Succeed())
+		req := openmcptesting.RequestFromObject(mcp)
+		env.ShouldReconcile(mcpReconciler, req)
+		observations := componentReadyObservations(openmcpv1alpha1.APIServerComponent)
+
+		as := &openmcpv1alpha1.APIServer{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), as)).To(Succeed())
+		as.Status.Conditions = []openmcpv1alpha1.ComponentCondition{
+			{Type: openmcpv1alpha1.APIServerComponent.HealthyCondition(), Status: openmcpv1alpha1.ComponentConditionStatusTrue},
+		}
+		as.Status.ObservedGenerations = openmcpv1alpha1.ObservedGenerations{
+			Resource:              as.Generation,
+			ManagedControlPlane:   mcp.Generation,
+			InternalConfiguration: -1,
+		}
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, as)).To(Succeed())
+		// simulate an MCP which has been reconciled by an operator version without progress reporting
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		mcp.Status.Progress = nil
+		Expect(env.Client(testutils.CrateCluster).Status().Update(env.Ctx, mcp)).To(Succeed())
+
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.Status.Progress.Components).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Component":      Equal(openmcpv1alpha1.APIServerComponent),
+			"Phase":          Equal(openmcpv1alpha1.MCPPhaseReady),
+			"FirstReadyTime": Not(BeNil()),
+		})))
+		Expect(componentReadyObservations(openmcpv1alpha1.APIServerComponent)).To(Equal(observations))
+	})
+
+	It("should delete the components in stages and report a stuck deletion stage", func() {
+		env := testutils.DefaultTestSetupBuilder("testdata", "test-01").WithReconcilerConstructor(mcpReconciler, getReconciler, testutils.CrateCluster).Build()
+		mcpocfg.Config.ManagedControlPlane.DeletionStageTimeout = &metav1.Duration{Duration: time.Hour}
+		defer func() {
+			mcpocfg.Config.ManagedControlPlane.DeletionStageTimeout = nil
+		}()
+
+		mcp := &openmcpv1alpha1.ManagedControlPlane{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, mcp)).To(Succeed())
+		req := openmcptesting.RequestFromObject(mcp)
+		env.ShouldReconcile(mcpReconciler, req)
+
+		// keep the CloudOrchestrator resource from being removed immediately
+		co := &openmcpv1alpha1.CloudOrchestrator{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), co)).To(Succeed())
+		co.SetFinalizers([]string{"test.openmcp.cloud/blocker"})
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, co)).To(Succeed())
+
+		exists := func(ct openmcpv1alpha1.ComponentType) bool {
+			err := env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), components.Registry.GetComponent(ct).Resource())
+			if apierrors.IsNotFound(err) {
+				return false
+			}
+			Expect(err).ToNot(HaveOccurred())
+			return true
+		}
+		deletionStuckCondition := func() openmcpv1alpha1.ComponentCondition {
+			for _, con := range mcp.Status.Conditions {
+				if con.Type == cconst.ConditionDeletionStuck {
+					return con.ComponentCondition
+				}
+			}
+			Fail("DeletionStuck condition not found")
+			return openmcpv1alpha1.ComponentCondition{}
+		}
+
+		By("deleting the components nothing depends on")
+		Expect(env.Client(testutils.CrateCluster).Delete(env.Ctx, mcp)).To(Succeed())
+		res := env.ShouldReconcile(mcpReconciler, req)
+		Expect(res.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(res.RequeueAfter).To(BeNumerically("<=", time.Hour))
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.Status.Deletion).ToNot(BeNil())
+		Expect(mcp.Status.Deletion.Stage).To(Equal([]openmcpv1alpha1.ComponentType{openmcpv1alpha1.CloudOrchestratorComponent, openmcpv1alpha1.LandscaperComponent}))
+		Expect(mcp.Status.Deletion.Waiting).To(Equal([]openmcpv1alpha1.ComponentType{openmcpv1alpha1.APIServerComponent, openmcpv1alpha1.AuthenticationComponent, openmcpv1alpha1.AuthorizationComponent}))
+		Expect(deletionStuckCondition().Status).To(Equal(openmcpv1alpha1.ComponentConditionStatusFalse))
+		Expect(exists(openmcpv1alpha1.LandscaperComponent)).To(BeFalse())
+		Expect(exists(openmcpv1alpha1.CloudOrchestratorComponent)).To(BeTrue())
+		Expect(exists(openmcpv1alpha1.AuthenticationComponent)).To(BeTrue())
+		Expect(exists(openmcpv1alpha1.AuthorizationComponent)).To(BeTrue())
+		Expect(exists(openmcpv1alpha1.APIServerComponent)).To(BeTrue())
+
+		By("reporting a stuck deletion stage")
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.Status.Deletion.Stage).To(Equal([]openmcpv1alpha1.ComponentType{openmcpv1alpha1.CloudOrchestratorComponent}))
+		mcp.Status.Deletion.StageStartTime = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+		Expect(env.Client(testutils.CrateCluster).Status().Update(env.Ctx, mcp)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(deletionStuckCondition()).To(MatchComponentCondition(openmcpv1alpha1.ComponentCondition{
+			Type:    cconst.ConditionDeletionStuck,
+			Status:  openmcpv1alpha1.ComponentConditionStatusTrue,
+			Reason:  cconst.ReasonDeletionStageTimeoutExceeded,
+			Message: "Deletion of components [CloudOrchestrator] has not finished within 1h0m0s. Blocking finalizers: CloudOrchestrator: [test.openmcp.cloud/blocker]",
+		}))
+
+		By("deleting the remaining components in reverse dependency order")
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), co)).To(Succeed())
+		co.SetFinalizers(nil)
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, co)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.Status.Deletion.Stage).To(Equal([]openmcpv1alpha1.ComponentType{openmcpv1alpha1.AuthenticationComponent, openmcpv1alpha1.AuthorizationComponent}))
+		Expect(deletionStuckCondition().Status).To(Equal(openmcpv1alpha1.ComponentConditionStatusFalse))
+		Expect(exists(openmcpv1alpha1.AuthenticationComponent)).To(BeFalse())
+		Expect(exists(openmcpv1alpha1.APIServerComponent)).To(BeTrue())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(exists(openmcpv1alpha1.APIServerComponent)).To(BeFalse())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(MatchError(apierrors.IsNotFound, "IsNotFound"))
+	})
+
+	It("should emit events for created components, status and condition changes, and deletion stages", func() {
+		recorder := record.NewFakeRecorder(100)
+		env := testutils.DefaultTestSetupBuilder("testdata", "test-01").WithReconcilerConstructor(mcpReconciler, func(c ...client.Client) reconcile.Reconciler {
+			r := managedcontrolplane.NewManagedControlPlaneController(c[0])
+			r.EventRecorder = recorder
+			return r
+		}, testutils.CrateCluster).Build()
+		receivedEvents := func() []string {
+			res := []string{}
+			for len(recorder.Events) > 0 {
+				res = append(res, <-recorder.Events)
+			}
+			return res
+		}
+
+		mcp := &openmcpv1alpha1.ManagedControlPlane{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, mcp)).To(Succeed())
+		req := openmcptesting.RequestFromObject(mcp)
+
+		By("creating the components")
+		env.ShouldReconcile(mcpReconciler, req)
+		events := receivedEvents()
+		for _, ct := range []openmcpv1alpha1.ComponentType{openmcpv1alpha1.APIServerComponent, openmcpv1alpha1.AuthenticationComponent, openmcpv1alpha1.AuthorizationComponent, openmcpv1alpha1.CloudOrchestratorComponent, openmcpv1alpha1.LandscaperComponent} {
+			Expect(events).To(ContainElement(fmt.Sprintf("Normal %s Created resource for component '%s'", managedcontrolplane.EventReasonComponentCreated, ct)))
+		}
+		Expect(events).To(ContainElement(HavePrefix(fmt.Sprintf("Warning %s Status changed from '' to '%s'", managedcontrolplane.EventReasonStatusChanged, openmcpv1alpha1.MCPStatusNotReady))))
+		Expect(events).To(ContainElement(fmt.Sprintf("Normal %s Condition '%s' added with status 'True': [%s]", managedcontrolplane.EventReasonConditionChanged, cconst.ConditionMCPSuccessful, cconst.ReasonAllComponentsReconciledSuccessfully)))
+
+		By("not emitting events if nothing changed")
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(receivedEvents()).To(BeEmpty())
+
+		By("reporting condition changes")
+		as := &openmcpv1alpha1.APIServer{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), as)).To(Succeed())
+		as.Status.Conditions = []openmcpv1alpha1.ComponentCondition{
+			{Type: openmcpv1alpha1.APIServerComponent.HealthyCondition(), Status: openmcpv1alpha1.ComponentConditionStatusTrue, Reason: "Healthy", Message: "The APIServer is healthy."},
+		}
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, as)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(receivedEvents()).To(ConsistOf(fmt.Sprintf("Normal %s Condition '%s' added with status 'True' (managed by %s): [Healthy] The APIServer is healthy.", managedcontrolplane.EventReasonConditionChanged, openmcpv1alpha1.APIServerComponent.HealthyCondition(), openmcpv1alpha1.APIServerComponent)))
+
+		By("reporting the deletion stages")
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		mcp.SetAnnotations(map[string]string{openmcpv1alpha1.ManagedControlPlaneDeletionConfirmationAnnotation: "true"})
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, mcp)).To(Succeed())
+		Expect(env.Client(testutils.CrateCluster).Delete(env.Ctx, mcp)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		events = receivedEvents()
+		Expect(events).To(ContainElement(fmt.Sprintf("Normal %s Deleting components [CloudOrchestrator, Landscaper], waiting for the deletion of components [APIServer, Authentication, Authorization]", managedcontrolplane.EventReasonDeletionStageStarted)))
+		Expect(events).To(ContainElement(HavePrefix(fmt.Sprintf("Normal %s Status changed from '%s' to '%s'", managedcontrolplane.EventReasonStatusChanged, openmcpv1alpha1.MCPStatusNotReady, openmcpv1alpha1.MCPStatusDeleting))))
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(receivedEvents()).To(ContainElement(fmt.Sprintf("Normal %s Deleting components [Authentication, Authorization], waiting for the deletion of components [APIServer]", managedcontrolplane.EventReasonDeletionStageStarted)))
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(receivedEvents()).To(ContainElement(fmt.Sprintf("Normal %s Deleting components [APIServer]", managedcontrolplane.EventReasonDeletionStageStarted)))
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(receivedEvents()).To(ContainElement(fmt.Sprintf("Normal %s All components have been deleted", managedcontrolplane.EventReasonComponentsDeleted)))
+	})
+
+	It("should pass the hibernation operation on to the APIServer and show a hibernated status", func() {
+		env := testutils.DefaultTestSetupBuilder("testdata", "test-06").WithReconcilerConstructor(mcpReconciler, getReconciler, testutils.CrateCluster).Build()
+
+		mcp := &openmcpv1alpha1.ManagedControlPlane{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, mcp)).To(Succeed())
+
+		env.ShouldReconcile(mcpReconciler, openmcptesting.RequestFromObject(mcp))
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.GetAnnotations()).ToNot(HaveKey(openmcpv1alpha1.OperationAnnotation))
+		Expect(mcp.Status.Status).To(Equal(openmcpv1alpha1.MCPStatusHibernated))
+		Expect(mcp.Status.Components.APIServer).ToNot(BeNil())
+		Expect(mcp.Status.Components.APIServer.Hibernation).To(Equal(&openmcpv1alpha1.HibernationStatus{State: openmcpv1alpha1.HibernationStateHibernated}))
+
+		as := &openmcpv1alpha1.APIServer{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), as)).To(Succeed())
+		Expect(as.GetAnnotations()).To(HaveKeyWithValue(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueHibernate))
+		Expect(as.Spec.GardenerConfig.Hibernation).To(Equal(mcp.Spec.Components.APIServer.GardenerConfig.Hibernation))
+	})
+
+	It("should hibernate the MCP during its deletion grace period and delete it afterwards, unless the deletion is cancelled", func() {
+		env := testutils.DefaultTestSetupBuilder("testdata", "test-07").WithReconcilerConstructor(mcpReconciler, getReconciler, testutils.CrateCluster).Build()
+
+		mcp := &openmcpv1alpha1.ManagedControlPlane{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, mcp)).To(Succeed())
+		req := openmcptesting.RequestFromObject(mcp)
+		as := &openmcpv1alpha1.APIServer{}
+
+		By("confirming the deletion")
+		res := env.ShouldReconcile(mcpReconciler, req)
+		Expect(res.RequeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.DeletionTimestamp.IsZero()).To(BeTrue())
+		Expect(mcp.Status.Status).To(Equal(openmcpv1alpha1.MCPStatusPendingDeletion))
+		Expect(mcp.Status.PendingDeletion).ToNot(BeNil())
+		Expect(mcp.Status.PendingDeletion.DeleteAfter.Time).To(Equal(mcp.Status.PendingDeletion.ConfirmedAt.Add(time.Hour)))
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), as)).To(Succeed())
+		Expect(as.GetAnnotations()).To(HaveKeyWithValue(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueHibernate))
+		Expect(as.Spec.GardenerConfig.Hibernation).To(Equal(&openmcpv1alpha1.HibernationConfig{Location: "Europe/Berlin"}))
+
+		By("cancelling the deletion")
+		// simulate the APIServer controller handling the operation
+		delete(as.Annotations, openmcpv1alpha1.OperationAnnotation)
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, as)).To(Succeed())
+		mcp.Annotations[openmcpv1alpha1.ManagedControlPlaneCancelDeletionAnnotation] = "true"
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, mcp)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.GetAnnotations()).ToNot(HaveKey(openmcpv1alpha1.ManagedControlPlaneCancelDeletionAnnotation))
+		Expect(mcp.GetAnnotations()).ToNot(HaveKey(openmcpv1alpha1.ManagedControlPlaneDeletionConfirmationAnnotation))
+		Expect(mcp.Status.Status).ToNot(Equal(openmcpv1alpha1.MCPStatusPendingDeletion))
+		Expect(mcp.Status.PendingDeletion).To(BeNil())
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), as)).To(Succeed())
+		Expect(as.GetAnnotations()).To(HaveKeyWithValue(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueWakeUp))
+		Expect(as.Spec.GardenerConfig.Hibernation).To(Equal(mcp.Spec.Components.APIServer.GardenerConfig.Hibernation))
+
+		By("confirming the deletion again and waiting for the grace period to pass")
+		mcp.SetAnnotations(map[string]string{openmcpv1alpha1.ManagedControlPlaneDeletionConfirmationAnnotation: "true"})
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, mcp)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.Status.PendingDeletion).ToNot(BeNil())
+		mcp.Status.PendingDeletion.DeleteAfter = metav1.NewTime(time.Now().Add(-time.Minute))
+		Expect(env.Client(testutils.CrateCluster).Status().Update(env.Ctx, mcp)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.DeletionTimestamp.IsZero()).To(BeFalse())
+	})
+
+	It("should keep the APIServer hibernated when cancelling the deletion of an MCP which was hibernated before", func() {
+		env := testutils.DefaultTestSetupBuilder("testdata", "test-07").WithReconcilerConstructor(mcpReconciler, getReconciler, testutils.CrateCluster).Build()
+
+		mcp := &openmcpv1alpha1.ManagedControlPlane{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, mcp)).To(Succeed())
+		req := openmcptesting.RequestFromObject(mcp)
+		as := &openmcpv1alpha1.APIServer{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), as)).To(Succeed())
+		as.Status.Hibernation = &openmcpv1alpha1.HibernationStatus{State: openmcpv1alpha1.HibernationStateHibernated}
+		Expect(env.Client(testutils.CrateCluster).Status().Update(env.Ctx, as)).To(Succeed())
+		mcp.Status.Components.APIServer = &openmcpv1alpha1.ExternalAPIServerStatus{Hibernation: as.Status.Hibernation}
+		Expect(env.Client(testutils.CrateCluster).Status().Update(env.Ctx, mcp)).To(Succeed())
+
+		By("confirming the deletion")
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.Status.Status).To(Equal(openmcpv1alpha1.MCPStatusPendingDeletion))
+		Expect(mcp.Status.PendingDeletion).ToNot(BeNil())
+		Expect(mcp.Status.PendingDeletion.APIServerWasHibernated).To(BeTrue())
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), as)).To(Succeed())
+		Expect(as.GetAnnotations()).ToNot(HaveKey(openmcpv1alpha1.OperationAnnotation))
+		Expect(as.Spec.GardenerConfig.Hibernation).To(Equal(&openmcpv1alpha1.HibernationConfig{Location: "Europe/Berlin"}))
+
+		By("cancelling the deletion")
+		mcp.Annotations[openmcpv1alpha1.ManagedControlPlaneCancelDeletionAnnotation] = "true"
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, mcp)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.Status.PendingDeletion).To(BeNil())
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), as)).To(Succeed())
+		Expect(as.GetAnnotations()).ToNot(HaveKey(openmcpv1alpha1.OperationAnnotation))
+		Expect(as.Spec.GardenerConfig.Hibernation).To(Equal(mcp.Spec.Components.APIServer.GardenerConfig.Hibernation))
+	})
+
+	It("should suspend the reconciliation of all components while the MCP is suspended and resume it afterwards", func() {
+		env := testutils.DefaultTestSetupBuilder("testdata", "test-01").WithReconcilerConstructor(mcpReconciler, getReconciler, testutils.CrateCluster).Build()
+
+		mcp := &openmcpv1alpha1.ManagedControlPlane{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, mcp)).To(Succeed())
+		req := openmcptesting.RequestFromObject(mcp)
+		env.ShouldReconcile(mcpReconciler, req)
+		// an operation which is pending when the MCP is suspended
+		co := &openmcpv1alpha1.CloudOrchestrator{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), co)).To(Succeed())
+		co.SetAnnotations(map[string]string{openmcpv1alpha1.OperationAnnotation: openmcpv1alpha1.OperationAnnotationValueReconcile})
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, co)).To(Succeed())
+
+		By("suspending the MCP")
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		mcp.Spec.Suspend = &openmcpv1alpha1.ManagedControlPlaneSuspension{Reason: "debugging incident 42"}
+		mcp.Spec.Components.Landscaper = nil
+		// set by the mutating webhook
+		mcp.SetAnnotations(map[string]string{
+			openmcpv1alpha1.ManagedControlPlaneSuspendedByAnnotation: "john.doe@example.org",
+			openmcpv1alpha1.OperationAnnotation:                      openmcpv1alpha1.OperationAnnotationValueHibernate,
+		})
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, mcp)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.Status.Status).To(Equal(openmcpv1alpha1.MCPStatusSuspended))
+		Expect(mcp.Status.Message).To(Equal("reconciliation suspended by john.doe@example.org: debugging incident 42"))
+		Expect(mcp.Status.Suspension).ToNot(BeNil())
+		Expect(mcp.Status.Suspension.SuspendedBy).To(Equal("john.doe@example.org"))
+		Expect(mcp.Status.Suspension.Reason).To(Equal("debugging incident 42"))
+		Expect(mcp.Status.Suspension.SuspendedAt.IsZero()).To(BeFalse())
+		// the hibernation operation is postponed until the MCP is resumed
+		Expect(mcp.GetAnnotations()).To(HaveKeyWithValue(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueHibernate))
+		// spec changes are not applied and all components are ignored by their controllers
+		for _, ct := range []openmcpv1alpha1.ComponentType{openmcpv1alpha1.APIServerComponent, openmcpv1alpha1.AuthenticationComponent, openmcpv1alpha1.AuthorizationComponent, openmcpv1alpha1.CloudOrchestratorComponent, openmcpv1alpha1.LandscaperComponent} {
+			comp := components.Registry.GetComponent(ct).Resource()
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), comp)).To(Succeed(), "component %s", ct)
+			Expect(comp.GetAnnotations()).To(HaveKeyWithValue(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueIgnore), "component %s", ct)
+		}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), co)).To(Succeed())
+		Expect(co.GetAnnotations()).To(HaveKeyWithValue(openmcpv1alpha1.SuspendedOperationAnnotation, openmcpv1alpha1.OperationAnnotationValueReconcile))
+
+		By("resuming the MCP")
+		mcp.Spec.Suspend = nil
+		delete(mcp.Annotations, openmcpv1alpha1.ManagedControlPlaneSuspendedByAnnotation)
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, mcp)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.Status.Status).ToNot(Equal(openmcpv1alpha1.MCPStatusSuspended))
+		Expect(mcp.Status.Suspension).To(BeNil())
+		Expect(mcp.GetAnnotations()).ToNot(HaveKey(openmcpv1alpha1.OperationAnnotation))
+		as := &openmcpv1alpha1.APIServer{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), as)).To(Succeed())
+		Expect(as.GetAnnotations()).To(HaveKeyWithValue(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueHibernate))
+		for _, ct := range []openmcpv1alpha1.ComponentType{openmcpv1alpha1.AuthenticationComponent, openmcpv1alpha1.AuthorizationComponent, openmcpv1alpha1.CloudOrchestratorComponent} {
+			comp := components.Registry.GetComponent(ct).Resource()
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), comp)).To(Succeed(), "component %s", ct)
+			Expect(comp.GetAnnotations()).ToNot(HaveKeyWithValue(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueIgnore), "component %s", ct)
+		}
+		// the operation which was pending when the MCP was suspended is restored
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), co)).To(Succeed())
+		Expect(co.GetAnnotations()).To(HaveKeyWithValue(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueReconcile))
+		Expect(co.GetAnnotations()).ToNot(HaveKey(openmcpv1alpha1.SuspendedOperationAnnotation))
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), &openmcpv1alpha1.Landscaper{})).To(MatchError(apierrors.IsNotFound, "IsNotFound"))
+	})
+
+	It("should handle components which have been registered via ComponentDefinitions", func() {
+		// the fake client needs to know the external resource kind
+		fooGVK := schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Foo"}
+		if !testutils.Scheme.Recognizes(fooGVK) {
+			testutils.Scheme.AddKnownTypeWithName(fooGVK, &unstructured.Unstructured{})
+			testutils.Scheme.AddKnownTypeWithName(fooGVK.GroupVersion().WithKind("FooList"), &unstructured.UnstructuredList{})
+		}
+		env := testutils.DefaultTestSetupBuilder("testdata", "test-01").WithReconcilerConstructor(mcpReconciler, getReconciler, testutils.CrateCluster).
+			WithFakeClientBuilderCall(testutils.CrateCluster, "WithInterceptorFuncs", externalComponentInterceptorFuncs).Build()
+		components.Registry.Register("Foo", components.NewExternalComponentHandlerFn(&openmcpv1alpha1.ComponentDefinition{
+			Spec: openmcpv1alpha1.ComponentDefinitionSpec{
+				Type:     "Foo",
+				Resource: openmcpv1alpha1.ComponentDefinitionResource{Group: fooGVK.Group, Version: fooGVK.Version, Kind: fooGVK.Kind},
+				SpecPath: "components.external.foo",
+			},
+		}), openmcpv1alpha1.APIServerComponent)
+		defer components.Registry.Register("Foo", nil)
+
+		mcp := &openmcpv1alpha1.ManagedControlPlane{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, mcp)).To(Succeed())
+		mcp.Spec.Components.External = &runtime.RawExtension{Raw: []byte(`{"foo":{"replicas":3}}`)}
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, mcp)).To(Succeed())
+		req := openmcptesting.RequestFromObject(mcp)
+		env.ShouldReconcile(mcpReconciler, req)
+
+		By("creating the component's resource from the configuration in the MCP spec")
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		foo := components.Registry.GetComponent("Foo").Resource()
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), foo)).To(Succeed())
+		Expect(foo.GetSpec()).To(Equal(map[string]any{"replicas": int64(3)}))
+		Expect(foo.GetLabels()).To(HaveKeyWithValue(openmcpv1alpha1.ManagedControlPlaneBackReferenceLabelName, mcp.Name))
+		Expect(foo.GetLabels()).To(HaveKeyWithValue(openmcpv1alpha1.ManagedControlPlaneGenerationLabel, fmt.Sprint(mcp.Generation)))
+		Expect(foo.GetOwnerReferences()).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Kind":       Equal("ManagedControlPlane"),
+			"Name":       Equal(mcp.Name),
+			"Controller": PointTo(BeTrue()),
+		})))
+		Expect(mcp.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"ComponentCondition": MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal("Foo"),
+				"Status": Equal(openmcpv1alpha1.ComponentConditionStatusUnknown),
+				"Reason": Equal(cconst.ReasonNoConditions),
+			}),
+			"ManagedBy": Equal(openmcpv1alpha1.ComponentType("Foo")),
+		})))
+
+		By("aggregating the conditions of the component's resource")
+		foo.SetCommonStatus(openmcpv1alpha1.CommonComponentStatus{
+			Conditions: openmcpv1alpha1.ComponentConditionList{
+				{Type: "FooReconciliation", Status: openmcpv1alpha1.ComponentConditionStatusFalse, Reason: "ConfigurationInvalid", Message: "replicas must be odd"},
+				{Type: "FooHealthy", Status: openmcpv1alpha1.ComponentConditionStatusTrue},
+			},
+		})
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, foo)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"ComponentCondition": MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal("FooHealthy"),
+				"Status": Equal(openmcpv1alpha1.ComponentConditionStatusTrue),
+			}),
+			"ManagedBy": Equal(openmcpv1alpha1.ComponentType("Foo")),
+		})))
+		Expect(mcp.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"ComponentCondition": MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(cconst.ConditionMCPSuccessful),
+				"Status":  Equal(openmcpv1alpha1.ComponentConditionStatusFalse),
+				"Message": ContainSubstring("Foo: [ConfigurationInvalid] replicas must be odd"),
+			}),
+		})))
+
+		By("planning an update of the component's resource")
+		mcp.SetAnnotations(map[string]string{openmcpv1alpha1.OperationAnnotation: openmcpv1alpha1.OperationAnnotationValuePlan})
+		mcp.Spec.Components.External = &runtime.RawExtension{Raw: []byte(`{"foo":{"replicas":5}}`)}
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, mcp)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		cm := &corev1.ConfigMap{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: managedcontrolplane.PlanConfigMapName(mcp.Name), Namespace: mcp.Namespace}, cm)).To(Succeed())
+		plan := &components.Plan{}
+		Expect(yaml.Unmarshal([]byte(cm.Data[managedcontrolplane.PlanConfigMapDataKey]), plan)).To(Succeed())
+		Expect(plan.Error).To(BeEmpty())
+		Expect(plan.Components).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Component":     Equal(openmcpv1alpha1.ComponentType("Foo")),
+			"Action":        Equal(components.PlanActionUpdate),
+			"ChangedFields": ConsistOf("spec.replicas"),
+		})))
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), foo)).To(Succeed())
+		Expect(foo.GetSpec()).To(Equal(map[string]any{"replicas": int64(3)}))
+
+		By("deleting the component's resource once it is removed from the MCP spec")
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		mcp.SetAnnotations(nil)
+		mcp.Spec.Components.External = nil
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, mcp)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), foo)).To(MatchError(apierrors.IsNotFound, "IsNotFound"))
+	})
+
+	It("should write a plan into a ConfigMap instead of applying changes in plan mode", func() {
+		env := testutils.DefaultTestSetupBuilder("testdata", "test-01").WithReconcilerConstructor(mcpReconciler, getReconciler, testutils.CrateCluster).Build()
+		components.Planners.Register(openmcpv1alpha1.LandscaperComponent, &fakePlanner{})
+		defer components.Planners.Register(openmcpv1alpha1.LandscaperComponent, nil)
+
+		mcp := &openmcpv1alpha1.ManagedControlPlane{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, mcp)).To(Succeed())
+		req := openmcptesting.RequestFromObject(mcp)
+
+		getPlan := func() *components.Plan {
+			cm := &corev1.ConfigMap{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: managedcontrolplane.PlanConfigMapName(mcp.Name), Namespace: mcp.Namespace}, cm)).To(Succeed())
+			Expect(cm.OwnerReferences).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Kind": Equal("ManagedControlPlane"),
+				"Name": Equal(mcp.Name),
+			})))
+			Expect(cm.Data).To(HaveKey(managedcontrolplane.PlanConfigMapDataKey))
+			plan := &components.Plan{}
+			Expect(yaml.Unmarshal([]byte(cm.Data[managedcontrolplane.PlanConfigMapDataKey]), plan)).To(Succeed())
+			Expect(plan.Error).To(BeEmpty())
+			return plan
+		}
+
+		By("planning the creation of all components")
+		mcp.SetAnnotations(map[string]string{openmcpv1alpha1.OperationAnnotation: openmcpv1alpha1.OperationAnnotationValuePlan})
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, mcp)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		Expect(mcp.GetAnnotations()).To(HaveKeyWithValue(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValuePlan))
+		Expect(mcp.Finalizers).To(BeEmpty())
+		plan := getPlan()
+		Expect(plan.Generation).To(Equal(mcp.Generation))
+		expectedComponents := []any{}
+		for ct, ch := range components.Registry.GetKnownComponents() {
+			if !ch.Converter().IsConfigured(mcp) {
+				continue
+			}
+			err := env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), ch.Resource())
+			Expect(apierrors.IsNotFound(err)).To(BeTrue(), "resource for component %s should not have been created in plan mode", ct)
+			expectedComponents = append(expectedComponents, MatchFields(IgnoreExtras, Fields{
+				"Component": Equal(ct),
+				"Action":    Equal(components.PlanActionCreate),
+				"Spec":      Not(BeNil()),
+			}))
+		}
+		Expect(plan.Components).To(ConsistOf(expectedComponents...))
+		for _, cp := range plan.Components {
+			if cp.Component == openmcpv1alpha1.LandscaperComponent {
+				Expect(cp.Downstream).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"Kind":   Equal("Fake"),
+					"Action": Equal(components.PlanActionCreate),
+				})))
+			} else {
+				Expect(cp.Downstream).To(BeEmpty())
+			}
+		}
+
+		By("applying the changes")
+		mcp.SetAnnotations(nil)
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, mcp)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		co := &openmcpv1alpha1.CloudOrchestrator{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), co)).To(Succeed())
+		Expect(co.Spec.Crossplane.Version).To(Equal("1.17.0"))
+
+		By("planning an update of a single component")
+		mcp.SetAnnotations(map[string]string{openmcpv1alpha1.OperationAnnotation: openmcpv1alpha1.OperationAnnotationValuePlan})
+		mcp.Spec.Components.Crossplane.Version = "1.18.0"
+		mcp.Spec.Components.BTPServiceOperator = nil
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, mcp)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), co)).To(Succeed())
+		Expect(co.Spec.Crossplane.Version).To(Equal("1.17.0"))
+		Expect(co.Spec.BTPServiceOperator).ToNot(BeNil())
+		plan = getPlan()
+		for _, cp := range plan.Components {
+			if cp.Component == openmcpv1alpha1.CloudOrchestratorComponent {
+				Expect(cp.Action).To(Equal(components.PlanActionUpdate))
+				Expect(cp.ChangedFields).To(ConsistOf("spec.btpServiceOperator", "spec.crossplane.version"))
+			} else {
+				Expect(cp.Action).To(Equal(components.PlanActionNone), "component %s should not be changed", cp.Component)
+				Expect(cp.ChangedFields).To(BeEmpty())
+			}
+		}
+	})
+
 	It("should add project and workspace metadata to MCP and all component resources, if present in namespace", func() {
 		var err error
 		env := testutils.DefaultTestSetupBuilder("testdata", "test-01").WithReconcilerConstructor(mcpReconciler, getReconciler, testutils.CrateCluster).Build()
@@ -538,6 +1272,41 @@ var _ = Describe("CO-1153 ManagedControlPlane Controller", func() {
 		reconcileAndTest()
 	})
 
+	It("should pass the architecture migration annotation on to the component and keep a migrated component on v2", func() {
+		env := testutils.DefaultTestSetupBuilder("testdata", "test-01").WithReconcilerConstructor(mcpReconciler, getReconciler, testutils.CrateCluster).Build()
+
+		mcp := &openmcpv1alpha1.ManagedControlPlane{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, types.NamespacedName{Name: "test", Namespace: "test"}, mcp)).To(Succeed())
+		delete(mcp.Labels, openmcpv1alpha1.APIServerComponent.ArchitectureVersionLabel())
+		mcp.Annotations = map[string]string{
+			openmcpv1alpha1.APIServerComponent.ArchitectureMigrationAnnotation(): openmcpv1alpha1.ArchitectureV2,
+		}
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, mcp)).To(Succeed())
+
+		req := openmcptesting.RequestFromObject(mcp)
+		env.ShouldReconcile(mcpReconciler, req)
+		as := &openmcpv1alpha1.APIServer{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), as)).To(Succeed())
+		Expect(as.GetAnnotations()).To(HaveKeyWithValue(openmcpv1alpha1.ArchitectureMigrationAnnotation, openmcpv1alpha1.ArchitectureV2))
+		Expect(as.GetLabels()).To(HaveKeyWithValue(openmcpv1alpha1.ArchitectureVersionLabel, openmcpv1alpha1.ArchitectureV1))
+		ls := &openmcpv1alpha1.Landscaper{}
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), ls)).To(Succeed())
+		Expect(ls.GetAnnotations()).ToNot(HaveKey(openmcpv1alpha1.ArchitectureMigrationAnnotation))
+
+		// mock the completed migration
+		as.Labels[openmcpv1alpha1.ArchitectureVersionLabel] = openmcpv1alpha1.ArchitectureV2
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, as)).To(Succeed())
+
+		// removing the annotation from the MCP removes it from the component, but doesn't switch it back to v1
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), mcp)).To(Succeed())
+		delete(mcp.Annotations, openmcpv1alpha1.APIServerComponent.ArchitectureMigrationAnnotation())
+		Expect(env.Client(testutils.CrateCluster).Update(env.Ctx, mcp)).To(Succeed())
+		env.ShouldReconcile(mcpReconciler, req)
+		Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(mcp), as)).To(Succeed())
+		Expect(as.GetAnnotations()).ToNot(HaveKey(openmcpv1alpha1.ArchitectureMigrationAnnotation))
+		Expect(as.GetLabels()).To(HaveKeyWithValue(openmcpv1alpha1.ArchitectureVersionLabel, openmcpv1alpha1.ArchitectureV2))
+	})
+
 	It("should throw an error if the MCP has an architecture version label for a component that does not allow overrides", func() {
 		env := testutils.DefaultTestSetupBuilder("testdata", "test-01").WithReconcilerConstructor(mcpReconciler, getReconciler, testutils.CrateCluster).Build()
 		mcpocfg.Config.Architecture.APIServer.AllowOverride = false
@@ -563,9 +1332,135 @@ var _ = Describe("CO-1153 ManagedControlPlane Controller", func() {
 		env.ShouldNotReconcileWithError(mcpReconciler, req, And(MatchError(ContainSubstring("version")), MatchError(ContainSubstring("APIServer")), MatchError(ContainSubstring("not allowed"))))
 	})
 
+	It("should validate the configuration of all configured components, the architecture version labels and the architecture migration annotations", func() {
+		mcpocfg.Config.Architecture.Landscaper.AllowOverride = false
+		mcp := &openmcpv1alpha1.ManagedControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "test",
+				Labels: map[string]string{
+					openmcpv1alpha1.APIServerComponent.ArchitectureVersionLabel():  "invalid",
+					openmcpv1alpha1.LandscaperComponent.ArchitectureVersionLabel(): openmcpv1alpha1.ArchitectureV1,
+				},
+
+				Annotations: map[string]string{
+					openmcpv1alpha1.APIServerComponent.ArchitectureMigrationAnnotation():      "v3",
+					openmcpv1alpha1.AuthenticationComponent.ArchitectureMigrationAnnotation(): openmcpv1alpha1.ArchitectureV2,
+				},
+			},
+			Spec: openmcpv1alpha1.ManagedControlPlaneSpec{
+				Components: openmcpv1alpha1.ManagedControlPlaneComponents{
+					APIServer: &openmcpv1alpha1.APIServerConfiguration{
+						Type: "Unknown",
+					},
+				},
+				Authentication: &openmcpv1alpha1.AuthenticationConfiguration{
+					IdentityProviders: []openmcpv1alpha1.IdentityProvider{
+						{Name: "idp", IssuerURL: "https://example.org", ClientID: "client"},
+						{Name: "idp", IssuerURL: "https://example.org"},
+					},
+				},
+				Authorization: &openmcpv1alpha1.AuthorizationConfiguration{
+					RoleBindings: []openmcpv1alpha1.RoleBinding{
+						{
+							Role: openmcpv1alpha1.RoleBindingRoleAdmin,
+							Subjects: []openmcpv1alpha1.Subject{
+								{Kind: "User", Name: "admin", APIGroup: "rbac.authorization.k8s.io"},
+								{Kind: "ServiceAccount", Name: "sa"},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		errs := managedcontrolplane.ValidateManagedControlPlane(context.Background(), mcp, nil)
+		Expect(errs).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal(fmt.Sprintf("metadata.labels[%s]", openmcpv1alpha1.APIServerComponent.ArchitectureVersionLabel())), "Type": Equal(field.ErrorTypeInvalid)})),
+			PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal(fmt.Sprintf("metadata.labels[%s]", openmcpv1alpha1.LandscaperComponent.ArchitectureVersionLabel())), "Type": Equal(field.ErrorTypeForbidden)})),
+			PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal(fmt.Sprintf("metadata.annotations[%s]", openmcpv1alpha1.APIServerComponent.ArchitectureMigrationAnnotation())), "Type": Equal(field.ErrorTypeNotSupported)})),
+			PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal(fmt.Sprintf("metadata.annotations[%s]", openmcpv1alpha1.AuthenticationComponent.ArchitectureMigrationAnnotation())), "Type": Equal(field.ErrorTypeForbidden)})),
+			PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("spec.components.apiServer.type"), "Type": Equal(field.ErrorTypeNotSupported)})),
+			PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("spec.authentication.identityProviders[1].name"), "Type": Equal(field.ErrorTypeDuplicate)})),
+			PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("spec.authentication.identityProviders[1].clientID"), "Type": Equal(field.ErrorTypeRequired)})),
+			PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("spec.authorization.roleBindings[0].subjects[1].namespace"), "Type": Equal(field.ErrorTypeRequired)})),
+		))
+
+		mcp.Labels = nil
+		mcp.Annotations = map[string]string{
+			openmcpv1alpha1.APIServerComponent.ArchitectureMigrationAnnotation(): openmcpv1alpha1.ArchitectureMigrationValueRollback,
+		}
+		mcp.Spec.Components.APIServer.Type = openmcpv1alpha1.Gardener
+		mcp.Spec.Authentication.IdentityProviders = mcp.Spec.Authentication.IdentityProviders[:1]
+		mcp.Spec.Authorization.RoleBindings[0].Subjects[1].Namespace = "default"
+		Expect(managedcontrolplane.ValidateManagedControlPlane(context.Background(), mcp, nil)).To(BeEmpty())
+	})
+
 })
 
+type fakePlanner struct{}
+
+func (p *fakePlanner) PlanDownstream(_ context.Context, desired, existing components.Component) ([]components.DownstreamChange, error) {
+	action := components.PlanActionCreate
+	if existing != nil {
+		action = components.PlanActionNone
+	}
+	return []components.DownstreamChange{{Kind: "Fake", Name: desired.GetName(), Action: action}}, nil
+}
+
+// externalComponentInterceptorFuncs pass the unstructured object wrapped by an ExternalComponent to the fake client.
+// This is required, because the fake client zeroes the given object before decoding into it, which would drop the wrapped object.
+var externalComponentInterceptorFuncs = interceptor.Funcs{
+	Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+		return c.Get(ctx, key, unwrapExternalComponent(obj), opts...)
+	},
+	Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+		return c.Create(ctx, unwrapExternalComponent(obj), opts...)
+	},
+	Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+		return c.Update(ctx, unwrapExternalComponent(obj), opts...)
+	},
+	Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+		return c.Patch(ctx, unwrapExternalComponent(obj), patch, opts...)
+	},
+	Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+		return c.Delete(ctx, unwrapExternalComponent(obj), opts...)
+	},
+	SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+		return c.SubResource(subResourceName).Update(ctx, unwrapExternalComponent(obj), opts...)
+	},
+	SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+		return c.SubResource(subResourceName).Patch(ctx, unwrapExternalComponent(obj), patch, opts...)
+	},
+}
+
+func unwrapExternalComponent(obj client.Object) client.Object {
+	if ec, ok := obj.(*components.ExternalComponent); ok {
+		return ec.Unstructured
+	}
+	return obj
+}
+
 func TestConfig(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "ManagedControlPlane Controller Test Suite")
 }
+
+// componentReadyObservations returns the number of observations of the time-to-ready metric for the given component.
+func componentReadyObservations(ct openmcpv1alpha1.ComponentType) uint64 {
+	mfs, err := crmetrics.Registry.Gather()
+	Expect(err).ToNot(HaveOccurred())
+	for _, mf := range mfs {
+		if mf.GetName() != "mcp_operator_component_time_to_ready_seconds" {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "component" && l.GetValue() == string(ct) {
+					return m.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+	}
+	return 0
+}
@@ -61,6 +61,19 @@ func (*ManagedControlPlaneController) ManagedControlPlaneToSplitInternalResource
 				})
 			}
 
+			// pass a requested architecture migration on to the component resource
+			if op, ok := mcp.Annotations[ct.ArchitectureMigrationAnnotation()]; ok {
+				if err := validateArchitectureMigrationAnnotation(mcp, ct); err != nil {
+					return nil, err
+				}
+				anns := ch.Resource().GetAnnotations()
+				if anns == nil {
+					anns = map[string]string{}
+				}
+				anns[openmcpv1alpha1.ArchitectureMigrationAnnotation] = op
+				ch.Resource().SetAnnotations(anns)
+			}
+
 			// take over architecture version label from the MCP resource, if override is allowed for the component
 			bridgeConfig := mcpocfg.Config.Architecture.GetBridgeConfigForComponent(ct)
 			cLabels := make(map[string]string, len(labels)+1)
@@ -68,11 +81,8 @@ func (*ManagedControlPlaneController) ManagedControlPlaneToSplitInternalResource
 			v, found := mcp.Labels[ct.ArchitectureVersionLabel()]
 			if found {
 				// check if version override is allowed for this component
-				if !bridgeConfig.AllowOverride {
-					return nil, fmt.Errorf("architecture version override is not allowed for component '%s', remove the '%s' label", string(ct), ct.ArchitectureVersionLabel())
-				}
-				if !bridgeConfig.IsAllowedVersion(v) {
-					return nil, fmt.Errorf("architecture version '%s' is not allowed for component '%s'", v, string(ct))
+				if err := validateArchitectureVersionLabel(mcp, ct, bridgeConfig); err != nil {
+					return nil, err
 				}
 				cLabels[openmcpv1alpha1.ArchitectureVersionLabel] = v
 			} else {
@@ -0,0 +1,95 @@
+package managedcontrolplane
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openmcp-project/mcp-operator/internal/components"
+	mcpocfg "github.com/openmcp-project/mcp-operator/internal/config"
+	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+)
+
+// deletionStage splits the given existing components into the ones which can be deleted now and the ones which have to wait.
+// A component has to wait as long as any other existing component depends on it.
+// Both returned lists are sorted.
+func deletionStage(compHandlers map[openmcpv1alpha1.ComponentType]*components.ComponentHandler) ([]openmcpv1alpha1.ComponentType, []openmcpv1alpha1.ComponentType) {
+	stage := []openmcpv1alpha1.ComponentType{}
+	waiting := []openmcpv1alpha1.ComponentType{}
+	for _, cts := range keyStringList(compHandlers, true) {
+		ct := openmcpv1alpha1.ComponentType(cts)
+		blocked := false
+		for other := range compHandlers {
+			if other != ct && slices.Contains(components.Registry.Dependencies(other), ct) {
+				blocked = true
+				break
+			}
+		}
+		if blocked {
+			waiting = append(waiting, ct)
+		} else {
+			stage = append(stage, ct)
+		}
+	}
+	if len(stage) == 0 {
+		// cannot happen for validated dependencies, delete everything at once to avoid a deadlock
+		return waiting, nil
+	}
+	return stage, waiting
+}
+
+// updateDeletionStatus writes the current deletion stage into the ManagedControlPlane's status and computes the DeletionStuck condition.
+// The stage start time is kept if the stage didn't change since the last reconciliation.
+// If a deletion stage timeout is configured and not yet exceeded, the returned duration is the time until it will be exceeded, otherwise it is zero.
+func updateDeletionStatus(mcp *openmcpv1alpha1.ManagedControlPlane, compHandlers map[openmcpv1alpha1.ComponentType]*components.ComponentHandler, stage, waiting []openmcpv1alpha1.ComponentType) (openmcpv1alpha1.ComponentCondition, time.Duration) {
+	now := time.Now()
+	ds := &openmcpv1alpha1.ManagedControlPlaneDeletionStatus{
+		Stage:          stage,
+		StageStartTime: metav1.NewTime(now),
+		Waiting:        waiting,
+	}
+	if prev := mcp.Status.Deletion; prev != nil && slices.Equal(prev.Stage, stage) {
+		ds.StageStartTime = prev.StageStartTime
+	}
+	mcp.Status.Deletion = ds
+
+	var timeout time.Duration
+	if mcpocfg.Config.ManagedControlPlane.DeletionStageTimeout != nil {
+		timeout = mcpocfg.Config.ManagedControlPlane.DeletionStageTimeout.Duration
+	}
+	if timeout <= 0 {
+		return componentutils.NewCondition(cconst.ConditionDeletionStuck, openmcpv1alpha1.ComponentConditionStatusFalse, cconst.ReasonDeletionStageProgressing, ""), 0
+	}
+	remaining := ds.StageStartTime.Add(timeout).Sub(now)
+	if remaining > 0 {
+		return componentutils.NewCondition(cconst.ConditionDeletionStuck, openmcpv1alpha1.ComponentConditionStatusFalse, cconst.ReasonDeletionStageProgressing, ""), remaining
+	}
+
+	blocking := make([]string, 0, len(stage))
+	for _, ct := range stage {
+		ch, ok := compHandlers[ct]
+		if !ok {
+			continue
+		}
+		fins := slices.Clone(ch.Resource().GetFinalizers())
+		slices.Sort(fins)
+		blocking = append(blocking, fmt.Sprintf("%s: [%s]", string(ct), strings.Join(fins, ", ")))
+	}
+	return componentutils.NewCondition(cconst.ConditionDeletionStuck, openmcpv1alpha1.ComponentConditionStatusTrue, cconst.ReasonDeletionStageTimeoutExceeded,
+		fmt.Sprintf("Deletion of components %s has not finished within %s. Blocking finalizers: %s", formatComponentTypes(stage), timeout.String(), strings.Join(blocking, "; "))), 0
+}
+
+// formatComponentTypes returns a human-readable list of the given component types.
+func formatComponentTypes(cts []openmcpv1alpha1.ComponentType) string {
+	names := make([]string, len(cts))
+	for i, ct := range cts {
+		names[i] = string(ct)
+	}
+	return fmt.Sprintf("[%s]", strings.Join(names, ", "))
+}
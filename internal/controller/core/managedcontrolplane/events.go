@@ -0,0 +1,83 @@
+package managedcontrolplane
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+)
+
+const (
+	// EventReasonComponentCreated is used for events about a component resource which has been created for a ManagedControlPlane.
+	EventReasonComponentCreated = "ComponentCreated"
+	// EventReasonComponentDeleted is used for events about a component resource which is deleted because it has been removed from the ManagedControlPlane spec.
+	EventReasonComponentDeleted = "ComponentDeleted"
+	// EventReasonStatusChanged is used for events about a change of the ManagedControlPlane's status.
+	EventReasonStatusChanged = "StatusChanged"
+	// EventReasonConditionChanged is used for events about a change of the status of one of the ManagedControlPlane's conditions.
+	EventReasonConditionChanged = "ConditionChanged"
+	// EventReasonDeletionStageStarted is used for events about the start of a new stage in the deletion of a ManagedControlPlane's components.
+	EventReasonDeletionStageStarted = "DeletionStageStarted"
+	// EventReasonComponentsDeleted is used for the event which is emitted when all components of a ManagedControlPlane in deletion are gone.
+	EventReasonComponentsDeleted = "ComponentsDeleted"
+)
+
+// eventf emits an event for the given ManagedControlPlane, if an event recorder is configured.
+func (r *ManagedControlPlaneController) eventf(mcp *openmcpv1alpha1.ManagedControlPlane, eventType, reason, messageFmt string, args ...any) {
+	if r.EventRecorder == nil {
+		return
+	}
+	r.EventRecorder.Eventf(mcp, eventType, reason, messageFmt, args...)
+}
+
+// recordStatusEvents emits events for the differences between the given old status and the current status of the ManagedControlPlane.
+// An event is emitted if the ManagedControlPlane's status changed and for each condition which has been added or whose status changed.
+func (r *ManagedControlPlaneController) recordStatusEvents(mcp *openmcpv1alpha1.ManagedControlPlane, oldStatus *openmcpv1alpha1.ManagedControlPlaneStatus) {
+	if oldStatus.Status != mcp.Status.Status {
+		eventType := corev1.EventTypeNormal
+		if mcp.Status.Status == openmcpv1alpha1.MCPStatusNotReady || mcp.Status.Status == openmcpv1alpha1.MCPStatusDegraded {
+			eventType = corev1.EventTypeWarning
+		}
+		msg := fmt.Sprintf("Status changed from '%s' to '%s'", oldStatus.Status, mcp.Status.Status)
+		if mcp.Status.Message != "" {
+			msg = fmt.Sprintf("%s: %s", msg, mcp.Status.Message)
+		}
+		r.eventf(mcp, eventType, EventReasonStatusChanged, "%s", msg)
+	}
+
+	oldConStatus := make(map[string]openmcpv1alpha1.ComponentConditionStatus, len(oldStatus.Conditions))
+	for _, con := range oldStatus.Conditions {
+		oldConStatus[con.Type] = con.Status
+	}
+	for _, con := range mcp.Status.Conditions {
+		old, ok := oldConStatus[con.Type]
+		if ok && old == con.Status {
+			continue
+		}
+		if !ok && con.Status == openmcpv1alpha1.ComponentConditionStatusUnknown {
+			// don't report new conditions which don't have a status yet
+			continue
+		}
+		eventType := corev1.EventTypeNormal
+		// the DeletionStuck condition is the only condition which indicates a problem if it is true
+		if (con.Status == openmcpv1alpha1.ComponentConditionStatusTrue) == (con.Type == cconst.ConditionDeletionStuck) {
+			eventType = corev1.EventTypeWarning
+		}
+		msg := fmt.Sprintf("Condition '%s' changed to '%s'", con.Type, con.Status)
+		if !ok {
+			msg = fmt.Sprintf("Condition '%s' added with status '%s'", con.Type, con.Status)
+		}
+		if con.ManagedBy != "" {
+			msg = fmt.Sprintf("%s (managed by %s)", msg, string(con.ManagedBy))
+		}
+		if con.Reason != "" {
+			msg = fmt.Sprintf("%s: [%s]", msg, con.Reason)
+		}
+		if con.Message != "" {
+			msg = fmt.Sprintf("%s %s", msg, con.Message)
+		}
+		r.eventf(mcp, eventType, EventReasonConditionChanged, "%s", msg)
+	}
+}
@@ -0,0 +1,126 @@
+package managedcontrolplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/openmcp-project/mcp-operator/internal/components"
+	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+)
+
+// componentWatcher adds watches for the resources of components which are registered after the controller has been set up.
+type componentWatcher struct {
+	lock       sync.Mutex
+	controller controller.Controller
+	cache      cache.Cache
+	scheme     *runtime.Scheme
+	mapper     meta.RESTMapper
+	// watched contains the resource kinds which are already watched.
+	watched sets.Set[schema.GroupVersionKind]
+	// ctx and queue are set once the controller has been started.
+	ctx   context.Context
+	queue workqueue.TypedRateLimitingInterface[reconcile.Request]
+	// pending contains the types of components which have been registered before the controller has been started.
+	pending sets.Set[openmcpv1alpha1.ComponentType]
+}
+
+// newComponentWatcher creates a componentWatcher for the given controller and registers the source which provides it with the controller's queue.
+func (r *ManagedControlPlaneController) newComponentWatcher(c controller.Controller, mgr ctrl.Manager) (*componentWatcher, error) {
+	w := &componentWatcher{
+		controller: c,
+		cache:      mgr.GetCache(),
+		scheme:     mgr.GetScheme(),
+		mapper:     mgr.GetRESTMapper(),
+		watched:    sets.New[schema.GroupVersionKind](),
+		pending:    sets.New[openmcpv1alpha1.ComponentType](),
+	}
+	// a single source is used for enqueuing the ManagedControlPlanes of all components registered at runtime,
+	// adding one per registration would leak a source for every change of a ComponentDefinition
+	err := c.Watch(source.Func(func(ctx context.Context, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+		w.lock.Lock()
+		defer w.lock.Unlock()
+		w.ctx = ctx
+		w.queue = queue
+		errs := []error{}
+		for _, ct := range sets.List(w.pending) {
+			errs = append(errs, r.enqueueConfiguringManagedControlPlanes(ctx, ct, queue))
+		}
+		w.pending.Clear()
+		return errors.Join(errs...)
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("error adding source for components registered at runtime: %w", err)
+	}
+	return w, nil
+}
+
+// WatchComponent makes the controller watch the resources of the given component, which has been registered at runtime, e.g. via a ComponentDefinition.
+// Additionally, all ManagedControlPlanes which contain configuration for the component are enqueued, so that the component's resources are created for them.
+// Calling it multiple times for the same resource kind adds the watch only once, but enqueues the ManagedControlPlanes every time.
+// It must not be called before SetupWithManager.
+func (r *ManagedControlPlaneController) WatchComponent(comp components.Component) error {
+	if r.watcher == nil {
+		return fmt.Errorf("controller '%s' has not been set up", ControllerName)
+	}
+	r.watcher.lock.Lock()
+	defer r.watcher.lock.Unlock()
+
+	gvk := comp.GetObjectKind().GroupVersionKind()
+	if !r.watcher.watched.Has(gvk) {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		src := source.Kind[client.Object](r.watcher.cache, obj,
+			handler.EnqueueRequestForOwner(r.watcher.scheme, r.watcher.mapper, &openmcpv1alpha1.ManagedControlPlane{}, handler.OnlyControllerOwner()),
+			componentutils.StatusChangedPredicate{})
+		if err := r.watcher.controller.Watch(src); err != nil {
+			return fmt.Errorf("error watching resources of component '%s': %w", string(comp.Type()), err)
+		}
+		r.watcher.watched.Insert(gvk)
+	}
+
+	if r.watcher.queue == nil {
+		// the ManagedControlPlanes are enqueued once the controller has been started
+		r.watcher.pending.Insert(comp.Type())
+		return nil
+	}
+	return r.enqueueConfiguringManagedControlPlanes(r.watcher.ctx, comp.Type(), r.watcher.queue)
+}
+
+// enqueueConfiguringManagedControlPlanes adds all ManagedControlPlanes which contain configuration for the given component to the queue.
+func (r *ManagedControlPlaneController) enqueueConfiguringManagedControlPlanes(ctx context.Context, ct openmcpv1alpha1.ComponentType, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+	ch := components.Registry.GetComponent(ct)
+	if ch == nil || ch.Converter() == nil {
+		return nil
+	}
+	mcps := &openmcpv1alpha1.ManagedControlPlaneList{}
+	if err := r.Client.List(ctx, mcps); err != nil {
+		return fmt.Errorf("error listing ManagedControlPlanes: %w", err)
+	}
+	log := logging.FromContextOrDiscard(ctx)
+	for _, mcp := range mcps.Items {
+		if ch.Converter().IsConfigured(&mcp) {
+			log.Debug("Enqueuing ManagedControlPlane for newly registered component", "component", string(ct), "resource", client.ObjectKeyFromObject(&mcp).String())
+			queue.Add(reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&mcp)})
+		}
+	}
+	return nil
+}
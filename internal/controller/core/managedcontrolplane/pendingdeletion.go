@@ -0,0 +1,101 @@
+package managedcontrolplane
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+)
+
+// handlePendingDeletion checks whether the deletion of the given ManagedControlPlane has been confirmed while it has a deletion grace period.
+// It schedules and cancels the deletion, and deletes the ManagedControlPlane once the grace period has passed.
+// The status of the ManagedControlPlane is modified, but not written.
+// It returns whether the ManagedControlPlane is pending deletion and the time until its grace period passes.
+// The returned hibernation operation is the operation to pass on to the APIServer, if any.
+func (r *ManagedControlPlaneController) handlePendingDeletion(ctx context.Context, mcp *openmcpv1alpha1.ManagedControlPlane) (bool, time.Duration, string, error) {
+	log := logging.FromContextOrPanic(ctx)
+
+	wasPending := mcp.Status.PendingDeletion != nil
+	confirmed := mcp.GetAnnotations()[openmcpv1alpha1.ManagedControlPlaneDeletionConfirmationAnnotation] == "true"
+	cancelled := mcp.GetAnnotations()[openmcpv1alpha1.ManagedControlPlaneCancelDeletionAnnotation] == "true"
+
+	if cancelled {
+		log.Info("Cancelling deletion of ManagedControlPlane due to cancel deletion annotation", "wasPending", wasPending)
+		if err := componentutils.PatchAnnotation(ctx, r.Client, mcp, openmcpv1alpha1.ManagedControlPlaneCancelDeletionAnnotation, "", componentutils.ANNOTATION_DELETE); err != nil {
+			return false, 0, "", fmt.Errorf("error removing cancel deletion annotation: %w", err)
+		}
+		if confirmed && mcp.Spec.DeletionGracePeriod != nil {
+			// without a grace period, the confirmation annotation doesn't trigger the deletion, so it is only removed if the deletion is actually cancelled
+			if err := componentutils.PatchAnnotation(ctx, r.Client, mcp, openmcpv1alpha1.ManagedControlPlaneDeletionConfirmationAnnotation, "", componentutils.ANNOTATION_DELETE); err != nil {
+				return false, 0, "", fmt.Errorf("error removing deletion confirmation annotation: %w", err)
+			}
+		}
+		confirmed = false
+	}
+
+	if !confirmed || mcp.Spec.DeletionGracePeriod == nil {
+		if !wasPending {
+			return false, 0, "", nil
+		}
+		wasHibernated := mcp.Status.PendingDeletion.APIServerWasHibernated
+		mcp.Status.PendingDeletion = nil
+		if wasHibernated {
+			// restore the previous hibernation state, the hibernation schedules apply again
+			log.Info("ManagedControlPlane is not pending deletion anymore, keeping APIServer hibernated as it was hibernated before")
+			return false, 0, "", nil
+		}
+		log.Info("ManagedControlPlane is not pending deletion anymore, waking up APIServer")
+		return false, 0, openmcpv1alpha1.OperationAnnotationValueWakeUp, nil
+	}
+
+	now := time.Now()
+	if !wasPending {
+		log.Info("Deletion of ManagedControlPlane confirmed, scheduling deletion after grace period", "gracePeriod", mcp.Spec.DeletionGracePeriod.Duration.String())
+		mcp.Status.PendingDeletion = &openmcpv1alpha1.ManagedControlPlanePendingDeletionStatus{
+			ConfirmedAt:            metav1.NewTime(now),
+			DeleteAfter:            metav1.NewTime(now.Add(mcp.Spec.DeletionGracePeriod.Duration)),
+			APIServerWasHibernated: apiServerHibernated(mcp),
+		}
+	}
+	remaining := mcp.Status.PendingDeletion.DeleteAfter.Sub(now)
+	if remaining <= 0 {
+		log.Info("Deletion grace period has passed, deleting ManagedControlPlane")
+		if err := r.Client.Delete(ctx, mcp); err != nil {
+			return true, 0, "", fmt.Errorf("error deleting ManagedControlPlane after deletion grace period: %w", err)
+		}
+		return true, 0, "", nil
+	}
+
+	// keep the APIServer hibernated while the ManagedControlPlane is pending deletion
+	hibernationOp := ""
+	if !apiServerHibernated(mcp) {
+		hibernationOp = openmcpv1alpha1.OperationAnnotationValueHibernate
+	}
+	return true, remaining, hibernationOp, nil
+}
+
+// apiServerHibernated returns whether the APIServer of the given ManagedControlPlane is hibernated or currently being hibernated, according to the ManagedControlPlane's status.
+func apiServerHibernated(mcp *openmcpv1alpha1.ManagedControlPlane) bool {
+	as := mcp.Status.Components.APIServer
+	return as != nil && as.Hibernation != nil && (as.Hibernation.State == openmcpv1alpha1.HibernationStateHibernated || as.Hibernation.State == openmcpv1alpha1.HibernationStateHibernating)
+}
+
+// keepHibernated modifies the given generated APIServer so that it stays hibernated while its ManagedControlPlane is pending deletion.
+// The hibernation schedules are removed, so that the APIServer is only woken up on demand.
+func keepHibernated(as *openmcpv1alpha1.APIServer) {
+	if as.Spec.GardenerConfig == nil {
+		// only Gardener APIServers can be hibernated
+		return
+	}
+	hc := &openmcpv1alpha1.HibernationConfig{}
+	if as.Spec.GardenerConfig.Hibernation != nil {
+		hc.Location = as.Spec.GardenerConfig.Hibernation.Location
+	}
+	as.Spec.GardenerConfig.Hibernation = hc
+}
@@ -0,0 +1,158 @@
+package managedcontrolplane
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openmcp-project/mcp-operator/internal/components"
+	"github.com/openmcp-project/mcp-operator/internal/utils"
+	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
+	"github.com/openmcp-project/mcp-operator/internal/utils/drift"
+
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+)
+
+// PlanConfigMapDataKey is the key under which the plan is stored in the plan ConfigMap.
+const PlanConfigMapDataKey = "plan.yaml"
+
+// PlanConfigMapName returns the name of the ConfigMap which the plan for the ManagedControlPlane with the given name is written to.
+// The ConfigMap is located in the namespace of the ManagedControlPlane.
+func PlanConfigMapName(mcpName string) string {
+	return utils.PrefixWithNamespace(mcpName, "plan")
+}
+
+// handlePlan computes the plan for the given ManagedControlPlane and writes it into the plan ConfigMap.
+// Apart from the plan ConfigMap, nothing is modified.
+func (r *ManagedControlPlaneController) handlePlan(ctx context.Context, mcp *openmcpv1alpha1.ManagedControlPlane, icfg *openmcpv1alpha1.InternalConfiguration, ns *corev1.Namespace) error {
+	log := logging.FromContextOrPanic(ctx)
+
+	plan := r.Plan(ctx, mcp, icfg, ns)
+	data, err := yaml.Marshal(plan)
+	if err != nil {
+		return fmt.Errorf("error marshalling plan: %w", err)
+	}
+
+	cm := &corev1.ConfigMap{}
+	cm.SetName(PlanConfigMapName(mcp.Name))
+	cm.SetNamespace(mcp.Namespace)
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, cm, func() error {
+		if cm.Labels == nil {
+			cm.Labels = map[string]string{}
+		}
+		cm.Labels[openmcpv1alpha1.ManagedControlPlaneBackReferenceLabelName] = mcp.Name
+		cm.Labels[openmcpv1alpha1.ManagedControlPlaneBackReferenceLabelNamespace] = mcp.Namespace
+		cm.Data = map[string]string{
+			PlanConfigMapDataKey: string(data),
+		}
+		return controllerutil.SetControllerReference(mcp, cm, r.Client.Scheme())
+	}); err != nil {
+		return fmt.Errorf("error writing plan ConfigMap '%s/%s': %w", cm.Namespace, cm.Name, err)
+	}
+	log.Info("Plan has been written", "configMap", fmt.Sprintf("%s/%s", cm.Namespace, cm.Name), "planError", plan.Error)
+	return nil
+}
+
+// Plan computes the changes which would be made if the current spec of the given ManagedControlPlane was applied.
+// Errors which prevent the plan from being computed are returned as part of the plan.
+func (r *ManagedControlPlaneController) Plan(ctx context.Context, mcp *openmcpv1alpha1.ManagedControlPlane, icfg *openmcpv1alpha1.InternalConfiguration, ns *corev1.Namespace) *components.Plan {
+	log := logging.FromContextOrPanic(ctx)
+	plan := &components.Plan{
+		Generation: mcp.Generation,
+	}
+
+	curCompHandlers, err := componentutils.GetComponents[*components.ComponentHandler](components.Registry, ctx, r.Client, mcp.Name, mcp.Namespace)
+	if err != nil {
+		plan.Error = fmt.Sprintf("error fetching current components from cluster: %s", err.Error())
+		return plan
+	}
+	genCompHandlers, err := r.ManagedControlPlaneToSplitInternalResources(mcp, icfg, ns, r.Client.Scheme(), false)
+	if err != nil {
+		plan.Error = fmt.Sprintf("unable to convert ManagedControlPlane to internal resources: %s", err.Error())
+		return plan
+	}
+
+	// iterate over the sorted component types, so that the plan is deterministic
+	for _, cts := range keyStringList(components.Registry.GetKnownComponents(), true) {
+		ct := openmcpv1alpha1.ComponentType(cts)
+		clog := log.WithValues("component", cts)
+		ch, existingOk := curCompHandlers[ct]
+		genCh, generatedOk := genCompHandlers[ct]
+		if !existingOk && !generatedOk {
+			continue
+		}
+		cp := components.ComponentPlan{
+			Component: ct,
+		}
+		if !generatedOk {
+			cp.Action = components.PlanActionDelete
+			plan.Components = append(plan.Components, cp)
+			continue
+		}
+
+		// compute the component resource as the ManagedControlPlane controller would write it
+		var desired, existing components.Component
+		if existingOk {
+			existing = ch.Resource()
+			desired = existing.DeepCopyObject().(components.Component)
+			if err := desired.SetSpec(genCh.Resource().GetSpec()); err != nil {
+				plan.Error = fmt.Sprintf("internal error transferring generated spec to existing resource for component '%s': %s", cts, err.Error())
+				return plan
+			}
+			changed, err := specDiff(existing.GetSpec(), desired.GetSpec())
+			if err != nil {
+				plan.Error = fmt.Sprintf("error comparing spec of component '%s': %s", cts, err.Error())
+				return plan
+			}
+			cp.ChangedFields = changed
+			cp.Action = components.PlanActionNone
+			if len(changed) > 0 {
+				cp.Action = components.PlanActionUpdate
+			}
+		} else {
+			desired = genCh.Resource()
+			cp.Action = components.PlanActionCreate
+		}
+		cp.Spec = desired.GetSpec()
+
+		if planner := components.Planners.Get(ct); planner != nil {
+			clog.Debug("Planning downstream changes")
+			downstream, err := planner.PlanDownstream(ctx, desired, existing)
+			if err != nil {
+				clog.Info("Unable to plan downstream changes", "error", err.Error())
+				cp.Message = fmt.Sprintf("Unable to plan downstream changes: %s", err.Error())
+			}
+			cp.Downstream = downstream
+		}
+		plan.Components = append(plan.Components, cp)
+	}
+	return plan
+}
+
+// specDiff returns the paths of all fields in which the two given specs differ.
+// The specs are expected to be pointers to structs or, for external components, maps.
+func specDiff(current, desired any) ([]string, error) {
+	cur, err := unstructuredSpec(current)
+	if err != nil {
+		return nil, err
+	}
+	des, err := unstructuredSpec(desired)
+	if err != nil {
+		return nil, err
+	}
+	return drift.Diff(map[string]any{"spec": cur}, map[string]any{"spec": des}), nil
+}
+
+// unstructuredSpec converts the given spec into its unstructured representation.
+// The specs of external components already are unstructured and are returned as they are.
+func unstructuredSpec(spec any) (map[string]any, error) {
+	if m, ok := spec.(map[string]any); ok {
+		return m, nil
+	}
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(spec)
+}
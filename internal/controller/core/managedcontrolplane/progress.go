@@ -0,0 +1,148 @@
+package managedcontrolplane
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/openmcp-project/mcp-operator/internal/components"
+	mcpometrics "github.com/openmcp-project/mcp-operator/internal/metrics"
+	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+)
+
+// updateProgress computes the progress of the given ManagedControlPlane from its component resources and writes it into the ManagedControlPlane's status.
+// It expects the status field of the ManagedControlPlane's status to be up-to-date already.
+// reconcileFailed specifies whether the current reconciliation of the ManagedControlPlane failed.
+// The returned function records the time-to-ready metric of the components which became ready. It must only be called once the status has been persisted,
+// as the components would be observed again during the next reconciliation otherwise.
+func (r *ManagedControlPlaneController) updateProgress(ctx context.Context, mcp *openmcpv1alpha1.ManagedControlPlane, reconcileFailed bool) (func(), error) {
+	chs, err := componentutils.GetComponents[*components.ComponentHandler](components.Registry, ctx, r.Client, mcp.Name, mcp.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	inDeletion := !mcp.DeletionTimestamp.IsZero()
+	prev := mcp.Status.Progress
+	prevComponents := map[openmcpv1alpha1.ComponentType]openmcpv1alpha1.ComponentProgress{}
+	progress := &openmcpv1alpha1.ManagedControlPlaneProgress{}
+	if prev != nil {
+		for _, cp := range prev.Components {
+			prevComponents[cp.Component] = cp
+		}
+		progress.FirstReadyTime = prev.FirstReadyTime
+	}
+
+	remaining := []openmcpv1alpha1.ComponentType{}
+	readyDurations := map[openmcpv1alpha1.ComponentType]time.Duration{}
+	anyFailed := false
+	for _, cts := range keyStringList(chs, true) {
+		ct := openmcpv1alpha1.ComponentType(cts)
+		comp := chs[ct].Resource()
+		cp := openmcpv1alpha1.ComponentProgress{
+			Component:      ct,
+			FirstReadyTime: prevComponents[ct].FirstReadyTime,
+		}
+		cp.Phase = componentPhase(comp, cp.FirstReadyTime != nil, inDeletion)
+		if cp.Phase == openmcpv1alpha1.MCPPhaseReady && cp.FirstReadyTime == nil {
+			cp.FirstReadyTime = ptr.To(metav1.Now())
+			// Components which are not tracked in the previous progress have either just been created or already been ready before the progress was introduced.
+			// Only the former became ready for the first time, the time-to-ready of the latter is unknown.
+			if _, tracked := prevComponents[ct]; tracked {
+				readyDurations[ct] = time.Since(comp.GetCreationTimestamp().Time)
+			}
+		}
+		progress.Components = append(progress.Components, cp)
+		if cp.Phase == openmcpv1alpha1.MCPPhaseFailed {
+			anyFailed = true
+		}
+		if cp.Phase != openmcpv1alpha1.MCPPhaseReady {
+			remaining = append(remaining, ct)
+		}
+	}
+	progress.RemainingSteps = remainingSteps(remaining, inDeletion)
+
+	switch {
+	case inDeletion:
+		progress.Phase = openmcpv1alpha1.MCPPhaseDeleting
+	case reconcileFailed || anyFailed:
+		progress.Phase = openmcpv1alpha1.MCPPhaseFailed
+	case mcp.Status.Status == openmcpv1alpha1.MCPStatusDegraded:
+		progress.Phase = openmcpv1alpha1.MCPPhaseDegraded
+	case len(remaining) > 0 || mcp.Status.Status != openmcpv1alpha1.MCPStatusReady:
+		if progress.FirstReadyTime == nil {
+			progress.Phase = openmcpv1alpha1.MCPPhaseProvisioning
+		} else {
+			progress.Phase = openmcpv1alpha1.MCPPhaseUpdating
+		}
+	default:
+		progress.Phase = openmcpv1alpha1.MCPPhaseReady
+		if progress.FirstReadyTime == nil {
+			progress.FirstReadyTime = ptr.To(metav1.Now())
+		}
+	}
+	if prev != nil && prev.Phase == progress.Phase {
+		progress.PhaseTransitionTime = prev.PhaseTransitionTime
+	} else {
+		progress.PhaseTransitionTime = metav1.Now()
+	}
+
+	mcp.Status.Progress = progress
+	return func() {
+		for ct, d := range readyDurations {
+			mcpometrics.RecordComponentReady(ct, d)
+		}
+	}, nil
+}
+
+// componentPhase computes the phase of the given component resource.
+// readyBefore specifies whether the component has been ready before.
+func componentPhase(comp components.Component, readyBefore bool, mcpInDeletion bool) openmcpv1alpha1.MCPPhase {
+	if mcpInDeletion || !comp.GetDeletionTimestamp().IsZero() {
+		return openmcpv1alpha1.MCPPhaseDeleting
+	}
+	if con := componentutils.GetCondition(comp.GetCommonStatus().Conditions, comp.Type().ReconciliationCondition()); con != nil && con.Status == openmcpv1alpha1.ComponentConditionStatusFalse {
+		return openmcpv1alpha1.MCPPhaseFailed
+	}
+	if componentutils.IsComponentReady(comp) {
+		return openmcpv1alpha1.MCPPhaseReady
+	}
+	if !readyBefore {
+		return openmcpv1alpha1.MCPPhaseProvisioning
+	}
+	return openmcpv1alpha1.MCPPhaseUpdating
+}
+
+// remainingSteps orders the given component types by their dependencies.
+// During deletion, the order is reversed, as components are deleted after the components depending on them.
+func remainingSteps(remaining []openmcpv1alpha1.ComponentType, inDeletion bool) []openmcpv1alpha1.ManagedControlPlaneStep {
+	if len(remaining) == 0 {
+		return nil
+	}
+	sorted := componentutils.SortByDependencies(components.Registry, remaining)
+	if inDeletion {
+		slices.Reverse(sorted)
+	}
+	res := make([]openmcpv1alpha1.ManagedControlPlaneStep, 0, len(sorted))
+	for _, ct := range sorted {
+		step := openmcpv1alpha1.ManagedControlPlaneStep{
+			Component: ct,
+		}
+		for _, other := range sorted {
+			var blocking bool
+			if inDeletion {
+				blocking = slices.Contains(components.Registry.Dependencies(other), ct)
+			} else {
+				blocking = slices.Contains(components.Registry.Dependencies(ct), other)
+			}
+			if blocking {
+				step.BlockedBy = append(step.BlockedBy, other)
+			}
+		}
+		res = append(res, step)
+	}
+	return res
+}
@@ -0,0 +1,72 @@
+package managedcontrolplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openmcp-project/mcp-operator/internal/components"
+	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+)
+
+// isSuspended returns true if the given ManagedControlPlane is suspended.
+// ManagedControlPlanes in deletion are never considered suspended.
+func isSuspended(mcp *openmcpv1alpha1.ManagedControlPlane) bool {
+	return mcp.Spec.Suspend != nil && mcp.DeletionTimestamp.IsZero()
+}
+
+// handleSuspension puts the ignore operation annotation on all existing component resources of the given suspended ManagedControlPlane,
+// so that their controllers stop reconciling them. No component resources are created, updated, or deleted.
+// The suspension status of the ManagedControlPlane is updated, but not written.
+// A pending operation annotation is saved in the suspended operation annotation before it is replaced.
+// The ignore annotation is removed again by the regular reconciliation once the ManagedControlPlane is resumed, which also restores the saved operation.
+func (r *ManagedControlPlaneController) handleSuspension(ctx context.Context, mcp *openmcpv1alpha1.ManagedControlPlane) error {
+	log := logging.FromContextOrPanic(ctx)
+
+	ss := &openmcpv1alpha1.ManagedControlPlaneSuspensionStatus{
+		SuspendedAt: metav1.Now(),
+		SuspendedBy: mcp.GetAnnotations()[openmcpv1alpha1.ManagedControlPlaneSuspendedByAnnotation],
+		Reason:      mcp.Spec.Suspend.Reason,
+	}
+	if mcp.Status.Suspension != nil {
+		ss.SuspendedAt = mcp.Status.Suspension.SuspendedAt
+	}
+	mcp.Status.Suspension = ss
+
+	compHandlers, err := componentutils.GetComponents[*components.ComponentHandler](components.Registry, ctx, r.Client, mcp.Name, mcp.Namespace)
+	if err != nil {
+		return fmt.Errorf("error fetching current components from cluster: %w", err)
+	}
+	allErrs := []error{}
+	for ct, ch := range compHandlers {
+		log.Debug("Ensuring ignore operation annotation on suspended component", "component", string(ct))
+		if op, ok := ch.Resource().GetAnnotations()[openmcpv1alpha1.OperationAnnotation]; ok && op != openmcpv1alpha1.OperationAnnotationValueIgnore {
+			// remember the pending operation, so that it is not lost when the ManagedControlPlane is resumed
+			if err := componentutils.PatchAnnotation(ctx, r.Client, ch.Resource(), openmcpv1alpha1.SuspendedOperationAnnotation, op, componentutils.ANNOTATION_OVERWRITE); err != nil {
+				allErrs = append(allErrs, fmt.Errorf("error saving operation annotation on resource for component '%s': %w", string(ct), err))
+				continue
+			}
+		}
+		if err := componentutils.PatchAnnotation(ctx, r.Client, ch.Resource(), openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueIgnore, componentutils.ANNOTATION_OVERWRITE); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("error patching ignore operation annotation on resource for component '%s': %w", string(ct), err))
+		}
+	}
+	return errors.Join(allErrs...)
+}
+
+// suspensionMessage returns the status message for the given suspended ManagedControlPlane.
+func suspensionMessage(ss *openmcpv1alpha1.ManagedControlPlaneSuspensionStatus) string {
+	msg := "reconciliation suspended"
+	if ss.SuspendedBy != "" {
+		msg = fmt.Sprintf("%s by %s", msg, ss.SuspendedBy)
+	}
+	if ss.Reason != "" {
+		msg = fmt.Sprintf("%s: %s", msg, ss.Reason)
+	}
+	return msg
+}
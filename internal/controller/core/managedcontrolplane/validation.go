@@ -0,0 +1,72 @@
+package managedcontrolplane
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/openmcp-project/mcp-operator/internal/components"
+	mcpocfg "github.com/openmcp-project/mcp-operator/internal/config"
+	"github.com/openmcp-project/mcp-operator/internal/config/architecture"
+	"github.com/openmcp-project/mcp-operator/internal/utils/migration"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+)
+
+// ValidateManagedControlPlane validates the configuration of all registered components which are configured in the given ManagedControlPlane,
+// as well as its architecture version override labels and architecture migration annotations.
+// It is registered as validator for the ManagedControlPlane webhook.
+func ValidateManagedControlPlane(_ context.Context, mcp, _ *openmcpv1alpha1.ManagedControlPlane) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allCompHandlers := components.Registry.GetKnownComponents()
+	for _, ct := range sets.List(sets.KeySet(allCompHandlers)) {
+		ch := allCompHandlers[ct]
+		if ch == nil || ch.Converter() == nil {
+			continue
+		}
+		if ch.Converter().IsConfigured(mcp) {
+			allErrs = append(allErrs, ch.Converter().ValidateConfiguration(mcp)...)
+		}
+		if err := validateArchitectureVersionLabel(mcp, ct, mcpocfg.Config.Architecture.GetBridgeConfigForComponent(ct)); err != nil {
+			allErrs = append(allErrs, err)
+		}
+		if err := validateArchitectureMigrationAnnotation(mcp, ct); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+	return allErrs
+}
+
+// validateArchitectureVersionLabel validates the architecture version override label for the given component on the ManagedControlPlane, if any.
+func validateArchitectureVersionLabel(mcp *openmcpv1alpha1.ManagedControlPlane, ct openmcpv1alpha1.ComponentType, bridgeConfig architecture.BridgeConfig) *field.Error {
+	v, found := mcp.Labels[ct.ArchitectureVersionLabel()]
+	if !found {
+		return nil
+	}
+	fldPath := field.NewPath("metadata", "labels").Key(ct.ArchitectureVersionLabel())
+	if !bridgeConfig.AllowOverride {
+		return field.Forbidden(fldPath, fmt.Sprintf("architecture version override is not allowed for component '%s', remove the '%s' label", string(ct), ct.ArchitectureVersionLabel()))
+	}
+	if !bridgeConfig.IsAllowedVersion(v) {
+		return field.Invalid(fldPath, v, fmt.Sprintf("architecture version is not allowed for component '%s', allowed versions are %v", string(ct), sets.List(architecture.AllowedVersions)))
+	}
+	return nil
+}
+
+// validateArchitectureMigrationAnnotation validates the architecture migration annotation for the given component on the ManagedControlPlane, if any.
+func validateArchitectureMigrationAnnotation(mcp *openmcpv1alpha1.ManagedControlPlane, ct openmcpv1alpha1.ComponentType) *field.Error {
+	v, found := mcp.Annotations[ct.ArchitectureMigrationAnnotation()]
+	if !found {
+		return nil
+	}
+	fldPath := field.NewPath("metadata", "annotations").Key(ct.ArchitectureMigrationAnnotation())
+	if !migration.SupportedComponents.Has(ct) {
+		return field.Forbidden(fldPath, fmt.Sprintf("component '%s' cannot be migrated to another architecture version, remove the '%s' annotation", string(ct), ct.ArchitectureMigrationAnnotation()))
+	}
+	if v != openmcpv1alpha1.ArchitectureV2 && v != openmcpv1alpha1.ArchitectureMigrationValueRollback {
+		return field.NotSupported(fldPath, v, []string{openmcpv1alpha1.ArchitectureV2, openmcpv1alpha1.ArchitectureMigrationValueRollback})
+	}
+	return nil
+}
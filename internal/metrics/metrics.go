@@ -0,0 +1,134 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+)
+
+const (
+	namespace = "mcp_operator"
+
+	// ReasonUnknown is used as reason label value for reconcile errors which don't have a reason.
+	ReasonUnknown = "Unknown"
+
+	// collectTimeout is the maximum time the ManagedControlPlane collector waits for listing the ManagedControlPlanes.
+	collectTimeout = 10 * time.Second
+)
+
+var (
+	// ComponentTimeToReady observes the time from the creation of a component resource until it became ready for the first time.
+	ComponentTimeToReady = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "component_time_to_ready_seconds",
+		Help:      "Time from the creation of a component resource until it became ready for the first time.",
+		Buckets:   []float64{30, 60, 120, 300, 600, 900, 1200, 1800, 2700, 3600},
+	}, []string{"component"})
+
+	// ComponentReconcileErrors counts the failed reconciliations of component resources by the reason of the returned error.
+	ComponentReconcileErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "component_reconcile_errors_total",
+		Help:      "Number of failed reconciliations of component resources by error reason.",
+	}, []string{"component", "reason"})
+
+	managedControlPlanesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "managedcontrolplanes"),
+		"Number of ManagedControlPlanes by status.",
+		[]string{"status"}, nil,
+	)
+	managedControlPlanesDeletionStuckDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "managedcontrolplanes_deletion_stuck"),
+		"Number of ManagedControlPlanes whose current deletion stage exceeded the configured timeout.",
+		nil, nil,
+	)
+)
+
+func init() {
+	crmetrics.Registry.MustRegister(ComponentTimeToReady, ComponentReconcileErrors)
+}
+
+// RecordComponentReconcileError increments the reconcile error counter for the given component.
+// An empty reason is recorded as ReasonUnknown.
+func RecordComponentReconcileError(ct openmcpv1alpha1.ComponentType, reason string) {
+	if reason == "" {
+		reason = ReasonUnknown
+	}
+	ComponentReconcileErrors.WithLabelValues(string(ct), reason).Inc()
+}
+
+// RecordComponentReady observes the time the given component took to become ready.
+func RecordComponentReady(ct openmcpv1alpha1.ComponentType, d time.Duration) {
+	ComponentTimeToReady.WithLabelValues(string(ct)).Observe(d.Seconds())
+}
+
+// ManagedControlPlaneCollector is a prometheus collector which computes the ManagedControlPlane metrics from the ManagedControlPlanes in the cluster.
+// As the values are computed on each scrape, they don't depend on which ManagedControlPlanes have been reconciled since the operator started.
+type ManagedControlPlaneCollector struct {
+	client client.Reader
+}
+
+var _ prometheus.Collector = &ManagedControlPlaneCollector{}
+
+// NewManagedControlPlaneCollector creates a new collector which lists the ManagedControlPlanes with the given client.
+// The client should be backed by a cache, as it is used on every scrape.
+func NewManagedControlPlaneCollector(c client.Reader) *ManagedControlPlaneCollector {
+	return &ManagedControlPlaneCollector{
+		client: c,
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *ManagedControlPlaneCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- managedControlPlanesDesc
+	ch <- managedControlPlanesDeletionStuckDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *ManagedControlPlaneCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+	mcps := &openmcpv1alpha1.ManagedControlPlaneList{}
+	if err := c.client.List(ctx, mcps); err != nil {
+		ch <- prometheus.NewInvalidMetric(managedControlPlanesDesc, err)
+		ch <- prometheus.NewInvalidMetric(managedControlPlanesDeletionStuckDesc, err)
+		return
+	}
+
+	byStatus := map[openmcpv1alpha1.MCPStatus]int{
+		openmcpv1alpha1.MCPStatusReady:           0,
+		openmcpv1alpha1.MCPStatusNotReady:        0,
+		openmcpv1alpha1.MCPStatusDegraded:        0,
+		openmcpv1alpha1.MCPStatusDeleting:        0,
+		openmcpv1alpha1.MCPStatusPendingDeletion: 0,
+		openmcpv1alpha1.MCPStatusHibernated:      0,
+		openmcpv1alpha1.MCPStatusSuspended:       0,
+	}
+	deletionStuck := 0
+	for _, mcp := range mcps.Items {
+		byStatus[mcp.Status.Status]++
+		if !mcp.DeletionTimestamp.IsZero() && isDeletionStuck(&mcp) {
+			deletionStuck++
+		}
+	}
+	for status, count := range byStatus {
+		ch <- prometheus.MustNewConstMetric(managedControlPlanesDesc, prometheus.GaugeValue, float64(count), string(status))
+	}
+	ch <- prometheus.MustNewConstMetric(managedControlPlanesDeletionStuckDesc, prometheus.GaugeValue, float64(deletionStuck))
+}
+
+// isDeletionStuck returns true if the given ManagedControlPlane has a DeletionStuck condition with status 'True'.
+func isDeletionStuck(mcp *openmcpv1alpha1.ManagedControlPlane) bool {
+	for _, con := range mcp.Status.Conditions {
+		if con.Type == cconst.ConditionDeletionStuck {
+			return con.Status == openmcpv1alpha1.ComponentConditionStatusTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package metrics_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	mcpometrics "github.com/openmcp-project/mcp-operator/internal/metrics"
+	testutils "github.com/openmcp-project/mcp-operator/test/utils"
+)
+
+func mcpWithStatus(name string, status openmcpv1alpha1.MCPStatus, cons ...openmcpv1alpha1.ManagedControlPlaneComponentCondition) *openmcpv1alpha1.ManagedControlPlane {
+	mcp := &openmcpv1alpha1.ManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+	}
+	mcp.Status.Status = status
+	mcp.Status.Conditions = cons
+	return mcp
+}
+
+// metricValue gathers the metrics from the given gatherer and returns the value of the gauge or counter with the given name and labels.
+// It returns -1 if no such metric exists.
+func metricValue(g prometheus.Gatherer, name string, labels map[string]string) float64 {
+	mfs, err := g.Gather()
+	Expect(err).ToNot(HaveOccurred())
+	for _, mf := range mfs {
+		if mf.GetName() != name {
+			continue
+		}
+	metricLoop:
+		for _, m := range mf.GetMetric() {
+			if len(m.GetLabel()) != len(labels) {
+				continue
+			}
+			for _, l := range m.GetLabel() {
+				if labels[l.GetName()] != l.GetValue() {
+					continue metricLoop
+				}
+			}
+			if m.GetCounter() != nil {
+				return m.GetCounter().GetValue()
+			}
+			return m.GetGauge().GetValue()
+		}
+	}
+	return -1
+}
+
+var _ = Describe("Metrics", func() {
+
+	It("should count the ManagedControlPlanes by status and the ones with a stuck deletion", func() {
+		stuck := mcpWithStatus("stuck", openmcpv1alpha1.MCPStatusDeleting, openmcpv1alpha1.ManagedControlPlaneComponentCondition{
+			ComponentCondition: openmcpv1alpha1.ComponentCondition{Type: cconst.ConditionDeletionStuck, Status: openmcpv1alpha1.ComponentConditionStatusTrue},
+		})
+		stuck.Finalizers = []string{openmcpv1alpha1.ManagedControlPlaneFinalizer}
+		stuck.DeletionTimestamp = &metav1.Time{Time: metav1.Now().Time}
+		c := fake.NewClientBuilder().WithScheme(testutils.Scheme).WithObjects(
+			mcpWithStatus("ready-1", openmcpv1alpha1.MCPStatusReady),
+			mcpWithStatus("ready-2", openmcpv1alpha1.MCPStatusReady),
+			mcpWithStatus("hibernated", openmcpv1alpha1.MCPStatusHibernated),
+			stuck,
+		).Build()
+		reg := prometheus.NewRegistry()
+		Expect(reg.Register(mcpometrics.NewManagedControlPlaneCollector(c))).To(Succeed())
+
+		byStatus := func(status openmcpv1alpha1.MCPStatus) float64 {
+			return metricValue(reg, "mcp_operator_managedcontrolplanes", map[string]string{"status": string(status)})
+		}
+		Expect(byStatus(openmcpv1alpha1.MCPStatusReady)).To(Equal(2.0))
+		Expect(byStatus(openmcpv1alpha1.MCPStatusHibernated)).To(Equal(1.0))
+		Expect(byStatus(openmcpv1alpha1.MCPStatusDeleting)).To(Equal(1.0))
+		Expect(byStatus(openmcpv1alpha1.MCPStatusNotReady)).To(Equal(0.0))
+		Expect(metricValue(reg, "mcp_operator_managedcontrolplanes_deletion_stuck", nil)).To(Equal(1.0))
+	})
+
+	It("should count reconcile errors by component and reason", func() {
+		errorCount := func(reason string) float64 {
+			return metricValue(crmetrics.Registry, "mcp_operator_component_reconcile_errors_total", map[string]string{"component": string(openmcpv1alpha1.APIServerComponent), "reason": reason})
+		}
+		Expect(errorCount(mcpometrics.ReasonUnknown)).To(Equal(-1.0))
+		mcpometrics.RecordComponentReconcileError(openmcpv1alpha1.APIServerComponent, "")
+		mcpometrics.RecordComponentReconcileError(openmcpv1alpha1.APIServerComponent, "InvalidConfig")
+		mcpometrics.RecordComponentReconcileError(openmcpv1alpha1.APIServerComponent, "InvalidConfig")
+		Expect(errorCount(mcpometrics.ReasonUnknown)).To(Equal(1.0))
+		Expect(errorCount("InvalidConfig")).To(Equal(2.0))
+	})
+
+})
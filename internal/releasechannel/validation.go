@@ -0,0 +1,145 @@
+package releasechannel
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+)
+
+// Names of the release channel components which are configured in the CloudOrchestrator configuration of a ManagedControlPlane.
+const (
+	ComponentCrossplane              = "crossplane"
+	ComponentBTPServiceOperator      = "sap-btp-service-operator"
+	ComponentExternalSecretsOperator = "external-secrets"
+	ComponentKyverno                 = "kyverno"
+	ComponentFlux                    = "flux"
+
+	// CrossplaneProviderPrefix is the prefix of the names of all release channel components which are Crossplane providers.
+	CrossplaneProviderPrefix = "provider-"
+)
+
+// VersionValidator validates the versions of the CloudOrchestrator components configured in a ManagedControlPlane.
+// The allowed versions are taken from the ManagedComponent resources, which mirror the release channels.
+type VersionValidator struct {
+	crateClient client.Reader
+}
+
+func NewVersionValidator(crateClient client.Reader) *VersionValidator {
+	return &VersionValidator{
+		crateClient: crateClient,
+	}
+}
+
+// Validate validates the CloudOrchestrator component versions and Crossplane providers of the given ManagedControlPlane.
+// Versions which didn't change compared to oldMcp are not validated, so that versions which have been removed from the release channels don't block other updates.
+// If there are no ManagedComponents at all, e.g. because the release channels haven't been synced yet, nothing is validated.
+// It can be registered as validator for the ManagedControlPlane webhook.
+func (v *VersionValidator) Validate(ctx context.Context, mcp, oldMcp *v1alpha1.ManagedControlPlane) field.ErrorList {
+	allErrs := field.ErrorList{}
+	fldPath := field.NewPath("spec", "components")
+	cfg := mcp.Spec.Components.CloudOrchestratorConfiguration
+	var oldCfg v1alpha1.CloudOrchestratorConfiguration
+	if oldMcp != nil {
+		oldCfg = oldMcp.Spec.Components.CloudOrchestratorConfiguration
+	}
+	if cfg.Crossplane == nil && cfg.BTPServiceOperator == nil && cfg.ExternalSecretsOperator == nil && cfg.Kyverno == nil && cfg.Flux == nil {
+		return allErrs
+	}
+
+	mcs := &v1alpha1.ManagedComponentList{}
+	if err := v.crateClient.List(ctx, mcs); err != nil {
+		return append(allErrs, field.InternalError(fldPath, fmt.Errorf("error listing ManagedComponents: %w", err)))
+	}
+	if len(mcs.Items) == 0 {
+		return allErrs
+	}
+	versions := make(map[string][]string, len(mcs.Items))
+	for _, mc := range mcs.Items {
+		versions[mc.Name] = mc.Status.Versions
+	}
+
+	validateVersion := func(fldPath *field.Path, component, version, oldVersion string) {
+		if version == oldVersion {
+			return
+		}
+		allowed, ok := versions[component]
+		if !ok {
+			allErrs = append(allErrs, field.Invalid(fldPath, version, fmt.Sprintf("component '%s' is not available in any release channel", component)))
+			return
+		}
+		if !slices.Contains(allowed, version) {
+			allErrs = append(allErrs, field.NotSupported(fldPath, version, allowed))
+		}
+	}
+
+	if cfg.Crossplane != nil {
+		cpPath := fldPath.Child("crossplane")
+		oldProviders := map[string]string{}
+		oldVersion := ""
+		if oldCfg.Crossplane != nil {
+			oldVersion = oldCfg.Crossplane.Version
+			for _, p := range oldCfg.Crossplane.Providers {
+				if p != nil {
+					oldProviders[p.Name] = p.Version
+				}
+			}
+		}
+		validateVersion(cpPath.Child("version"), ComponentCrossplane, cfg.Crossplane.Version, oldVersion)
+
+		providerNames := sets.New[string]()
+		for name := range versions {
+			if strings.HasPrefix(name, CrossplaneProviderPrefix) {
+				providerNames.Insert(name)
+			}
+		}
+		for i, p := range cfg.Crossplane.Providers {
+			if p == nil {
+				continue
+			}
+			pPath := cpPath.Child("providers").Index(i)
+			oldProviderVersion, existed := oldProviders[p.Name]
+			if !existed && !providerNames.Has(p.Name) {
+				allErrs = append(allErrs, field.NotSupported(pPath.Child("name"), p.Name, sets.List(providerNames)))
+				continue
+			}
+			validateVersion(pPath.Child("version"), p.Name, p.Version, oldProviderVersion)
+		}
+	}
+	if cfg.BTPServiceOperator != nil {
+		oldVersion := ""
+		if oldCfg.BTPServiceOperator != nil {
+			oldVersion = oldCfg.BTPServiceOperator.Version
+		}
+		validateVersion(fldPath.Child("btpServiceOperator", "version"), ComponentBTPServiceOperator, cfg.BTPServiceOperator.Version, oldVersion)
+	}
+	if cfg.ExternalSecretsOperator != nil {
+		oldVersion := ""
+		if oldCfg.ExternalSecretsOperator != nil {
+			oldVersion = oldCfg.ExternalSecretsOperator.Version
+		}
+		validateVersion(fldPath.Child("externalSecretsOperator", "version"), ComponentExternalSecretsOperator, cfg.ExternalSecretsOperator.Version, oldVersion)
+	}
+	if cfg.Kyverno != nil {
+		oldVersion := ""
+		if oldCfg.Kyverno != nil {
+			oldVersion = oldCfg.Kyverno.Version
+		}
+		validateVersion(fldPath.Child("kyverno", "version"), ComponentKyverno, cfg.Kyverno.Version, oldVersion)
+	}
+	if cfg.Flux != nil {
+		oldVersion := ""
+		if oldCfg.Flux != nil {
+			oldVersion = oldCfg.Flux.Version
+		}
+		validateVersion(fldPath.Child("flux", "version"), ComponentFlux, cfg.Flux.Version, oldVersion)
+	}
+
+	return allErrs
+}
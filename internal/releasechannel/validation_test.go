@@ -0,0 +1,103 @@
+package releasechannel
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	testutils "github.com/openmcp-project/mcp-operator/test/utils"
+)
+
+func managedComponent(name string, versions ...string) *v1alpha1.ManagedComponent {
+	return &v1alpha1.ManagedComponent{
+		ObjectMeta: v1.ObjectMeta{Name: name},
+		Status:     v1alpha1.ManagedComponentStatus{Versions: versions},
+	}
+}
+
+func fieldError(fldPath string, errType field.ErrorType) any {
+	return PointTo(MatchFields(IgnoreExtras, Fields{
+		"Field": Equal(fldPath),
+		"Type":  Equal(errType),
+	}))
+}
+
+var _ = Describe("VersionValidator", func() {
+	var mcp *v1alpha1.ManagedControlPlane
+
+	BeforeEach(func() {
+		mcp = &v1alpha1.ManagedControlPlane{
+			ObjectMeta: v1.ObjectMeta{Name: "test", Namespace: "test"},
+			Spec: v1alpha1.ManagedControlPlaneSpec{
+				Components: v1alpha1.ManagedControlPlaneComponents{
+					CloudOrchestratorConfiguration: v1alpha1.CloudOrchestratorConfiguration{
+						Crossplane: &v1alpha1.CrossplaneConfig{
+							Version: "1.17.0",
+							Providers: []*v1alpha1.CrossplaneProviderConfig{
+								{Name: "provider-kubernetes", Version: "0.14.1"},
+							},
+						},
+						Flux: &v1alpha1.FluxConfig{Version: "2.4.0"},
+					},
+				},
+			},
+		}
+	})
+
+	It("should accept known component versions", func() {
+		c := fake.NewClientBuilder().WithScheme(testutils.Scheme).WithObjects(
+			managedComponent("crossplane", "1.16.0", "1.17.0"),
+			managedComponent("provider-kubernetes", "0.14.1"),
+			managedComponent("flux", "2.4.0"),
+		).Build()
+		Expect(NewVersionValidator(c).Validate(context.Background(), mcp, nil)).To(BeEmpty())
+	})
+
+	It("should reject unknown component versions and providers and list the allowed values", func() {
+		c := fake.NewClientBuilder().WithScheme(testutils.Scheme).WithObjects(
+			managedComponent("crossplane", "1.16.0", "1.17.0"),
+			managedComponent("provider-kubernetes", "0.14.1"),
+			managedComponent("provider-helm", "0.19.0"),
+		).Build()
+		mcp.Spec.Components.Crossplane.Version = "1.71.0"
+		mcp.Spec.Components.Crossplane.Providers = append(mcp.Spec.Components.Crossplane.Providers,
+			&v1alpha1.CrossplaneProviderConfig{Name: "provider-kubernetes", Version: "0.15.0"},
+			&v1alpha1.CrossplaneProviderConfig{Name: "provider-kubernets", Version: "0.14.1"},
+		)
+
+		errs := NewVersionValidator(c).Validate(context.Background(), mcp, nil)
+		Expect(errs).To(ConsistOf(
+			fieldError("spec.components.crossplane.version", field.ErrorTypeNotSupported),
+			fieldError("spec.components.crossplane.providers[1].version", field.ErrorTypeNotSupported),
+			fieldError("spec.components.crossplane.providers[2].name", field.ErrorTypeNotSupported),
+			fieldError("spec.components.flux.version", field.ErrorTypeInvalid),
+		))
+		Expect(errs.ToAggregate().Error()).To(ContainSubstring(`supported values: "1.16.0", "1.17.0"`))
+		Expect(errs.ToAggregate().Error()).To(ContainSubstring(`supported values: "provider-helm", "provider-kubernetes"`))
+	})
+
+	It("should not validate versions which didn't change", func() {
+		c := fake.NewClientBuilder().WithScheme(testutils.Scheme).WithObjects(
+			managedComponent("crossplane", "1.18.0"),
+		).Build()
+		oldMcp := mcp.DeepCopy()
+		mcp.Spec.Components.Flux.Version = "2.5.0"
+
+		errs := NewVersionValidator(c).Validate(context.Background(), mcp, oldMcp)
+		Expect(errs).To(ConsistOf(
+			fieldError("spec.components.flux.version", field.ErrorTypeInvalid),
+		))
+	})
+
+	It("should not validate anything if there are no ManagedComponents", func() {
+		c := fake.NewClientBuilder().WithScheme(testutils.Scheme).Build()
+		mcp.Spec.Components.Crossplane.Version = "invalid"
+		Expect(NewVersionValidator(c).Validate(context.Background(), mcp, nil)).To(BeEmpty())
+	})
+})
@@ -163,6 +163,10 @@ func (w *WorkerImpl) Start(ctx context.Context, onExit OnExit, onNextInterval On
 					for i := range apiServers.Items {
 						as := &apiServers.Items[i]
 						log := log.WithValues("apiserver", fmt.Sprintf("%s/%s", as.Namespace, as.Name))
+						if as.Status.Hibernation.IsHibernated() {
+							log.Debug("Skipping hibernated APIServer")
+							continue
+						}
 						// create the kubernetes client for the APIServer
 						apiServerClient, err := w.createAPIServerClient(ctx, as)
 
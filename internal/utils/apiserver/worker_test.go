@@ -82,6 +82,32 @@ var _ = Describe("APIServerWorker", func() {
 		Eventually(onExit).WithTimeout(timeout).Should(Receive(ptr.To(true)))
 	})
 
+	It("should skip hibernated APIServers", func() {
+		ctx, cancel := context.WithCancel(env.Ctx)
+
+		var (
+			task1          = sync.Map{}
+			onNextInterval = make(chan bool)
+		)
+
+		worker.RegisterTask("task1", func(ctx context.Context, as *openmcpv1alpha1.APIServer, crateClient client.Client, apiServerClient client.Client) error {
+			task1.Store(as.Name, true)
+			return nil
+		})
+
+		err := worker.Start(ctx, nil, onNextInterval, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(onNextInterval).WithTimeout(timeout).Should(Receive(ptr.To(true)))
+		Eventually(onNextInterval).WithTimeout(timeout).Should(Receive(ptr.To(true)))
+		_, ok := task1.Load("test1")
+		Expect(ok).To(BeTrue())
+		_, ok = task1.Load("test3")
+		Expect(ok).To(BeFalse())
+
+		cancel()
+	})
+
 	It("should remove tasks", func() {
 		ctx, cancel := context.WithCancel(env.Ctx)
 
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	components "github.com/openmcp-project/mcp-operator/internal/components"
+	mcpometrics "github.com/openmcp-project/mcp-operator/internal/metrics"
 	"github.com/openmcp-project/mcp-operator/internal/utils"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -182,6 +183,7 @@ func UpdateStatus[T components.Component](ctx context.Context, c client.Client,
 		if aggErr.Reason() != "" {
 			rr.Reason = aggErr.Reason()
 		}
+		mcpometrics.RecordComponentReconcileError(rr.Component.Type(), aggErr.Reason())
 	}
 
 	commonStatus := rr.Component.GetCommonStatus()
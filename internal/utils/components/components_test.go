@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/openmcp-project/mcp-operator/internal/components"
 	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
@@ -35,6 +36,7 @@ func (tmc TestManagedComponent) Resource() components.Component {
 // TestComponentRegistry is a simple testing implementation of the ComponentRegistry interface.
 type TestComponentRegistry struct {
 	Components map[openmcpv1alpha1.ComponentType]TestManagedComponent
+	Deps       map[openmcpv1alpha1.ComponentType][]openmcpv1alpha1.ComponentType
 }
 
 func (tcr *TestComponentRegistry) GetKnownComponents() map[openmcpv1alpha1.ComponentType]TestManagedComponent {
@@ -45,12 +47,21 @@ func (tcr *TestComponentRegistry) GetComponent(ct openmcpv1alpha1.ComponentType)
 	return tcr.Components[ct]
 }
 
-func (tcr *TestComponentRegistry) Register(ct openmcpv1alpha1.ComponentType, f func() TestManagedComponent) {
+func (tcr *TestComponentRegistry) Register(ct openmcpv1alpha1.ComponentType, f func() TestManagedComponent, deps ...openmcpv1alpha1.ComponentType) {
+	if tcr.Deps == nil {
+		tcr.Deps = map[openmcpv1alpha1.ComponentType][]openmcpv1alpha1.ComponentType{}
+	}
 	if f == nil {
 		delete(tcr.Components, ct)
+		delete(tcr.Deps, ct)
 		return
 	}
 	tcr.Components[ct] = f()
+	tcr.Deps[ct] = deps
+}
+
+func (tcr *TestComponentRegistry) Dependencies(ct openmcpv1alpha1.ComponentType) []openmcpv1alpha1.ComponentType {
+	return slices.Clone(tcr.Deps[ct])
 }
 
 func (tcr *TestComponentRegistry) Has(ct openmcpv1alpha1.ComponentType) bool {
@@ -4,13 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/openmcp-project/mcp-operator/internal/components"
 
 	"github.com/openmcp-project/controller-utils/pkg/logging"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -19,6 +22,10 @@ import (
 	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
 )
 
+// DependencyHibernatedRequeueInterval is the interval in which components are requeued while a dependency is hibernated.
+// Components which watch their dependencies are reconciled earlier, as soon as the dependency has been woken up.
+const DependencyHibernatedRequeueInterval = 10 * time.Minute
+
 var (
 	mutex *sync.Mutex
 )
@@ -138,6 +145,164 @@ func IsDependencyReady(dep components.Component, ownCPGeneration, ownICGeneratio
 		dep.GetCommonStatus().ObservedGenerations.InternalConfiguration == ownICGeneration
 }
 
+// EnsureDependencyFinalizers ensures that the dependency finalizer of the given component either exists or doesn't exist (based on argument 'expected') on the resources of all given dependencies.
+// The dependencies are expected to be fetched via GetDependencies, dependencies whose resources don't exist are skipped.
+func EnsureDependencyFinalizers(ctx context.Context, c client.Client, comp components.Component, deps map[openmcpv1alpha1.ComponentType]components.Component, expected bool) error {
+	for _, ct := range sets.List(sets.KeySet(deps)) {
+		dep := deps[ct]
+		if dep == nil {
+			continue
+		}
+		if err := EnsureDependencyFinalizer(ctx, c, dep, comp, expected); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("error updating dependency finalizer on %s component resource: %w", string(ct), err)
+		}
+	}
+	return nil
+}
+
+// GetDependencies fetches the resources of all dependencies which are declared for the given component in the registry.
+// The resources are expected to have the same name and namespace as the given component.
+// The returned map contains an entry for each declared dependency, its value is nil if the dependency's resource doesn't exist.
+// Dependencies which are not registered (e.g. because their controller is not active) are skipped, as no resources are generated for them.
+func GetDependencies[T components.ManagedComponent](reg components.ComponentRegistry[T], ctx context.Context, c client.Client, comp components.Component) (map[openmcpv1alpha1.ComponentType]components.Component, error) {
+	deps := reg.Dependencies(comp.Type())
+	res := make(map[openmcpv1alpha1.ComponentType]components.Component, len(deps))
+	for _, ct := range deps {
+		if !reg.Has(ct) {
+			continue
+		}
+		dep := reg.GetComponent(ct).Resource()
+		if err := c.Get(ctx, client.ObjectKeyFromObject(comp), dep); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("error getting %s component resource %s/%s: %w", string(ct), comp.GetNamespace(), comp.GetName(), err)
+			}
+			dep = nil
+		}
+		res[ct] = dep
+	}
+	return res, nil
+}
+
+// FilterDependencies returns the entries of the given dependencies whose types are contained in the given list.
+// It can be used to check the readiness of only the dependencies a component requires, while all of them are protected via dependency finalizers.
+func FilterDependencies(deps map[openmcpv1alpha1.ComponentType]components.Component, cts ...openmcpv1alpha1.ComponentType) map[openmcpv1alpha1.ComponentType]components.Component {
+	res := make(map[openmcpv1alpha1.ComponentType]components.Component, len(cts))
+	for _, ct := range cts {
+		if dep, ok := deps[ct]; ok {
+			res[ct] = dep
+		}
+	}
+	return res
+}
+
+// NotReadyDependencies returns the sorted types of all given dependencies which are not ready according to IsDependencyReady.
+// Dependencies whose resources don't exist are not ready.
+// The remaining arguments are passed to IsDependencyReady.
+func NotReadyDependencies(deps map[openmcpv1alpha1.ComponentType]components.Component, ownCPGeneration, ownICGeneration int64, relevantConditions ...string) []openmcpv1alpha1.ComponentType {
+	res := []openmcpv1alpha1.ComponentType{}
+	for ct, dep := range deps {
+		if !IsDependencyReady(dep, ownCPGeneration, ownICGeneration, relevantConditions...) {
+			res = append(res, ct)
+		}
+	}
+	slices.Sort(res)
+	return res
+}
+
+// WaitingForDependenciesMessage returns the message for the condition of a component which waits for the given dependencies to become ready.
+func WaitingForDependenciesMessage(notReady []openmcpv1alpha1.ComponentType) string {
+	switch len(notReady) {
+	case 0:
+		return "Waiting for dependencies to be ready."
+	case 1:
+		return fmt.Sprintf("Waiting for %s dependency to be ready.", string(notReady[0]))
+	}
+	names := make([]string, len(notReady))
+	for i, ct := range notReady {
+		names[i] = string(ct)
+	}
+	return fmt.Sprintf("Waiting for dependencies to be ready: [%s]", strings.Join(names, ", "))
+}
+
+// ValidateDependencies verifies the declared dependencies of all components in the registry.
+// It returns an error if a component depends on an unknown component or if the dependencies contain a cycle.
+// It is meant to be called once at startup, before any components are unregistered, and whenever a component is registered at runtime.
+func ValidateDependencies[T components.ManagedComponent](reg components.ComponentRegistry[T]) error {
+	cts := sets.List(sets.KeySet(reg.GetKnownComponents()))
+	for _, ct := range cts {
+		for _, dep := range reg.Dependencies(ct) {
+			if !reg.Has(dep) {
+				return fmt.Errorf("component '%s' depends on unknown component '%s'", string(ct), string(dep))
+			}
+		}
+	}
+	// depth-first search, components on the current path are 'visiting'
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[openmcpv1alpha1.ComponentType]int{}
+	var visit func(ct openmcpv1alpha1.ComponentType, path []openmcpv1alpha1.ComponentType) error
+	visit = func(ct openmcpv1alpha1.ComponentType, path []openmcpv1alpha1.ComponentType) error {
+		path = append(path, ct)
+		switch state[ct] {
+		case visited:
+			return nil
+		case visiting:
+			names := make([]string, len(path))
+			for i, elem := range path {
+				names[i] = string(elem)
+			}
+			return fmt.Errorf("cyclic component dependencies: %s", strings.Join(names, " -> "))
+		}
+		state[ct] = visiting
+		for _, dep := range reg.Dependencies(ct) {
+			if err := visit(dep, path); err != nil {
+				return err
+			}
+		}
+		state[ct] = visited
+		return nil
+	}
+	for _, ct := range cts {
+		if err := visit(ct, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SortByDependencies returns the given component types sorted so that each component type comes after all of its dependencies, as declared in the registry.
+// Only dependencies among the given component types are taken into account, component types without an order between them are sorted alphabetically.
+func SortByDependencies[T components.ManagedComponent](reg components.ComponentRegistry[T], cts []openmcpv1alpha1.ComponentType) []openmcpv1alpha1.ComponentType {
+	remaining := slices.Clone(cts)
+	slices.Sort(remaining)
+	res := make([]openmcpv1alpha1.ComponentType, 0, len(remaining))
+	for len(remaining) > 0 {
+		next := -1
+		for i, ct := range remaining {
+			blocked := false
+			for _, dep := range reg.Dependencies(ct) {
+				if slices.Contains(remaining, dep) {
+					blocked = true
+					break
+				}
+			}
+			if !blocked {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			// cannot happen for validated dependencies, append the rest in alphabetical order to be on the safe side
+			return append(res, remaining...)
+		}
+		res = append(res, remaining[next])
+		remaining = slices.Delete(remaining, next, next+1)
+	}
+	return res
+}
+
 func logFinalizers(log logging.Logger, comp components.Component) {
 	if comp == nil {
 		return
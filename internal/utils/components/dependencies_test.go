@@ -4,6 +4,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/openmcp-project/mcp-operator/internal/components"
 	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -268,4 +269,113 @@ var _ = Describe("Dependencies", func() {
 
 	})
 
+	Context("Declared Dependencies", func() {
+
+		It("should fetch all declared dependencies of a component", func() {
+			env := testutils.DefaultTestSetupBuilder("testdata", "test-05").Build()
+			co := &openmcpv1alpha1.CloudOrchestrator{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKey{Name: "test", Namespace: "test"}, co)).To(Succeed())
+			deps, err := componentutils.GetDependencies(components.Registry, env.Ctx, env.Client(testutils.CrateCluster), co)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deps).To(HaveLen(3))
+			Expect(deps[openmcpv1alpha1.APIServerComponent]).To(BeAssignableToTypeOf(&openmcpv1alpha1.APIServer{}))
+			Expect(deps[openmcpv1alpha1.APIServerComponent].GetName()).To(Equal("test"))
+			Expect(deps[openmcpv1alpha1.AuthenticationComponent]).To(BeAssignableToTypeOf(&openmcpv1alpha1.Authentication{}))
+			Expect(deps).To(HaveKeyWithValue(openmcpv1alpha1.AuthorizationComponent, BeNil()))
+
+			Expect(componentutils.NotReadyDependencies(deps, 3, -1)).To(Equal([]openmcpv1alpha1.ComponentType{openmcpv1alpha1.AuthenticationComponent, openmcpv1alpha1.AuthorizationComponent}))
+			Expect(componentutils.NotReadyDependencies(deps, 2, -1)).To(HaveLen(3))
+		})
+
+		It("should filter the dependencies whose readiness is required", func() {
+			env := testutils.DefaultTestSetupBuilder("testdata", "test-05").Build()
+			co := &openmcpv1alpha1.CloudOrchestrator{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKey{Name: "test", Namespace: "test"}, co)).To(Succeed())
+			deps, err := componentutils.GetDependencies(components.Registry, env.Ctx, env.Client(testutils.CrateCluster), co)
+			Expect(err).ToNot(HaveOccurred())
+
+			required := componentutils.FilterDependencies(deps, openmcpv1alpha1.APIServerComponent)
+			Expect(required).To(HaveLen(1))
+			Expect(required).To(HaveKey(openmcpv1alpha1.APIServerComponent))
+			Expect(componentutils.NotReadyDependencies(required, 3, -1)).To(BeEmpty())
+			Expect(componentutils.FilterDependencies(deps, openmcpv1alpha1.LandscaperComponent)).To(BeEmpty())
+		})
+
+		It("should add and remove the dependency finalizers on all existing dependencies", func() {
+			env := testutils.DefaultTestSetupBuilder("testdata", "test-05").Build()
+			co := &openmcpv1alpha1.CloudOrchestrator{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKey{Name: "test", Namespace: "test"}, co)).To(Succeed())
+			deps, err := componentutils.GetDependencies(components.Registry, env.Ctx, env.Client(testutils.CrateCluster), co)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(componentutils.EnsureDependencyFinalizers(env.Ctx, env.Client(testutils.CrateCluster), co, deps, true)).To(Succeed())
+			as := &openmcpv1alpha1.APIServer{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(co), as)).To(Succeed())
+			Expect(as.GetFinalizers()).To(ConsistOf(openmcpv1alpha1.CloudOrchestratorComponent.DependencyFinalizer()))
+			auth := &openmcpv1alpha1.Authentication{}
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(co), auth)).To(Succeed())
+			Expect(auth.GetFinalizers()).To(ConsistOf(openmcpv1alpha1.CloudOrchestratorComponent.DependencyFinalizer()))
+
+			Expect(componentutils.EnsureDependencyFinalizers(env.Ctx, env.Client(testutils.CrateCluster), co, deps, false)).To(Succeed())
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(co), as)).To(Succeed())
+			Expect(as.GetFinalizers()).To(BeEmpty())
+			Expect(env.Client(testutils.CrateCluster).Get(env.Ctx, client.ObjectKeyFromObject(co), auth)).To(Succeed())
+			Expect(auth.GetFinalizers()).To(BeEmpty())
+		})
+
+		It("should return a message listing the dependencies which are not ready", func() {
+			Expect(componentutils.WaitingForDependenciesMessage([]openmcpv1alpha1.ComponentType{openmcpv1alpha1.APIServerComponent})).To(Equal("Waiting for APIServer dependency to be ready."))
+			Expect(componentutils.WaitingForDependenciesMessage([]openmcpv1alpha1.ComponentType{openmcpv1alpha1.APIServerComponent, openmcpv1alpha1.AuthenticationComponent})).To(Equal("Waiting for dependencies to be ready: [APIServer, Authentication]"))
+		})
+
+		It("should accept the dependencies of the registered components", func() {
+			Expect(componentutils.ValidateDependencies(components.Registry)).To(Succeed())
+		})
+
+		It("should detect cyclic and unknown dependencies", func() {
+			registry := &TestComponentRegistry{
+				Components: make(map[openmcpv1alpha1.ComponentType]TestManagedComponent),
+			}
+			newComp := func() TestManagedComponent { return TestManagedComponent{} }
+			registry.Register("a", newComp)
+			registry.Register("b", newComp, "a")
+			registry.Register("c", newComp, "a", "b")
+			Expect(componentutils.ValidateDependencies(registry)).To(Succeed())
+
+			registry.Register("a", newComp, "c")
+			Expect(componentutils.ValidateDependencies(registry)).To(MatchError(ContainSubstring("cyclic component dependencies: a -> c -> a")))
+
+			registry.Register("a", newComp, "d")
+			Expect(componentutils.ValidateDependencies(registry)).To(MatchError(ContainSubstring("component 'a' depends on unknown component 'd'")))
+		})
+
+		It("should sort component types so that dependencies come first", func() {
+			Expect(componentutils.SortByDependencies(components.Registry, []openmcpv1alpha1.ComponentType{
+				openmcpv1alpha1.CloudOrchestratorComponent,
+				openmcpv1alpha1.LandscaperComponent,
+				openmcpv1alpha1.AuthorizationComponent,
+				openmcpv1alpha1.APIServerComponent,
+				openmcpv1alpha1.AuthenticationComponent,
+			})).To(Equal([]openmcpv1alpha1.ComponentType{
+				openmcpv1alpha1.APIServerComponent,
+				openmcpv1alpha1.AuthenticationComponent,
+				openmcpv1alpha1.AuthorizationComponent,
+				openmcpv1alpha1.CloudOrchestratorComponent,
+				openmcpv1alpha1.LandscaperComponent,
+			}))
+		})
+
+		It("should only consider dependencies among the given component types when sorting", func() {
+			Expect(componentutils.SortByDependencies(components.Registry, []openmcpv1alpha1.ComponentType{
+				openmcpv1alpha1.CloudOrchestratorComponent,
+				openmcpv1alpha1.AuthorizationComponent,
+			})).To(Equal([]openmcpv1alpha1.ComponentType{
+				openmcpv1alpha1.AuthorizationComponent,
+				openmcpv1alpha1.CloudOrchestratorComponent,
+			}))
+			Expect(componentutils.SortByDependencies(components.Registry, nil)).To(BeEmpty())
+		})
+
+	})
+
 })
@@ -5,6 +5,7 @@ package components
 import (
 	"reflect"
 
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
@@ -19,8 +20,11 @@ func DefaultComponentControllerPredicates() predicate.Predicate {
 		predicate.Or(
 			predicate.GenerationChangedPredicate{},
 			colactrlutil.GotAnnotationPredicate(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueReconcile),
+			colactrlutil.GotAnnotationPredicate(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueHibernate),
+			colactrlutil.GotAnnotationPredicate(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueWakeUp),
 			colactrlutil.LostAnnotationPredicate(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueIgnore),
 			GenerationLabelsChangedPredicate{},
+			AnnotationChangedPredicate{Key: openmcpv1alpha1.ArchitectureMigrationAnnotation},
 		),
 		predicate.Not(
 			colactrlutil.HasAnnotationPredicate(openmcpv1alpha1.OperationAnnotation, openmcpv1alpha1.OperationAnnotationValueIgnore),
@@ -50,10 +54,32 @@ func (GenerationLabelsChangedPredicate) Update(e event.UpdateEvent) bool {
 	return oldCPGen != newCPGen || oldIRGen != newIRGen
 }
 
+// AnnotationChangedPredicate reacts on changes to the value of the annotation with the given key, including its addition and removal.
+type AnnotationChangedPredicate struct {
+	predicate.Funcs
+	Key string
+}
+
+var _ predicate.Predicate = AnnotationChangedPredicate{}
+
+func (p AnnotationChangedPredicate) Update(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil {
+		return false
+	}
+	if e.ObjectNew == nil {
+		return false
+	}
+
+	oldVal, oldOk := e.ObjectOld.GetAnnotations()[p.Key]
+	newVal, newOk := e.ObjectNew.GetAnnotations()[p.Key]
+	return oldOk != newOk || oldVal != newVal
+}
+
 var _ predicate.Predicate = StatusChangedPredicate{}
 
 // StatusChangedPredicate returns true if the object's status changed.
 // Getting the status is done via reflection and only works if the corresponding field is named 'Status'.
+// For unstructured objects, the 'status' field of the content is used.
 // If getting the status fails, this predicate always returns true.
 type StatusChangedPredicate struct {
 	predicate.Funcs
@@ -72,6 +98,9 @@ func getStatus(obj any) any {
 	if obj == nil {
 		return nil
 	}
+	if u, ok := obj.(runtime.Unstructured); ok {
+		return u.UnstructuredContent()["status"]
+	}
 	val := reflect.ValueOf(obj).Elem()
 	for i := 0; i < val.NumField(); i++ {
 		if val.Type().Field(i).Name == "Status" {
@@ -0,0 +1,103 @@
+package controlplane
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openmcp-project/controller-utils/pkg/collections"
+	commonapi "github.com/openmcp-project/openmcp-operator/api/common"
+	corev2alpha1 "github.com/openmcp-project/openmcp-operator/api/core/v2alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	"github.com/openmcp-project/mcp-operator/internal/utils/components"
+)
+
+// The v2 ControlPlane resource is shared between the Authentication and the Authorization v2 bridges.
+// It has the same name and namespace as the ManagedControlPlane and lives in the crate cluster, which acts as onboarding cluster for the v2 architecture.
+// The Authentication bridge manages the OIDC providers, the Authorization bridge manages their role bindings.
+
+// GetV2ControlPlane fetches the v2 ControlPlane with the given name and namespace.
+// Returns nil without an error if it doesn't exist.
+func GetV2ControlPlane(ctx context.Context, c client.Client, name, namespace string) (*corev2alpha1.ControlPlane, error) {
+	cp := &corev2alpha1.ControlPlane{}
+	cp.SetName(name)
+	cp.SetNamespace(namespace)
+	if err := c.Get(ctx, client.ObjectKeyFromObject(cp), cp); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting v2 ControlPlane '%s/%s': %w", namespace, name, err)
+	}
+	return cp, nil
+}
+
+// CreateOrUpdateV2ControlPlane creates or updates the v2 ControlPlane with the given name and namespace.
+// The reference labels pointing to the v1 ManagedControlPlane are set automatically, everything else has to be done by the mutate function.
+func CreateOrUpdateV2ControlPlane(ctx context.Context, c client.Client, name, namespace string, mutate func(cp *corev2alpha1.ControlPlane) error) (*corev2alpha1.ControlPlane, error) {
+	cp := &corev2alpha1.ControlPlane{}
+	cp.SetName(name)
+	cp.SetNamespace(namespace)
+	if _, err := ctrl.CreateOrUpdate(ctx, c, cp, func() error {
+		if cp.Labels == nil {
+			cp.Labels = map[string]string{}
+		}
+		cp.Labels[openmcpv1alpha1.V1MCPReferenceLabelName] = name
+		cp.Labels[openmcpv1alpha1.V1MCPReferenceLabelNamespace] = namespace
+		return mutate(cp)
+	}); err != nil {
+		return nil, fmt.Errorf("error creating or updating v2 ControlPlane '%s/%s': %w", namespace, name, err)
+	}
+	return cp, nil
+}
+
+// ReleaseV2ControlPlane removes the part of the v2 ControlPlane which is managed by the calling bridge, as implemented by the release function.
+// If no IAM configuration is left afterwards, the v2 ControlPlane is deleted.
+// Returns true if the v2 ControlPlane doesn't exist anymore or is still required by another bridge, false if its deletion is still pending.
+func ReleaseV2ControlPlane(ctx context.Context, c client.Client, name, namespace string, release func(cp *corev2alpha1.ControlPlane)) (bool, error) {
+	cp, err := GetV2ControlPlane(ctx, c, name, namespace)
+	if err != nil {
+		return false, err
+	}
+	if cp == nil {
+		return true, nil
+	}
+	if !cp.DeletionTimestamp.IsZero() {
+		return false, nil
+	}
+	release(cp)
+	if isIAMConfigEmpty(&cp.Spec.IAM) {
+		if err := c.Delete(ctx, cp); client.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("error deleting v2 ControlPlane '%s/%s': %w", namespace, name, err)
+		}
+		return false, nil
+	}
+	if err := c.Update(ctx, cp); err != nil {
+		return false, fmt.Errorf("error updating v2 ControlPlane '%s/%s': %w", namespace, name, err)
+	}
+	return true, nil
+}
+
+// isIAMConfigEmpty returns true if the given IAM configuration neither contains tokens nor OIDC providers nor role bindings for the default OIDC provider.
+func isIAMConfigEmpty(iam *corev2alpha1.IAMConfig) bool {
+	return len(iam.Tokens) == 0 && (iam.OIDC == nil || (len(iam.OIDC.ExtraProviders) == 0 && len(iam.OIDC.DefaultProvider.RoleBindings) == 0))
+}
+
+// IsV2ControlPlaneReady returns true if the given v2 ControlPlane is ready and its status is up-to-date.
+func IsV2ControlPlaneReady(cp *corev2alpha1.ControlPlane) bool {
+	return cp != nil && cp.Status.Phase == commonapi.StatusPhaseReady && cp.Status.ObservedGeneration == cp.Generation
+}
+
+// V2ControlPlaneConditions converts the conditions of the given v2 ControlPlane into component conditions.
+// The given prefix is prepended to the condition types, because condition types have to be unique within the ManagedControlPlane.
+func V2ControlPlaneConditions(cp *corev2alpha1.ControlPlane, prefix string) []openmcpv1alpha1.ComponentCondition {
+	if cp == nil {
+		return nil
+	}
+	return collections.ProjectSliceToSlice(cp.Status.Conditions, func(v2con metav1.Condition) openmcpv1alpha1.ComponentCondition {
+		return components.NewCondition(prefix+v2con.Type, components.ComponentConditionStatusFromMetav1ConditionStatus(v2con.Status), v2con.Reason, v2con.Message)
+	})
+}
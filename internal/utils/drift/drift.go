@@ -0,0 +1,217 @@
+package drift
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Path is a parsed field path.
+// Each element is either a field name or a bracketed list index or map key, e.g. '[0]' or '[gardener.cloud/purpose]'.
+type Path []string
+
+// ParsePath parses a field path.
+// Fields are separated by dots, list indices and map keys which contain dots or slashes are written in brackets,
+// e.g. 'spec.provider.workers[0].machine.type' or 'metadata.annotations[gardener.cloud/timestamp]'.
+// '*' matches any field name and '[*]' matches any list index or bracketed map key.
+func ParsePath(path string) (Path, error) {
+	if path == "" {
+		return nil, fmt.Errorf("path must not be empty")
+	}
+	res := Path{}
+	cur := strings.Builder{}
+	afterBracket := false
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		switch c {
+		case '.':
+			if cur.Len() == 0 && !afterBracket {
+				return nil, fmt.Errorf("invalid path '%s': empty field name at position %d", path, i)
+			}
+			if cur.Len() > 0 {
+				res = append(res, cur.String())
+				cur.Reset()
+			}
+			afterBracket = false
+		case '[':
+			if cur.Len() > 0 {
+				res = append(res, cur.String())
+				cur.Reset()
+			} else if i == 0 {
+				return nil, fmt.Errorf("invalid path '%s': path must start with a field name", path)
+			}
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path '%s': missing closing bracket for opening bracket at position %d", path, i)
+			}
+			if end == 1 {
+				return nil, fmt.Errorf("invalid path '%s': empty brackets at position %d", path, i)
+			}
+			res = append(res, path[i:i+end+1])
+			i += end
+			afterBracket = true
+		case ']':
+			return nil, fmt.Errorf("invalid path '%s': unexpected closing bracket at position %d", path, i)
+		default:
+			if afterBracket {
+				return nil, fmt.Errorf("invalid path '%s': expected '.' or '[' after closing bracket at position %d", path, i)
+			}
+			cur.WriteByte(c)
+		}
+	}
+	if cur.Len() > 0 {
+		res = append(res, cur.String())
+	} else if !afterBracket {
+		return nil, fmt.Errorf("invalid path '%s': path must not end with '.'", path)
+	}
+	return res, nil
+}
+
+// ParsePaths parses multiple field paths.
+func ParsePaths(paths ...string) ([]Path, error) {
+	res := make([]Path, len(paths))
+	for i, p := range paths {
+		pp, err := ParsePath(p)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = pp
+	}
+	return res, nil
+}
+
+// String returns the string representation of the path, as accepted by ParsePath.
+func (p Path) String() string {
+	sb := strings.Builder{}
+	for i, elem := range p {
+		if i > 0 && !strings.HasPrefix(elem, "[") {
+			sb.WriteByte('.')
+		}
+		sb.WriteString(elem)
+	}
+	return sb.String()
+}
+
+// Covers returns true if the given path is equal to or below this path, taking wildcards into account.
+func (p Path) Covers(other Path) bool {
+	if len(p) > len(other) {
+		return false
+	}
+	for i, elem := range p {
+		switch {
+		case elem == other[i]:
+		case elem == "*" && !strings.HasPrefix(other[i], "["):
+		case elem == "[*]" && strings.HasPrefix(other[i], "["):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the paths of all fields which differ between the two given objects, sorted alphabetically.
+// The objects are expected to be unstructured, as returned by runtime.DefaultUnstructuredConverter.
+// Fields which are covered by any of the ignore paths are skipped.
+// If two lists differ in length, only the path of the list itself is returned.
+func Diff(a, b map[string]any, ignore ...Path) []string {
+	res := []string{}
+	diff(a, b, Path{}, ignore, &res)
+	slices.Sort(res)
+	return res
+}
+
+func diff(a, b any, path Path, ignore []Path, res *[]string) {
+	if isIgnored(path, ignore) {
+		return
+	}
+	switch at := a.(type) {
+	case map[string]any:
+		bt, ok := b.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(at)+len(bt))
+		for k := range at {
+			keys = append(keys, k)
+		}
+		for k := range bt {
+			if _, ok := at[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		for _, k := range keys {
+			av, aok := at[k]
+			bv, bok := bt[k]
+			sub := append(slices.Clone(path), pathElement(k))
+			if aok != bok {
+				if !isIgnored(sub, ignore) {
+					*res = append(*res, sub.String())
+				}
+				continue
+			}
+			diff(av, bv, sub, ignore, res)
+		}
+		return
+	case []any:
+		bt, ok := b.([]any)
+		if !ok || len(at) != len(bt) {
+			break
+		}
+		for i := range at {
+			diff(at[i], bt[i], append(slices.Clone(path), fmt.Sprintf("[%d]", i)), ignore, res)
+		}
+		return
+	}
+	if !reflect.DeepEqual(a, b) {
+		*res = append(*res, path.String())
+	}
+}
+
+// Get returns the value at the given path in the given unstructured object.
+// Wildcards are not supported. The second return value is false if the path doesn't exist.
+func Get(obj map[string]any, path Path) (any, bool) {
+	var cur any = obj
+	for _, elem := range path {
+		switch ct := cur.(type) {
+		case map[string]any:
+			key := elem
+			if strings.HasPrefix(elem, "[") {
+				key = elem[1 : len(elem)-1]
+			}
+			v, ok := ct[key]
+			if !ok {
+				return nil, false
+			}
+			cur = v
+		case []any:
+			idx, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(elem, "["), "]"))
+			if err != nil || !strings.HasPrefix(elem, "[") || idx < 0 || idx >= len(ct) {
+				return nil, false
+			}
+			cur = ct[idx]
+		default:
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+// pathElement returns the path element for the given map key.
+// Keys which cannot be represented as field names are put in brackets.
+func pathElement(key string) string {
+	if key == "" || key == "*" || strings.ContainsAny(key, "./[]") {
+		return fmt.Sprintf("[%s]", key)
+	}
+	return key
+}
+
+func isIgnored(path Path, ignore []Path) bool {
+	for _, ip := range ignore {
+		if ip.Covers(path) {
+			return true
+		}
+	}
+	return false
+}
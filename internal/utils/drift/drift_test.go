@@ -0,0 +1,169 @@
+package drift_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openmcp-project/mcp-operator/internal/utils/drift"
+)
+
+var _ = Describe("Drift", func() {
+
+	Context("ParsePath", func() {
+
+		It("should parse valid paths", func() {
+			p, err := drift.ParsePath("spec.provider.workers[0].machine.type")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(p).To(Equal(drift.Path{"spec", "provider", "workers", "[0]", "machine", "type"}))
+			Expect(p.String()).To(Equal("spec.provider.workers[0].machine.type"))
+
+			p, err = drift.ParsePath("metadata.annotations[gardener.cloud/timestamp]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(p).To(Equal(drift.Path{"metadata", "annotations", "[gardener.cloud/timestamp]"}))
+			Expect(p.String()).To(Equal("metadata.annotations[gardener.cloud/timestamp]"))
+
+			p, err = drift.ParsePath("spec.provider.workers[*].*")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(p).To(Equal(drift.Path{"spec", "provider", "workers", "[*]", "*"}))
+		})
+
+		It("should reject invalid paths", func() {
+			for _, p := range []string{"", ".spec", "spec.", "spec..provider", "[0].spec", "spec[0", "spec[]", "spec]", "spec[0]provider"} {
+				_, err := drift.ParsePath(p)
+				Expect(err).To(HaveOccurred(), "path '%s' should be invalid", p)
+			}
+		})
+
+	})
+
+	Context("Covers", func() {
+
+		It("should match prefixes and wildcards", func() {
+			paths, err := drift.ParsePaths("spec.provider", "spec.provider.workers[*].minimum", "metadata.*", "spec.kubernetes")
+			Expect(err).ToNot(HaveOccurred())
+			target, err := drift.ParsePath("spec.provider.workers[1].minimum")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(paths[0].Covers(target)).To(BeTrue())
+			Expect(paths[1].Covers(target)).To(BeTrue())
+			Expect(paths[2].Covers(target)).To(BeFalse())
+			Expect(paths[3].Covers(target)).To(BeFalse())
+			Expect(target.Covers(paths[0])).To(BeFalse())
+		})
+
+	})
+
+	Context("Diff", func() {
+
+		a := map[string]any{
+			"metadata": map[string]any{
+				"annotations": map[string]any{
+					"gardener.cloud/timestamp": "1",
+					"foo":                      "bar",
+				},
+			},
+			"spec": map[string]any{
+				"workers": []any{
+					map[string]any{"name": "w1", "minimum": int64(1)},
+					map[string]any{"name": "w2", "minimum": int64(1)},
+				},
+				"region":  "europe-west1",
+				"purpose": "evaluation",
+			},
+		}
+
+		It("should return no differences for equal objects", func() {
+			Expect(drift.Diff(a, a)).To(BeEmpty())
+		})
+
+		It("should return the paths of all differing fields", func() {
+			b := map[string]any{
+				"metadata": map[string]any{
+					"annotations": map[string]any{
+						"gardener.cloud/timestamp": "2",
+						"foo":                      "bar",
+					},
+				},
+				"spec": map[string]any{
+					"workers": []any{
+						map[string]any{"name": "w1", "minimum": int64(1)},
+						map[string]any{"name": "w2", "minimum": int64(3)},
+					},
+					"region": "europe-west3",
+					"hibernation": map[string]any{
+						"enabled": true,
+					},
+				},
+			}
+			Expect(drift.Diff(a, b)).To(Equal([]string{
+				"metadata.annotations[gardener.cloud/timestamp]",
+				"spec.hibernation",
+				"spec.purpose",
+				"spec.region",
+				"spec.workers[1].minimum",
+			}))
+
+			ignore, err := drift.ParsePaths("metadata.annotations[gardener.cloud/timestamp]", "spec.workers[*].minimum", "spec.hibernation")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(drift.Diff(a, b, ignore...)).To(Equal([]string{
+				"spec.purpose",
+				"spec.region",
+			}))
+		})
+
+		It("should return only the list path if lists differ in length", func() {
+			b := map[string]any{
+				"metadata": a["metadata"],
+				"spec": map[string]any{
+					"workers": []any{
+						map[string]any{"name": "w1", "minimum": int64(2)},
+					},
+					"region":  "europe-west1",
+					"purpose": "evaluation",
+				},
+			}
+			Expect(drift.Diff(a, b)).To(Equal([]string{"spec.workers"}))
+		})
+
+	})
+
+	Context("Get", func() {
+
+		obj := map[string]any{
+			"metadata": map[string]any{
+				"annotations": map[string]any{
+					"gardener.cloud/timestamp": "1",
+				},
+			},
+			"spec": map[string]any{
+				"workers": []any{
+					map[string]any{"name": "w1", "minimum": int64(1)},
+				},
+			},
+		}
+
+		It("should return the values of existing fields", func() {
+			for path, expected := range map[string]any{
+				"metadata.annotations[gardener.cloud/timestamp]": "1",
+				"spec.workers[0].minimum":                        int64(1),
+				"spec.workers[0]":                                map[string]any{"name": "w1", "minimum": int64(1)},
+			} {
+				p, err := drift.ParsePath(path)
+				Expect(err).ToNot(HaveOccurred())
+				v, ok := drift.Get(obj, p)
+				Expect(ok).To(BeTrue(), "path '%s' should exist", path)
+				Expect(v).To(Equal(expected))
+			}
+		})
+
+		It("should report missing fields", func() {
+			for _, path := range []string{"spec.region", "spec.workers[1]", "spec.workers[*]", "spec.workers.name", "spec.workers[0].minimum.foo"} {
+				p, err := drift.ParsePath(path)
+				Expect(err).ToNot(HaveOccurred())
+				_, ok := drift.Get(obj, p)
+				Expect(ok).To(BeFalse(), "path '%s' should not exist", path)
+			}
+		})
+
+	})
+
+})
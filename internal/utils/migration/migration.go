@@ -0,0 +1,268 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
+	"github.com/openmcp-project/mcp-operator/internal/components"
+	componentutils "github.com/openmcp-project/mcp-operator/internal/utils/components"
+)
+
+// A migration moves a component which has been created with the v1 architecture to the v2 architecture, without recreating the resources it manages.
+// It is requested via the component-specific migration annotation on the ManagedControlPlane, which is passed on to the component resource.
+// The migration consists of steps which are executed in order during each reconciliation, until all of them are completed.
+// Its last step switches the architecture version label of the component resource, which is only allowed by the immutability policy
+// if the migration annotation is present. Once the label has been switched, the migration cannot be rolled back anymore.
+
+// SupportedComponents contains the types of all components which can be migrated from the v1 to the v2 architecture.
+var SupportedComponents = sets.New(openmcpv1alpha1.APIServerComponent, openmcpv1alpha1.LandscaperComponent)
+
+// StepArchitectureSwitched is the name of the last step of each migration, which switches the architecture version label of the component resource.
+const StepArchitectureSwitched = "ArchitectureSwitched"
+
+// requeueInterval is the interval in which unfinished migrations and rollbacks are checked again.
+const requeueInterval = 30 * time.Second
+
+// StepFunc performs or reverts a migration step.
+// It returns whether this is completed and, if not, a message describing what the step is waiting for.
+// StepFuncs are called during each reconciliation until the migration is completed, so they have to be idempotent.
+type StepFunc func(ctx context.Context) (bool, string, openmcperrors.ReasonableError)
+
+// Step is a single step of a migration.
+type Step struct {
+	// Name is the name of the step.
+	// It is used to construct the name of the step's condition.
+	Name string
+	// Migrate performs the step.
+	Migrate StepFunc
+	// Rollback reverts the step.
+	// May be nil if the step doesn't change anything that needs to be reverted.
+	Rollback StepFunc
+	// NotReversible marks a step whose changes cannot be reverted, e.g. because it deletes resources.
+	// If the step has been started, rolling back the migration doesn't revert it, which is reported with reason MigrationStepNotReversible.
+	NotReversible bool
+}
+
+// Migration is the migration of a single component resource from the v1 to the v2 architecture.
+type Migration struct {
+	comp  components.Component
+	steps []Step
+}
+
+// New returns a new Migration for the given component resource, consisting of the given steps.
+// The step which switches the architecture version label of the component resource is appended automatically.
+// The given client is used to update the component resource.
+func New(c client.Client, comp components.Component, steps ...Step) *Migration {
+	return &Migration{
+		comp:  comp,
+		steps: append(slices.Clone(steps), switchArchitectureStep(c, comp)),
+	}
+}
+
+// Requested returns the migration operation which is requested for the given component resource.
+// This is either openmcpv1alpha1.ArchitectureV2, openmcpv1alpha1.ArchitectureMigrationValueRollback, or an empty string if no migration is requested.
+// If the migration annotation has been removed while a migration or its rollback is still in progress, a rollback is returned,
+// so that the resources created by the unfinished migration are cleaned up before the v1 logic resumes.
+func Requested(comp components.Component) string {
+	if op, ok := comp.GetAnnotations()[openmcpv1alpha1.ArchitectureMigrationAnnotation]; ok {
+		return op
+	}
+	if con := condition(comp, comp.Type().ArchitectureMigrationCondition()); con != nil && (con.Reason == cconst.ReasonMigrationInProgress || con.Reason == cconst.ReasonMigrationRollingBack) {
+		return openmcpv1alpha1.ArchitectureMigrationValueRollback
+	}
+	return ""
+}
+
+// condition returns the condition of the given type from the status of the given component resource, or nil if it doesn't exist.
+func condition(comp components.Component, conType string) *openmcpv1alpha1.ComponentCondition {
+	for _, con := range comp.GetCommonStatus().Conditions {
+		if con.Type == conType {
+			return &con
+		}
+	}
+	return nil
+}
+
+// stepStarted returns whether the given step has been started according to the status of the given component resource.
+func stepStarted(comp components.Component, step string) bool {
+	con := condition(comp, StepCondition(comp.Type(), step))
+	return con != nil && con.Reason != cconst.ReasonMigrationStepPending && con.Reason != cconst.ReasonMigrationStepRolledBack
+}
+
+// StepCondition returns the name of the condition for the given migration step of the given component.
+// It resolves to "<componentType>Migration<step>".
+func StepCondition(ct openmcpv1alpha1.ComponentType, step string) string {
+	return fmt.Sprintf("%sMigration%s", string(ct), step)
+}
+
+// Result is the result of running or rolling back a migration.
+type Result struct {
+	// Completed is true if all steps have been completed or reverted, respectively.
+	Completed bool
+	// Result is the reconcile result that should be returned if the migration is not completed yet.
+	Result ctrl.Result
+	// Conditions contains the overall migration condition as well as one condition per step.
+	Conditions []openmcpv1alpha1.ComponentCondition
+	// Error is the error that occurred in the current step, if any.
+	Error openmcperrors.ReasonableError
+}
+
+// Run executes the migration steps in order, until one of them is not completed yet.
+func (m *Migration) Run(ctx context.Context) Result {
+	log := logging.FromContextOrPanic(ctx).WithName("migration")
+	ct := m.comp.Type()
+
+	res := Result{Conditions: make([]openmcpv1alpha1.ComponentCondition, 0, len(m.steps)+1)}
+	current := -1
+	for i, step := range m.steps {
+		con := componentutils.NewCondition(StepCondition(ct, step.Name), openmcpv1alpha1.ComponentConditionStatusUnknown, cconst.ReasonMigrationStepPending, "")
+		if current < 0 {
+			done, msg, err := step.Migrate(ctx)
+			switch {
+			case err != nil:
+				log.Error(err, "Migration step failed", "step", step.Name)
+				con.Status = openmcpv1alpha1.ComponentConditionStatusFalse
+				con.Reason = err.Reason()
+				con.Message = err.Error()
+				res.Error = err
+				current = i
+			case !done:
+				log.Info("Waiting for migration step", "step", step.Name, "message", msg)
+				con.Status = openmcpv1alpha1.ComponentConditionStatusFalse
+				con.Reason = cconst.ReasonMigrationStepInProgress
+				con.Message = msg
+				current = i
+			default:
+				log.Debug("Migration step completed", "step", step.Name)
+				con.Status = openmcpv1alpha1.ComponentConditionStatusTrue
+				con.Reason = ""
+			}
+		}
+		res.Conditions = append(res.Conditions, con)
+	}
+
+	if current >= 0 {
+		res.Result = ctrl.Result{RequeueAfter: requeueInterval}
+		res.Conditions = append(res.Conditions, componentutils.NewCondition(ct.ArchitectureMigrationCondition(), openmcpv1alpha1.ComponentConditionStatusFalse, cconst.ReasonMigrationInProgress, fmt.Sprintf("Migration to the v2 architecture is in progress, current step: %s", m.steps[current].Name)))
+		return res
+	}
+
+	log.Info("Migration to the v2 architecture completed")
+	res.Completed = true
+	res.Conditions = append(res.Conditions, componentutils.NewCondition(ct.ArchitectureMigrationCondition(), openmcpv1alpha1.ComponentConditionStatusTrue, cconst.ReasonMigrationCompleted, "Migration to the v2 architecture completed, the migration annotation can be removed from the ManagedControlPlane"))
+	return res
+}
+
+// Rollback reverts the migration steps in reverse order, until one of them is not reverted yet.
+// It must only be called if the architecture version label of the component resource has not been switched yet.
+func (m *Migration) Rollback(ctx context.Context) Result {
+	log := logging.FromContextOrPanic(ctx).WithName("migration")
+	ct := m.comp.Type()
+
+	res := Result{Conditions: make([]openmcpv1alpha1.ComponentCondition, len(m.steps), len(m.steps)+1)}
+	current := -1
+	for i := len(m.steps) - 1; i >= 0; i-- {
+		step := m.steps[i]
+		con := componentutils.NewCondition(StepCondition(ct, step.Name), openmcpv1alpha1.ComponentConditionStatusUnknown, cconst.ReasonMigrationStepPending, "")
+		if current < 0 {
+			if step.NotReversible {
+				if stepStarted(m.comp, step.Name) {
+					log.Info("Migration step cannot be rolled back", "step", step.Name)
+					con.Status = openmcpv1alpha1.ComponentConditionStatusFalse
+					con.Reason = cconst.ReasonMigrationStepNotReversible
+					con.Message = "This migration step cannot be reverted, its changes are kept after the rollback"
+				} else {
+					con.Status = openmcpv1alpha1.ComponentConditionStatusFalse
+					con.Reason = cconst.ReasonMigrationStepRolledBack
+				}
+				res.Conditions[i] = con
+				continue
+			}
+			done, msg := true, ""
+			var err openmcperrors.ReasonableError
+			if step.Rollback != nil {
+				done, msg, err = step.Rollback(ctx)
+			}
+			switch {
+			case err != nil:
+				log.Error(err, "Rollback of migration step failed", "step", step.Name)
+				con.Status = openmcpv1alpha1.ComponentConditionStatusFalse
+				con.Reason = err.Reason()
+				con.Message = err.Error()
+				res.Error = err
+				current = i
+			case !done:
+				log.Info("Waiting for rollback of migration step", "step", step.Name, "message", msg)
+				con.Status = openmcpv1alpha1.ComponentConditionStatusFalse
+				con.Reason = cconst.ReasonMigrationRollingBack
+				con.Message = msg
+				current = i
+			default:
+				log.Debug("Migration step rolled back", "step", step.Name)
+				con.Status = openmcpv1alpha1.ComponentConditionStatusFalse
+				con.Reason = cconst.ReasonMigrationStepRolledBack
+			}
+		}
+		res.Conditions[i] = con
+	}
+
+	if current >= 0 {
+		res.Result = ctrl.Result{RequeueAfter: requeueInterval}
+		res.Conditions = append(res.Conditions, componentutils.NewCondition(ct.ArchitectureMigrationCondition(), openmcpv1alpha1.ComponentConditionStatusFalse, cconst.ReasonMigrationRollingBack, fmt.Sprintf("Migration to the v2 architecture is being rolled back, current step: %s", m.steps[current].Name)))
+		return res
+	}
+
+	log.Info("Migration to the v2 architecture rolled back")
+	res.Completed = true
+	res.Conditions = append(res.Conditions, componentutils.NewCondition(ct.ArchitectureMigrationCondition(), openmcpv1alpha1.ComponentConditionStatusFalse, cconst.ReasonMigrationRolledBack, "Migration to the v2 architecture has been rolled back, the migration annotation can be removed from the ManagedControlPlane"))
+	return res
+}
+
+// Completed returns the conditions for a migration whose architecture version label has already been switched.
+// The steps are not executed again. If a rollback is requested, this is reported in the overall migration condition.
+func (m *Migration) Completed() []openmcpv1alpha1.ComponentCondition {
+	ct := m.comp.Type()
+	cons := make([]openmcpv1alpha1.ComponentCondition, 0, len(m.steps)+1)
+	for _, step := range m.steps {
+		cons = append(cons, componentutils.NewCondition(StepCondition(ct, step.Name), openmcpv1alpha1.ComponentConditionStatusTrue, "", ""))
+	}
+	if Requested(m.comp) == openmcpv1alpha1.ArchitectureMigrationValueRollback {
+		return append(cons, componentutils.NewCondition(ct.ArchitectureMigrationCondition(), openmcpv1alpha1.ComponentConditionStatusTrue, cconst.ReasonMigrationRollbackNotPossible, "Migration to the v2 architecture has already been completed and cannot be rolled back anymore"))
+	}
+	return append(cons, componentutils.NewCondition(ct.ArchitectureMigrationCondition(), openmcpv1alpha1.ComponentConditionStatusTrue, cconst.ReasonMigrationCompleted, "Migration to the v2 architecture completed, the migration annotation can be removed from the ManagedControlPlane"))
+}
+
+// switchArchitectureStep returns the step which switches the architecture version label of the given component resource to v2.
+// It has no rollback, because the migration cannot be rolled back anymore once the label has been switched.
+func switchArchitectureStep(c client.Client, comp components.Component) Step {
+	return Step{
+		Name: StepArchitectureSwitched,
+		Migrate: func(ctx context.Context) (bool, string, openmcperrors.ReasonableError) {
+			if comp.GetLabels()[openmcpv1alpha1.ArchitectureVersionLabel] == openmcpv1alpha1.ArchitectureV2 {
+				return true, "", nil
+			}
+			logging.FromContextOrPanic(ctx).Info("Switching architecture version label", "version", openmcpv1alpha1.ArchitectureV2)
+			old := comp.DeepCopyObject().(components.Component)
+			labels := comp.GetLabels()
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[openmcpv1alpha1.ArchitectureVersionLabel] = openmcpv1alpha1.ArchitectureV2
+			comp.SetLabels(labels)
+			if err := c.Patch(ctx, comp, client.MergeFrom(old)); err != nil {
+				return false, "", openmcperrors.WithReason(fmt.Errorf("error switching architecture version label of %s resource: %w", string(comp.Type()), err), cconst.ReasonCrateClusterInteractionProblem)
+			}
+			return true, "", nil
+		},
+	}
+}
@@ -0,0 +1,234 @@
+package migration_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	cconst "github.com/openmcp-project/mcp-operator/api/constants"
+	openmcpv1alpha1 "github.com/openmcp-project/mcp-operator/api/core/v1alpha1"
+	openmcperrors "github.com/openmcp-project/mcp-operator/api/errors"
+	"github.com/openmcp-project/mcp-operator/internal/utils/migration"
+	testutils "github.com/openmcp-project/mcp-operator/test/utils"
+)
+
+// fakeStep is a migration step which completes after it has been called the configured number of times.
+type fakeStep struct {
+	name          string
+	migrateCalls  int
+	rollbackCalls int
+	requiredCalls int
+	err           openmcperrors.ReasonableError
+}
+
+func (s *fakeStep) step() migration.Step {
+	return migration.Step{
+		Name: s.name,
+		Migrate: func(_ context.Context) (bool, string, openmcperrors.ReasonableError) {
+			s.migrateCalls++
+			if s.err != nil {
+				return false, "", s.err
+			}
+			return s.migrateCalls >= s.requiredCalls, fmt.Sprintf("waiting for %s", s.name), nil
+		},
+		Rollback: func(_ context.Context) (bool, string, openmcperrors.ReasonableError) {
+			s.rollbackCalls++
+			return s.rollbackCalls >= s.requiredCalls, fmt.Sprintf("reverting %s", s.name), nil
+		},
+	}
+}
+
+func conditionStatus(cons []openmcpv1alpha1.ComponentCondition, conType string) (openmcpv1alpha1.ComponentConditionStatus, string) {
+	for _, con := range cons {
+		if con.Type == conType {
+			return con.Status, con.Reason
+		}
+	}
+	return "", ""
+}
+
+var _ = Describe("Migration", func() {
+	var (
+		ctx context.Context
+		c   client.Client
+		as  *openmcpv1alpha1.APIServer
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContext(context.Background(), logging.Discard())
+		as = &openmcpv1alpha1.APIServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "test",
+				Labels: map[string]string{
+					openmcpv1alpha1.ArchitectureVersionLabel: openmcpv1alpha1.ArchitectureV1,
+				},
+				Annotations: map[string]string{
+					openmcpv1alpha1.ArchitectureMigrationAnnotation: openmcpv1alpha1.ArchitectureV2,
+				},
+			},
+		}
+		c = fake.NewClientBuilder().WithScheme(testutils.Scheme).WithObjects(as).Build()
+	})
+
+	It("should execute the steps in order and switch the architecture label afterwards", func() {
+		first := &fakeStep{name: "First", requiredCalls: 1}
+		second := &fakeStep{name: "Second", requiredCalls: 2}
+		m := migration.New(c, as, first.step(), second.step())
+		Expect(migration.Requested(as)).To(Equal(openmcpv1alpha1.ArchitectureV2))
+
+		res := m.Run(ctx)
+		Expect(res.Completed).To(BeFalse())
+		Expect(res.Error).ToNot(HaveOccurred())
+		Expect(res.Result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(first.migrateCalls).To(Equal(1))
+		Expect(second.migrateCalls).To(Equal(1))
+		status, reason := conditionStatus(res.Conditions, "APIServerMigrationFirst")
+		Expect(status).To(Equal(openmcpv1alpha1.ComponentConditionStatusTrue))
+		Expect(reason).To(BeEmpty())
+		status, reason = conditionStatus(res.Conditions, "APIServerMigrationSecond")
+		Expect(status).To(Equal(openmcpv1alpha1.ComponentConditionStatusFalse))
+		Expect(reason).To(Equal(cconst.ReasonMigrationStepInProgress))
+		status, reason = conditionStatus(res.Conditions, migration.StepCondition(openmcpv1alpha1.APIServerComponent, migration.StepArchitectureSwitched))
+		Expect(status).To(Equal(openmcpv1alpha1.ComponentConditionStatusUnknown))
+		Expect(reason).To(Equal(cconst.ReasonMigrationStepPending))
+		status, reason = conditionStatus(res.Conditions, openmcpv1alpha1.APIServerComponent.ArchitectureMigrationCondition())
+		Expect(status).To(Equal(openmcpv1alpha1.ComponentConditionStatusFalse))
+		Expect(reason).To(Equal(cconst.ReasonMigrationInProgress))
+		Expect(as.Labels).To(HaveKeyWithValue(openmcpv1alpha1.ArchitectureVersionLabel, openmcpv1alpha1.ArchitectureV1))
+
+		res = m.Run(ctx)
+		Expect(res.Completed).To(BeTrue())
+		Expect(res.Error).ToNot(HaveOccurred())
+		for _, con := range res.Conditions {
+			Expect(con.Status).To(Equal(openmcpv1alpha1.ComponentConditionStatusTrue), con.Type)
+		}
+		status, reason = conditionStatus(res.Conditions, openmcpv1alpha1.APIServerComponent.ArchitectureMigrationCondition())
+		Expect(status).To(Equal(openmcpv1alpha1.ComponentConditionStatusTrue))
+		Expect(reason).To(Equal(cconst.ReasonMigrationCompleted))
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(as), as)).To(Succeed())
+		Expect(as.Labels).To(HaveKeyWithValue(openmcpv1alpha1.ArchitectureVersionLabel, openmcpv1alpha1.ArchitectureV2))
+	})
+
+	It("should stop at a failing step and report its error", func() {
+		first := &fakeStep{name: "First", requiredCalls: 1, err: openmcperrors.WithReason(fmt.Errorf("boom"), "TestReason")}
+		second := &fakeStep{name: "Second", requiredCalls: 1}
+		m := migration.New(c, as, first.step(), second.step())
+
+		res := m.Run(ctx)
+		Expect(res.Completed).To(BeFalse())
+		Expect(res.Error).To(HaveOccurred())
+		Expect(second.migrateCalls).To(BeZero())
+		status, reason := conditionStatus(res.Conditions, "APIServerMigrationFirst")
+		Expect(status).To(Equal(openmcpv1alpha1.ComponentConditionStatusFalse))
+		Expect(reason).To(Equal("TestReason"))
+	})
+
+	It("should roll back the steps in reverse order", func() {
+		first := &fakeStep{name: "First", requiredCalls: 1}
+		second := &fakeStep{name: "Second", requiredCalls: 2}
+		m := migration.New(c, as, first.step(), second.step())
+
+		res := m.Rollback(ctx)
+		Expect(res.Completed).To(BeFalse())
+		Expect(second.rollbackCalls).To(Equal(1))
+		Expect(first.rollbackCalls).To(BeZero())
+		status, reason := conditionStatus(res.Conditions, "APIServerMigrationSecond")
+		Expect(status).To(Equal(openmcpv1alpha1.ComponentConditionStatusFalse))
+		Expect(reason).To(Equal(cconst.ReasonMigrationRollingBack))
+		status, reason = conditionStatus(res.Conditions, "APIServerMigrationFirst")
+		Expect(status).To(Equal(openmcpv1alpha1.ComponentConditionStatusUnknown))
+		Expect(reason).To(Equal(cconst.ReasonMigrationStepPending))
+		status, reason = conditionStatus(res.Conditions, openmcpv1alpha1.APIServerComponent.ArchitectureMigrationCondition())
+		Expect(status).To(Equal(openmcpv1alpha1.ComponentConditionStatusFalse))
+		Expect(reason).To(Equal(cconst.ReasonMigrationRollingBack))
+
+		res = m.Rollback(ctx)
+		Expect(res.Completed).To(BeTrue())
+		Expect(first.rollbackCalls).To(Equal(1))
+		status, reason = conditionStatus(res.Conditions, "APIServerMigrationFirst")
+		Expect(status).To(Equal(openmcpv1alpha1.ComponentConditionStatusFalse))
+		Expect(reason).To(Equal(cconst.ReasonMigrationStepRolledBack))
+		status, reason = conditionStatus(res.Conditions, openmcpv1alpha1.APIServerComponent.ArchitectureMigrationCondition())
+		Expect(status).To(Equal(openmcpv1alpha1.ComponentConditionStatusFalse))
+		Expect(reason).To(Equal(cconst.ReasonMigrationRolledBack))
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(as), as)).To(Succeed())
+		Expect(as.Labels).To(HaveKeyWithValue(openmcpv1alpha1.ArchitectureVersionLabel, openmcpv1alpha1.ArchitectureV1))
+	})
+
+	It("should request a rollback if the annotation is removed before the migration is completed or rolled back", func() {
+		delete(as.Annotations, openmcpv1alpha1.ArchitectureMigrationAnnotation)
+		Expect(migration.Requested(as)).To(BeEmpty())
+
+		for reason, expected := range map[string]string{
+			cconst.ReasonMigrationInProgress:  openmcpv1alpha1.ArchitectureMigrationValueRollback,
+			cconst.ReasonMigrationRollingBack: openmcpv1alpha1.ArchitectureMigrationValueRollback,
+			cconst.ReasonMigrationRolledBack:  "",
+			cconst.ReasonMigrationCompleted:   "",
+		} {
+			as.Status.Conditions = []openmcpv1alpha1.ComponentCondition{{
+				Type:   openmcpv1alpha1.APIServerComponent.ArchitectureMigrationCondition(),
+				Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+				Reason: reason,
+			}}
+			Expect(migration.Requested(as)).To(Equal(expected), reason)
+		}
+	})
+
+	It("should report started steps which cannot be reverted during the rollback", func() {
+		first := &fakeStep{name: "First", requiredCalls: 1}
+		second := first.step()
+		second.Name = "Second"
+		second.NotReversible = true
+		third := first.step()
+		third.Name = "Third"
+		third.NotReversible = true
+		as.Status.Conditions = []openmcpv1alpha1.ComponentCondition{
+			{
+				Type:   migration.StepCondition(openmcpv1alpha1.APIServerComponent, "Second"),
+				Status: openmcpv1alpha1.ComponentConditionStatusFalse,
+				Reason: cconst.ReasonMigrationStepInProgress,
+			},
+			{
+				Type:   migration.StepCondition(openmcpv1alpha1.APIServerComponent, "Third"),
+				Status: openmcpv1alpha1.ComponentConditionStatusUnknown,
+				Reason: cconst.ReasonMigrationStepPending,
+			},
+		}
+		m := migration.New(c, as, first.step(), second, third)
+
+		res := m.Rollback(ctx)
+		Expect(res.Completed).To(BeTrue())
+		Expect(first.rollbackCalls).To(Equal(1))
+		status, reason := conditionStatus(res.Conditions, "APIServerMigrationSecond")
+		Expect(status).To(Equal(openmcpv1alpha1.ComponentConditionStatusFalse))
+		Expect(reason).To(Equal(cconst.ReasonMigrationStepNotReversible))
+		status, reason = conditionStatus(res.Conditions, "APIServerMigrationThird")
+		Expect(status).To(Equal(openmcpv1alpha1.ComponentConditionStatusFalse))
+		Expect(reason).To(Equal(cconst.ReasonMigrationStepRolledBack))
+		status, reason = conditionStatus(res.Conditions, openmcpv1alpha1.APIServerComponent.ArchitectureMigrationCondition())
+		Expect(status).To(Equal(openmcpv1alpha1.ComponentConditionStatusFalse))
+		Expect(reason).To(Equal(cconst.ReasonMigrationRolledBack))
+	})
+
+	It("should report that a completed migration cannot be rolled back", func() {
+		as.Annotations[openmcpv1alpha1.ArchitectureMigrationAnnotation] = openmcpv1alpha1.ArchitectureMigrationValueRollback
+		m := migration.New(c, as, (&fakeStep{name: "First"}).step())
+
+		cons := m.Completed()
+		status, reason := conditionStatus(cons, "APIServerMigrationFirst")
+		Expect(status).To(Equal(openmcpv1alpha1.ComponentConditionStatusTrue))
+		Expect(reason).To(BeEmpty())
+		status, reason = conditionStatus(cons, openmcpv1alpha1.APIServerComponent.ArchitectureMigrationCondition())
+		Expect(status).To(Equal(openmcpv1alpha1.ComponentConditionStatusTrue))
+		Expect(reason).To(Equal(cconst.ReasonMigrationRollbackNotPossible))
+	})
+})
@@ -181,8 +181,16 @@ func (g Geography) SortByProximity(start openmcpv1alpha1.RegionSpecification, pr
 //
 // The returned list is sorted alphabetically to ensure consistent results.
 func GetClosestRegions(origin openmcpv1alpha1.RegionSpecification, mapper GenericToSpecificRegionMapper, availableRegions []string, preferSameRegion bool) ([]string, error) {
+	regions, _, err := GetClosestRegionsWithDistance(origin, mapper, availableRegions, preferSameRegion)
+	return regions, err
+}
+
+// GetClosestRegionsWithDistance works like GetClosestRegions, but additionally returns the distance between the origin and the returned regions.
+// The distance is the index of the proximity group (see SortByProximity) in which the matches were found, 0 meaning that the origin itself matched.
+// If no match is found, the returned distance is -1.
+func GetClosestRegionsWithDistance(origin openmcpv1alpha1.RegionSpecification, mapper GenericToSpecificRegionMapper, availableRegions []string, preferSameRegion bool) ([]string, int, error) {
 	groups := SortByProximity(origin, preferSameRegion)
-	for _, group := range groups {
+	for distance, group := range groups {
 		var groupMatches []string
 		for _, region := range group {
 			regex := mapper.MapGenericToSpecific(region.Name, region.Direction)
@@ -192,7 +200,7 @@ func GetClosestRegions(origin openmcpv1alpha1.RegionSpecification, mapper Generi
 			var err error
 			regionMatches, err := Filter(availableRegions, regex)
 			if err != nil {
-				return nil, err
+				return nil, -1, err
 			}
 			if len(regionMatches) > 0 {
 				groupMatches = append(groupMatches, regionMatches...)
@@ -200,10 +208,10 @@ func GetClosestRegions(origin openmcpv1alpha1.RegionSpecification, mapper Generi
 		}
 		if len(groupMatches) > 0 {
 			slices.Sort(groupMatches)
-			return groupMatches, nil
+			return groupMatches, distance, nil
 		}
 	}
-	return nil, nil
+	return nil, -1, nil
 }
 
 // Filter filters a list of strings and returns a new list containing only the elements which are matched by the given regular expression.
@@ -294,11 +302,14 @@ func (m *BasicRegionMapper) MapGenericToSpecific(reg openmcpv1alpha1.Region, dir
 // Otherwise, nil is returned.
 // The name of the cloudprovider is case-insensitive.
 //
-// Currently supported: aws, gcp
+// Currently supported: aws, azure, gcp
+// There is no predefined mapper for openstack, because OpenStack region names are specific to the respective landscape.
 func GetPredefinedMapperByCloudprovider(provider string) GenericToSpecificRegionMapper {
 	switch strings.ToLower(provider) {
 	case "aws":
 		return AWSMapper()
+	case "azure":
+		return AzureMapper()
 	case "gcp":
 		return GCPMapper()
 	}
@@ -418,6 +429,87 @@ var AWSZones = []string{
 	"sa-east-1c",
 }
 
+// AzureMapper returns a pre-configured mapper for Azure regions.
+// Azure region names don't follow a strict schema: the direction is either prepended to a geographic area (e.g. 'westeurope', 'southeastasia')
+// or appended to a country (e.g. 'germanywestcentral', 'uksouth'). Therefore, the region mapping contains alternatives and the format matches both variants.
+func AzureMapper() *BasicRegionMapper {
+	return NewRegionMapper("^([a-z]{0,5}%D[a-z]{0,7}%R|%R[a-z]{0,7}%D[a-z]{0,4})[0-9]*$", map[openmcpv1alpha1.Region]string{
+		openmcpv1alpha1.AFRICA:       "(southafrica|uae|qatar|israel)", // middle east is not really africa, but close enough
+		openmcpv1alpha1.ASIA:         "(asia|japan|korea|india|indonesia|malaysia|taiwan)",
+		openmcpv1alpha1.AUSTRALIA:    "(australia|newzealand)",
+		openmcpv1alpha1.EUROPE:       "(europe|germany|france|uk|switzerland|norway|sweden|poland|italy|spain|austria|belgium|denmark)",
+		openmcpv1alpha1.NORTHAMERICA: "(us|canada|mexico)",
+		openmcpv1alpha1.SOUTHAMERICA: "(brazil|chile)",
+	}, map[openmcpv1alpha1.Direction]string{
+		openmcpv1alpha1.CENTRAL: "central",
+		openmcpv1alpha1.NORTH:   "north",
+		openmcpv1alpha1.EAST:    "east",
+		openmcpv1alpha1.SOUTH:   "south",
+		openmcpv1alpha1.WEST:    "west",
+	}, true)
+}
+
+// AzureRegions contains a list of all known Azure regions. May not be up-to-date.
+// Azure availability zones are not region-specific (they are named '1', '2', and '3' in every zoned region), so there is no corresponding list of zones.
+var AzureRegions = []string{
+	"australiacentral",
+	"australiacentral2",
+	"australiaeast",
+	"australiasoutheast",
+	"austriaeast",
+	"belgiumcentral",
+	"brazilsouth",
+	"brazilsoutheast",
+	"canadacentral",
+	"canadaeast",
+	"centralindia",
+	"centralus",
+	"chilecentral",
+	"denmarkeast",
+	"eastasia",
+	"eastus",
+	"eastus2",
+	"francecentral",
+	"francesouth",
+	"germanynorth",
+	"germanywestcentral",
+	"indonesiacentral",
+	"israelcentral",
+	"italynorth",
+	"japaneast",
+	"japanwest",
+	"koreacentral",
+	"koreasouth",
+	"malaysiawest",
+	"mexicocentral",
+	"newzealandnorth",
+	"northcentralus",
+	"northeurope",
+	"norwayeast",
+	"norwaywest",
+	"polandcentral",
+	"qatarcentral",
+	"southafricanorth",
+	"southafricawest",
+	"southcentralus",
+	"southeastasia",
+	"southindia",
+	"spaincentral",
+	"swedencentral",
+	"switzerlandnorth",
+	"switzerlandwest",
+	"uaecentral",
+	"uaenorth",
+	"uksouth",
+	"ukwest",
+	"westcentralus",
+	"westeurope",
+	"westindia",
+	"westus",
+	"westus2",
+	"westus3",
+}
+
 // GCPMapper returns a pre-configured mapper for GCP regions.
 func GCPMapper() *BasicRegionMapper {
 	return NewRegionMapper("^%R-%D[a-z]{0,4}[0-9]+(-[a-z]+)?$", map[openmcpv1alpha1.Region]string{
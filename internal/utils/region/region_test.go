@@ -32,4 +32,64 @@ var _ = Describe("Regions", func() {
 		Expect(regions).To(HaveLen(2))
 		Expect(regions).To(ContainElements(availableRegions[1], availableRegions[2]))
 	})
+
+	It("should return the distance of the closest regions", func() {
+		availableRegions := []string{"europe-west1", "us-east1", "us-west1"}
+		regions, distance, err := GetClosestRegionsWithDistance(openmcpv1alpha1.RegionSpecification{Name: openmcpv1alpha1.EUROPE, Direction: openmcpv1alpha1.WEST}, GCPMapper(), availableRegions, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(regions).To(ConsistOf("europe-west1"))
+		Expect(distance).To(Equal(0))
+
+		regions, distance, err = GetClosestRegionsWithDistance(openmcpv1alpha1.RegionSpecification{Name: openmcpv1alpha1.NORTHAMERICA, Direction: openmcpv1alpha1.NORTH}, GCPMapper(), availableRegions, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(regions).To(ConsistOf("us-east1", "us-west1"))
+		Expect(distance).To(BeNumerically(">", 0))
+
+		regions, distance, err = GetClosestRegionsWithDistance(openmcpv1alpha1.RegionSpecification{Name: openmcpv1alpha1.EUROPE, Direction: openmcpv1alpha1.WEST}, GCPMapper(), []string{"foo"}, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(regions).To(BeEmpty())
+		Expect(distance).To(Equal(-1))
+	})
+
+	It("should map generic regions to Azure regions", func() {
+		availableRegions := []string{"eastus", "eastus2", "germanywestcentral", "northeurope", "westeurope", "westus"}
+		regions, err := GetClosestRegions(openmcpv1alpha1.RegionSpecification{
+			Name:      openmcpv1alpha1.EUROPE,
+			Direction: openmcpv1alpha1.CENTRAL,
+		}, AzureMapper(), availableRegions, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(regions).To(ConsistOf("germanywestcentral"))
+
+		regions, err = GetClosestRegions(openmcpv1alpha1.RegionSpecification{
+			Name:      openmcpv1alpha1.EUROPE,
+			Direction: openmcpv1alpha1.WEST,
+		}, AzureMapper(), availableRegions, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(regions).To(ConsistOf("westeurope"))
+
+		regions, err = GetClosestRegions(openmcpv1alpha1.RegionSpecification{
+			Name:      openmcpv1alpha1.NORTHAMERICA,
+			Direction: openmcpv1alpha1.EAST,
+		}, AzureMapper(), availableRegions, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(regions).To(ConsistOf("eastus", "eastus2"))
+	})
+
+	It("should map every known Azure region to a generic region", func() {
+		mapper := AzureMapper()
+		unmapped := map[string]struct{}{}
+		for _, r := range AzureRegions {
+			unmapped[r] = struct{}{}
+		}
+		for reg := range World {
+			for _, dir := range []openmcpv1alpha1.Direction{openmcpv1alpha1.CENTRAL, openmcpv1alpha1.NORTH, openmcpv1alpha1.EAST, openmcpv1alpha1.SOUTH, openmcpv1alpha1.WEST} {
+				matches, err := Filter(AzureRegions, mapper.MapGenericToSpecific(reg, dir))
+				Expect(err).NotTo(HaveOccurred())
+				for _, m := range matches {
+					delete(unmapped, m)
+				}
+			}
+		}
+		Expect(unmapped).To(BeEmpty())
+	})
 })
@@ -6,6 +6,7 @@ import (
 
 	"github.com/openmcp-project/mcp-operator/internal/utils/apiserver"
 
+	gcpv1alpha1 "github.com/openmcp-project/cluster-provider-gardener/api/core/v1alpha1"
 	cocorev1beta1 "github.com/openmcp-project/control-plane-operator/api/v1beta1"
 	"github.com/openmcp-project/controller-utils/pkg/testing"
 	laasinstall "github.com/openmcp-project/landscaper-service/pkg/apis/core/install"
@@ -31,6 +32,7 @@ func init() {
 	utilruntime.Must(cocorev1beta1.AddToScheme(Scheme))
 	utilruntime.Must(gardenv1beta1.AddToScheme(Scheme))
 	utilruntime.Must(gardenauthenticationv1alpha1.AddToScheme(Scheme))
+	utilruntime.Must(gcpv1alpha1.AddToScheme(Scheme))
 	utilruntime.Must(clientgoscheme.AddToScheme(Scheme))
 	v2install.InstallOperatorAPIsPlatform(Scheme)
 	v2install.InstallOperatorAPIsOnboarding(Scheme)